@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	accountSvc "github.com/kamil5b/clean-go-vite-react/backend/service/account"
+	"github.com/labstack/echo/v4"
+)
+
+// AccountHandler handles password reset and email verification HTTP requests
+type AccountHandler struct {
+	accountService accountSvc.AccountService
+}
+
+// NewAccountHandler creates a new instance of AccountHandler
+func NewAccountHandler(accountService accountSvc.AccountService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+	}
+}
+
+// ForgotPassword handles POST /api/auth/password/forgot requests. It
+// answers the same whether or not the email has an account.
+func (h *AccountHandler) ForgotPassword(c echo.Context) error {
+	req := &request.ForgotPasswordRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := h.accountService.ForgotPassword(c.Request().Context(), req); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "if the email belongs to an account, a password reset link has been sent to it",
+	})
+}
+
+// ResetPassword handles POST /api/auth/password/reset requests
+func (h *AccountHandler) ResetPassword(c echo.Context) error {
+	req := &request.ResetPasswordRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := h.accountService.ResetPassword(c.Request().Context(), req); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "password has been reset, sign in with the new one",
+	})
+}
+
+// VerifyEmail handles POST /api/auth/verify-email requests
+func (h *AccountHandler) VerifyEmail(c echo.Context) error {
+	req := &request.VerifyEmailRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	if err := h.accountService.VerifyEmail(c.Request().Context(), req); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "email has been verified",
+	})
+}
+
+// ResendVerification handles POST /api/auth/verify-email/resend requests (protected)
+func (h *AccountHandler) ResendVerification(c echo.Context) error {
+	userID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.accountService.SendVerification(c.Request().Context(), userID); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "a verification link has been sent to your email",
+	})
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
+	"github.com/labstack/echo/v4"
+)
+
+// AdminHandler handles user administration HTTP requests
+type AdminHandler struct {
+	userService userSvc.UserService
+	pagination  Pagination
+}
+
+// NewAdminHandler creates a new instance of AdminHandler
+func NewAdminHandler(userService userSvc.UserService, pagination Pagination) *AdminHandler {
+	return &AdminHandler{
+		userService: userService,
+		pagination:  pagination,
+	}
+}
+
+// ListUsers handles GET /api/admin/users requests
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
+	}
+
+	users, err := h.userService.ListUsers(c.Request().Context(), page, limit)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, users)
+}
+
+// UpdateRoles handles PUT /api/admin/users/:id/roles requests
+func (h *AdminHandler) UpdateRoles(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.UpdateUserRolesRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	user, err := h.userService.UpdateRoles(c.Request().Context(), id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+// Unlock handles POST /api/admin/users/:id/unlock requests
+func (h *AdminHandler) Unlock(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.userService.Unlock(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "user unlocked successfully",
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	apiKeySvc "github.com/kamil5b/clean-go-vite-react/backend/service/apikey"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHandler handles personal API key HTTP requests
+type APIKeyHandler struct {
+	apiKeyService apiKeySvc.APIKeyService
+}
+
+// NewAPIKeyHandler creates a new instance of APIKeyHandler
+func NewAPIKeyHandler(apiKeyService apiKeySvc.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// Create handles POST /api/auth/api-keys requests
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	userID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := &request.CreateAPIKeyRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	key, err := h.apiKeyService.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, key)
+}
+
+// List handles GET /api/auth/api-keys requests
+func (h *APIKeyHandler) List(c echo.Context) error {
+	userID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	keys, err := h.apiKeyService.List(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Revoke handles DELETE /api/auth/api-keys/:id requests
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	userID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.apiKeyService.Revoke(c.Request().Context(), userID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "api key revoked successfully",
+	})
+}
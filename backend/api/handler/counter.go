@@ -23,9 +23,7 @@ func NewCounterHandler(svc counter.CounterService) *CounterHandler {
 func (h *CounterHandler) GetCounter(c echo.Context) error {
 	value, err := h.service.GetCounter(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, value)
@@ -35,9 +33,7 @@ func (h *CounterHandler) GetCounter(c echo.Context) error {
 func (h *CounterHandler) IncrementCounter(c echo.Context) error {
 	value, err := h.service.IncrementCounter(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, value)
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	customerSvc "github.com/kamil5b/clean-go-vite-react/backend/service/customer"
+	"github.com/labstack/echo/v4"
+)
+
+// CustomerHandler handles customer-related HTTP requests
+type CustomerHandler struct {
+	customerService customerSvc.CustomerService
+	pagination      Pagination
+}
+
+// NewCustomerHandler creates a new instance of CustomerHandler
+func NewCustomerHandler(customerService customerSvc.CustomerService, pagination Pagination) *CustomerHandler {
+	return &CustomerHandler{
+		customerService: customerService,
+		pagination:      pagination,
+	}
+}
+
+// Create handles POST /api/customers requests
+func (h *CustomerHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := &request.CreateCustomerRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	customer, err := h.customerService.Create(c.Request().Context(), ownerID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, customer)
+}
+
+// GetByID handles GET /api/customers/:id requests
+func (h *CustomerHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	customer, err := h.customerService.GetByID(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, customer)
+}
+
+// Update handles PUT /api/customers/:id requests
+func (h *CustomerHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.UpdateCustomerRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	customer, err := h.customerService.Update(c.Request().Context(), ownerID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, customer)
+}
+
+// Delete handles DELETE /api/customers/:id requests
+func (h *CustomerHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.customerService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "customer deleted successfully",
+	})
+}
+
+// GetAll handles GET /api/customers requests
+func (h *CustomerHandler) GetAll(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
+	}
+	search := c.QueryParam("search")
+
+	customers, err := h.customerService.GetAll(c.Request().Context(), ownerID, page, limit, search)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, customers)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/labstack/echo/v4"
+)
+
+// ProblemContentType is the media type of every error body
+const ProblemContentType = "application/problem+json"
+
+var errInvalidID = apperror.New(apperror.ErrBadRequest, "invalid_id", "invalid id")
+
+// kindStatus is the HTTP status of each kind of apperror
+var kindStatus = []struct {
+	kind   error
+	status int
+}{
+	{apperror.ErrBadRequest, http.StatusBadRequest},
+	{apperror.ErrValidation, http.StatusUnprocessableEntity},
+	{apperror.ErrUnauthorized, http.StatusUnauthorized},
+	{apperror.ErrForbidden, http.StatusForbidden},
+	{apperror.ErrNotFound, http.StatusNotFound},
+	{apperror.ErrConflict, http.StatusConflict},
+	{apperror.ErrTooManyRequests, http.StatusTooManyRequests},
+}
+
+// HandleError is the echo.HTTPErrorHandler of the API. It writes err as an
+// RFC 7807 problem: an apperror with the status of its kind and its code,
+// an echo.HTTPError with its own status, and anything else as a 500 whose
+// details are logged rather than sent.
+func HandleError(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	problem := response.ProblemResponse{
+		Type:     "about:blank",
+		Status:   http.StatusInternalServerError,
+		Detail:   "internal server error",
+		Instance: c.Request().URL.Path,
+		Code:     "internal_error",
+	}
+
+	var appErr *apperror.Error
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &appErr):
+		problem.Detail = err.Error()
+		problem.Code = appErr.Code
+		for _, field := range appErr.Fields {
+			problem.Fields = append(problem.Fields, response.FieldErrorResponse{
+				Field: field.Field,
+				Rule:  field.Rule,
+				Param: field.Param,
+			})
+		}
+		for _, k := range kindStatus {
+			if errors.Is(appErr, k.kind) {
+				problem.Status = k.status
+				break
+			}
+		}
+		if appErr.RetryAfter > 0 {
+			seconds := int(math.Ceil(appErr.RetryAfter.Seconds()))
+			c.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+		}
+	case errors.As(err, &httpErr):
+		problem.Status = httpErr.Code
+		problem.Code = statusCode(httpErr.Code)
+		if message, ok := httpErr.Message.(string); ok {
+			problem.Detail = message
+		} else {
+			problem.Detail = strings.ToLower(http.StatusText(httpErr.Code))
+		}
+	}
+	problem.Title = http.StatusText(problem.Status)
+	if problem.Status == http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(problem.Status)
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, ProblemContentType)
+		err = c.JSON(problem.Status, problem)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+// statusCode is the code of an error known only by its status, e.g.
+// method_not_allowed for 405
+func statusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
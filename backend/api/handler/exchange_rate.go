@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	exchangeRateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/exchangerate"
+	"github.com/labstack/echo/v4"
+)
+
+// maxImportFileSize caps the size of an uploaded exchange rate file (1 MiB)
+const maxImportFileSize = 1 << 20
+
+var (
+	errFileRequired = apperror.New(apperror.ErrBadRequest, "file_required", "file is required")
+	errInvalidFile  = apperror.New(apperror.ErrBadRequest, "invalid_file", "invalid file")
+)
+
+// ExchangeRateHandler handles exchange rate-related HTTP requests
+type ExchangeRateHandler struct {
+	exchangeRateService exchangeRateSvc.ExchangeRateService
+	pagination          Pagination
+}
+
+// NewExchangeRateHandler creates a new instance of ExchangeRateHandler
+func NewExchangeRateHandler(exchangeRateService exchangeRateSvc.ExchangeRateService, pagination Pagination) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		exchangeRateService: exchangeRateService,
+		pagination:          pagination,
+	}
+}
+
+// Create handles POST /api/exchange-rates requests
+func (h *ExchangeRateHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := &request.CreateExchangeRateRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	rate, err := h.exchangeRateService.Create(c.Request().Context(), ownerID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, rate)
+}
+
+// Import handles POST /api/exchange-rates/import requests. The CSV file is
+// sent as the "file" field of a multipart form.
+func (h *ExchangeRateHandler) Import(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		return errFileRequired
+	}
+	if header.Size > maxImportFileSize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "file is too large")
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return errInvalidFile
+	}
+	defer file.Close()
+
+	result, err := h.exchangeRateService.Import(c.Request().Context(), ownerID, file)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, result)
+}
+
+// GetByID handles GET /api/exchange-rates/:id requests
+func (h *ExchangeRateHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	rate, err := h.exchangeRateService.GetByID(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rate)
+}
+
+// Delete handles DELETE /api/exchange-rates/:id requests
+func (h *ExchangeRateHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.exchangeRateService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "exchange rate deleted successfully",
+	})
+}
+
+// GetAll handles GET /api/exchange-rates requests
+func (h *ExchangeRateHandler) GetAll(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
+	}
+	baseCurrency := c.QueryParam("base_currency")
+	quoteCurrency := c.QueryParam("quote_currency")
+
+	rates, err := h.exchangeRateService.GetAll(c.Request().Context(), ownerID, page, limit, baseCurrency, quoteCurrency)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rates)
+}
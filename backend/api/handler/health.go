@@ -23,9 +23,7 @@ func NewHealthHandler(svc health.HealthService) *HealthHandler {
 func (h *HealthHandler) Check(c echo.Context) error {
 	status, err := h.service.Check(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, status)
@@ -40,9 +38,7 @@ func (h *HealthHandler) CheckWithDependencies(c echo.Context, checks map[string]
 
 	status, err := h.service.Check(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, status)
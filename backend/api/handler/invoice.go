@@ -1,41 +1,51 @@
 package handler
 
 import (
+	"bytes"
+	"fmt"
+	"mime"
 	"net/http"
-	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	invoiceSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoice"
+	pdfSvc "github.com/kamil5b/clean-go-vite-react/backend/service/pdf"
 	"github.com/labstack/echo/v4"
 )
 
 // InvoiceHandler handles invoice-related HTTP requests
 type InvoiceHandler struct {
 	invoiceService invoiceSvc.InvoiceService
+	pdfRenderer    pdfSvc.InvoiceRenderer
+	pagination     Pagination
 }
 
 // NewInvoiceHandler creates a new instance of InvoiceHandler
-func NewInvoiceHandler(invoiceService invoiceSvc.InvoiceService) *InvoiceHandler {
+func NewInvoiceHandler(invoiceService invoiceSvc.InvoiceService, pdfRenderer pdfSvc.InvoiceRenderer, pagination Pagination) *InvoiceHandler {
 	return &InvoiceHandler{
 		invoiceService: invoiceService,
+		pdfRenderer:    pdfRenderer,
+		pagination:     pagination,
 	}
 }
 
 // Create handles POST /api/invoices requests
 func (h *InvoiceHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	req := &request.CreateInvoiceRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	invoice, err := h.invoiceService.Create(c.Request().Context(), req)
+	invoice, err := h.invoiceService.Create(c.Request().Context(), ownerID, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, invoice)
@@ -43,44 +53,75 @@ func (h *InvoiceHandler) Create(c echo.Context) error {
 
 // GetByID handles GET /api/invoices/:id requests
 func (h *InvoiceHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	invoice, err := h.invoiceService.GetByID(c.Request().Context(), uuid.UUID(id))
+	invoice, err := h.invoiceService.GetByID(c.Request().Context(), ownerID, id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, invoice)
 }
 
+// GetPDF handles GET /api/invoices/:id/pdf requests
+func (h *InvoiceHandler) GetPDF(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	invoice, err := h.invoiceService.GetByID(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	// Render fully before responding so a template error is still a problem response
+	var document bytes.Buffer
+	if err := h.pdfRenderer.Render(&document, invoice); err != nil {
+		return fmt.Errorf("failed to render invoice: %w", err)
+	}
+
+	filename := "invoice-" + invoice.ID.String() + ".pdf"
+	if invoice.Number != nil {
+		filename = *invoice.Number + ".pdf"
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": filename}))
+	return c.Blob(http.StatusOK, "application/pdf", document.Bytes())
+}
+
 // Update handles PUT /api/invoices/:id requests
 func (h *InvoiceHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
 	req := &request.UpdateInvoiceRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	invoice, err := h.invoiceService.Update(c.Request().Context(), uuid.UUID(id), req)
+	invoice, err := h.invoiceService.Update(c.Request().Context(), ownerID, id, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, invoice)
@@ -88,17 +129,18 @@ func (h *InvoiceHandler) Update(c echo.Context) error {
 
 // Delete handles DELETE /api/invoices/:id requests
 func (h *InvoiceHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	if err := h.invoiceService.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+	if err := h.invoiceService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -108,23 +150,174 @@ func (h *InvoiceHandler) Delete(c echo.Context) error {
 
 // GetAll handles GET /api/invoices requests
 func (h *InvoiceHandler) GetAll(c echo.Context) error {
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	limit, _ := strconv.Atoi(c.QueryParam("limit"))
-	search := c.QueryParam("search")
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
 
-	if page < 1 {
-		page = 1
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
 	}
-	if limit < 1 {
-		limit = 10
+	baseCurrency := c.QueryParam("base_currency")
+
+	spec, err := query.Parse(c.QueryParams(), invoiceSvc.QueryFields)
+	if err != nil {
+		return err
 	}
 
-	invoices, err := h.invoiceService.GetAll(c.Request().Context(), page, limit, search)
+	invoices, err := h.invoiceService.GetAll(c.Request().Context(), ownerID, page, limit, spec, baseCurrency)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, invoices)
 }
+
+// Summary handles GET /api/invoices/summary requests
+func (h *InvoiceHandler) Summary(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	status := c.QueryParam("status")
+	baseCurrency := c.QueryParam("base_currency")
+
+	summary, err := h.invoiceService.Summary(c.Request().Context(), ownerID, status, baseCurrency)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, summary)
+}
+
+// Issue handles POST /api/invoices/:id/issue requests
+func (h *InvoiceHandler) Issue(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	invoice, err := h.invoiceService.Issue(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, invoice)
+}
+
+// CreatePayment handles POST /api/invoices/:id/payments requests
+func (h *InvoiceHandler) CreatePayment(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.CreatePaymentRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	invoice, err := h.invoiceService.RecordPayment(c.Request().Context(), ownerID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, invoice)
+}
+
+// GetPayments handles GET /api/invoices/:id/payments requests
+func (h *InvoiceHandler) GetPayments(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	payments, err := h.invoiceService.GetPayments(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, payments)
+}
+
+// CreateCreditNote handles POST /api/invoices/:id/credit-notes requests
+func (h *InvoiceHandler) CreateCreditNote(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.CreateCreditNoteRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	invoice, err := h.invoiceService.CreateCreditNote(c.Request().Context(), ownerID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, invoice)
+}
+
+// GetCreditNotes handles GET /api/invoices/:id/credit-notes requests
+func (h *InvoiceHandler) GetCreditNotes(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	creditNotes, err := h.invoiceService.GetCreditNotes(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, creditNotes)
+}
+
+// Void handles POST /api/invoices/:id/void requests
+func (h *InvoiceHandler) Void(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	invoice, err := h.invoiceService.Void(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, invoice)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	invoiceTemplateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoicetemplate"
+	"github.com/labstack/echo/v4"
+)
+
+// InvoiceTemplateHandler handles recurring invoice template HTTP requests
+type InvoiceTemplateHandler struct {
+	invoiceTemplateService invoiceTemplateSvc.InvoiceTemplateService
+	pagination             Pagination
+}
+
+// NewInvoiceTemplateHandler creates a new instance of InvoiceTemplateHandler
+func NewInvoiceTemplateHandler(invoiceTemplateService invoiceTemplateSvc.InvoiceTemplateService, pagination Pagination) *InvoiceTemplateHandler {
+	return &InvoiceTemplateHandler{
+		invoiceTemplateService: invoiceTemplateService,
+		pagination:             pagination,
+	}
+}
+
+// Create handles POST /api/invoice-templates requests
+func (h *InvoiceTemplateHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := &request.CreateInvoiceTemplateRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	template, err := h.invoiceTemplateService.Create(c.Request().Context(), ownerID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, template)
+}
+
+// GetByID handles GET /api/invoice-templates/:id requests
+func (h *InvoiceTemplateHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	template, err := h.invoiceTemplateService.GetByID(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+// Update handles PUT /api/invoice-templates/:id requests
+func (h *InvoiceTemplateHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.UpdateInvoiceTemplateRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	template, err := h.invoiceTemplateService.Update(c.Request().Context(), ownerID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, template)
+}
+
+// Delete handles DELETE /api/invoice-templates/:id requests
+func (h *InvoiceTemplateHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.invoiceTemplateService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "invoice template deleted successfully",
+	})
+}
+
+// GetAll handles GET /api/invoice-templates requests
+func (h *InvoiceTemplateHandler) GetAll(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
+	}
+
+	templates, err := h.invoiceTemplateService.GetAll(c.Request().Context(), ownerID, page, limit)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, templates)
+}
@@ -2,9 +2,10 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	itemSvc "github.com/kamil5b/clean-go-vite-react/backend/service/item"
 	"github.com/labstack/echo/v4"
@@ -13,29 +14,32 @@ import (
 // ItemHandler handles item-related HTTP requests
 type ItemHandler struct {
 	itemService itemSvc.ItemService
+	pagination  Pagination
 }
 
 // NewItemHandler creates a new instance of ItemHandler
-func NewItemHandler(itemService itemSvc.ItemService) *ItemHandler {
+func NewItemHandler(itemService itemSvc.ItemService, pagination Pagination) *ItemHandler {
 	return &ItemHandler{
 		itemService: itemService,
+		pagination:  pagination,
 	}
 }
 
 // Create handles POST /api/items requests
 func (h *ItemHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	req := &request.CreateItemRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	item, err := h.itemService.Create(c.Request().Context(), req)
+	item, err := h.itemService.Create(c.Request().Context(), ownerID, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, item)
@@ -43,18 +47,19 @@ func (h *ItemHandler) Create(c echo.Context) error {
 
 // GetByID handles GET /api/items/:id requests
 func (h *ItemHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	item, err := h.itemService.GetByID(c.Request().Context(), uuid.UUID(id))
+	item, err := h.itemService.GetByID(c.Request().Context(), ownerID, id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, item)
@@ -62,25 +67,24 @@ func (h *ItemHandler) GetByID(c echo.Context) error {
 
 // Update handles PUT /api/items/:id requests
 func (h *ItemHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
 	req := &request.UpdateItemRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	item, err := h.itemService.Update(c.Request().Context(), id, req)
+	item, err := h.itemService.Update(c.Request().Context(), ownerID, id, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, item)
@@ -88,17 +92,18 @@ func (h *ItemHandler) Update(c echo.Context) error {
 
 // Delete handles DELETE /api/items/:id requests
 func (h *ItemHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	if err := h.itemService.Delete(c.Request().Context(), id); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+	if err := h.itemService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -108,22 +113,23 @@ func (h *ItemHandler) Delete(c echo.Context) error {
 
 // GetAll handles GET /api/items requests
 func (h *ItemHandler) GetAll(c echo.Context) error {
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	limit, _ := strconv.Atoi(c.QueryParam("limit"))
-	search := c.QueryParam("search")
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
 
-	if page < 1 {
-		page = 1
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
 	}
-	if limit < 1 {
-		limit = 10
+	spec, err := query.Parse(c.QueryParams(), itemSvc.QueryFields)
+	if err != nil {
+		return err
 	}
 
-	items, err := h.itemService.GetAll(c.Request().Context(), page, limit, search)
+	items, err := h.itemService.GetAll(c.Request().Context(), ownerID, page, limit, spec)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, items)
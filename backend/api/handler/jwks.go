@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+	"github.com/labstack/echo/v4"
+)
+
+// jwksMaxAge is how long clients may cache the key set, in seconds. A new
+// key must be published at least this long before it starts signing.
+const jwksMaxAge = "300"
+
+// JWKSHandler publishes the keys access tokens are verified with
+type JWKSHandler struct {
+	tokenService token.TokenService
+}
+
+// NewJWKSHandler creates a new instance of JWKSHandler
+func NewJWKSHandler(tokenService token.TokenService) *JWKSHandler {
+	return &JWKSHandler{
+		tokenService: tokenService,
+	}
+}
+
+// Get handles GET /.well-known/jwks.json requests
+func (h *JWKSHandler) Get(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", "public, max-age="+jwksMaxAge)
+	return c.JSON(http.StatusOK, h.tokenService.JWKS())
+}
@@ -23,9 +23,7 @@ func NewMessageHandler(svc message.MessageService) *MessageHandler {
 func (h *MessageHandler) GetMessage(c echo.Context) error {
 	message, err := h.service.GetMessage(c.Request().Context())
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, message)
@@ -1,8 +1,7 @@
 package handler
 
 import (
-	"net/http"
-
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
 	"github.com/labstack/echo/v4"
 )
 
@@ -16,10 +15,5 @@ func NewNotFoundHandler() *NotFoundHandler {
 
 // Handle handles GET /api/* for undefined endpoints
 func (h *NotFoundHandler) Handle(c echo.Context) error {
-	return c.JSON(http.StatusNotFound, map[string]interface{}{
-		"error":   "Not Found",
-		"message": "The requested API endpoint does not exist",
-		"path":    c.Request().URL.Path,
-		"method":  c.Request().Method,
-	})
+	return apperror.Errorf(apperror.ErrNotFound, "route_not_found", "the requested API endpoint %s %s does not exist", c.Request().Method, c.Request().URL.Path)
 }
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	errInvalidPage  = apperror.New(apperror.ErrBadRequest, "invalid_page", "page must be a whole number of at least 1")
+	errInvalidLimit = apperror.New(apperror.ErrBadRequest, "invalid_limit", "limit must be a whole number of at least 1")
+)
+
+// Pagination holds the page sizes list endpoints accept
+type Pagination struct {
+	// DefaultLimit is the page size when ?limit= is not given
+	DefaultLimit int
+	// MaxLimit caps ?limit=, so one request cannot load a whole table
+	MaxLimit int
+}
+
+// parse reads ?page= and ?limit=, defaulting to the first page of
+// DefaultLimit rows. A limit above MaxLimit is lowered to it, and a page
+// or limit that is not a positive whole number is an error.
+func (p Pagination) parse(c echo.Context) (page, limit int, err error) {
+	page, limit = 1, p.DefaultLimit
+
+	if value := c.QueryParam("page"); value != "" {
+		if page, err = strconv.Atoi(value); err != nil || page < 1 {
+			return 0, 0, errInvalidPage
+		}
+	}
+	if value := c.QueryParam("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			return 0, 0, errInvalidLimit
+		}
+	}
+	if limit > p.MaxLimit {
+		limit = p.MaxLimit
+	}
+
+	return page, limit, nil
+}
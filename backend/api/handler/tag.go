@@ -2,9 +2,10 @@ package handler
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	tagSvc "github.com/kamil5b/clean-go-vite-react/backend/service/tag"
 	"github.com/labstack/echo/v4"
@@ -13,29 +14,32 @@ import (
 // TagHandler handles tag-related HTTP requests
 type TagHandler struct {
 	tagService tagSvc.TagService
+	pagination Pagination
 }
 
 // NewTagHandler creates a new instance of TagHandler
-func NewTagHandler(tagService tagSvc.TagService) *TagHandler {
+func NewTagHandler(tagService tagSvc.TagService, pagination Pagination) *TagHandler {
 	return &TagHandler{
 		tagService: tagService,
+		pagination: pagination,
 	}
 }
 
 // Create handles POST /api/tags requests
 func (h *TagHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	req := &request.CreateTagRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	tag, err := h.tagService.Create(c.Request().Context(), req)
+	tag, err := h.tagService.Create(c.Request().Context(), ownerID, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, tag)
@@ -43,18 +47,19 @@ func (h *TagHandler) Create(c echo.Context) error {
 
 // GetByID handles GET /api/tags/:id requests
 func (h *TagHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	tag, err := h.tagService.GetByID(c.Request().Context(), uuid.UUID(id))
+	tag, err := h.tagService.GetByID(c.Request().Context(), ownerID, id)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, tag)
@@ -62,25 +67,24 @@ func (h *TagHandler) GetByID(c echo.Context) error {
 
 // Update handles PUT /api/tags/:id requests
 func (h *TagHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
 	req := &request.UpdateTagRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
+		return err
 	}
 
-	tag, err := h.tagService.Update(c.Request().Context(), uuid.UUID(id), req)
+	tag, err := h.tagService.Update(c.Request().Context(), ownerID, id, req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, tag)
@@ -88,17 +92,18 @@ func (h *TagHandler) Update(c echo.Context) error {
 
 // Delete handles DELETE /api/tags/:id requests
 func (h *TagHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid id",
-		})
+		return errInvalidID
 	}
 
-	if err := h.tagService.Delete(c.Request().Context(), uuid.UUID(id)); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+	if err := h.tagService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -108,22 +113,23 @@ func (h *TagHandler) Delete(c echo.Context) error {
 
 // GetAll handles GET /api/tags requests
 func (h *TagHandler) GetAll(c echo.Context) error {
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	limit, _ := strconv.Atoi(c.QueryParam("limit"))
-	search := c.QueryParam("search")
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
 
-	if page < 1 {
-		page = 1
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
 	}
-	if limit < 1 {
-		limit = 10
+	spec, err := query.Parse(c.QueryParams(), tagSvc.QueryFields)
+	if err != nil {
+		return err
 	}
 
-	tags, err := h.tagService.GetAll(c.Request().Context(), page, limit, search)
+	tags, err := h.tagService.GetAll(c.Request().Context(), ownerID, page, limit, spec)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, tags)
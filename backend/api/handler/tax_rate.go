@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	taxRateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/taxrate"
+	"github.com/labstack/echo/v4"
+)
+
+// TaxRateHandler handles tax rate-related HTTP requests
+type TaxRateHandler struct {
+	taxRateService taxRateSvc.TaxRateService
+	pagination     Pagination
+}
+
+// NewTaxRateHandler creates a new instance of TaxRateHandler
+func NewTaxRateHandler(taxRateService taxRateSvc.TaxRateService, pagination Pagination) *TaxRateHandler {
+	return &TaxRateHandler{
+		taxRateService: taxRateService,
+		pagination:     pagination,
+	}
+}
+
+// Create handles POST /api/tax-rates requests
+func (h *TaxRateHandler) Create(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	req := &request.CreateTaxRateRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	taxRate, err := h.taxRateService.Create(c.Request().Context(), ownerID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, taxRate)
+}
+
+// GetByID handles GET /api/tax-rates/:id requests
+func (h *TaxRateHandler) GetByID(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	taxRate, err := h.taxRateService.GetByID(c.Request().Context(), ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, taxRate)
+}
+
+// Update handles PUT /api/tax-rates/:id requests
+func (h *TaxRateHandler) Update(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	req := &request.UpdateTaxRateRequest{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	taxRate, err := h.taxRateService.Update(c.Request().Context(), ownerID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, taxRate)
+}
+
+// Delete handles DELETE /api/tax-rates/:id requests
+func (h *TaxRateHandler) Delete(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return errInvalidID
+	}
+
+	if err := h.taxRateService.Delete(c.Request().Context(), ownerID, id); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "tax rate deleted successfully",
+	})
+}
+
+// GetAll handles GET /api/tax-rates requests
+func (h *TaxRateHandler) GetAll(c echo.Context) error {
+	ownerID, err := middleware.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	page, limit, err := h.pagination.parse(c)
+	if err != nil {
+		return err
+	}
+	search := c.QueryParam("search")
+
+	taxRates, err := h.taxRateService.GetAll(c.Request().Context(), ownerID, page, limit, search)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, taxRates)
+}
@@ -1,29 +1,40 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	accountSvc "github.com/kamil5b/clean-go-vite-react/backend/service/account"
 	"github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
 	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
 	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
 	"github.com/labstack/echo/v4"
 )
 
+var (
+	errMissingRefreshToken = apperror.New(apperror.ErrUnauthorized, "missing_refresh_token", "missing refresh token")
+	errUnauthenticated     = apperror.New(apperror.ErrUnauthorized, "unauthorized", "unauthorized")
+)
+
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	userService  userSvc.UserService
-	tokenService token.TokenService
-	csrfService  csrf.CSRFService
+	userService    userSvc.UserService
+	accountService accountSvc.AccountService
+	tokenService   token.TokenService
+	csrfService    csrf.CSRFService
 }
 
 // NewUserHandler creates a new instance of UserHandler
-func NewUserHandler(userService userSvc.UserService, tokenService token.TokenService, csrfService csrf.CSRFService) *UserHandler {
+func NewUserHandler(userService userSvc.UserService, accountService accountSvc.AccountService, tokenService token.TokenService, csrfService csrf.CSRFService) *UserHandler {
 	return &UserHandler{
-		userService:  userService,
-		tokenService: tokenService,
-		csrfService:  csrfService,
+		userService:    userService,
+		accountService: accountService,
+		tokenService:   tokenService,
+		csrfService:    csrfService,
 	}
 }
 
@@ -31,54 +42,24 @@ func NewUserHandler(userService userSvc.UserService, tokenService token.TokenSer
 func (h *UserHandler) Register(c echo.Context) error {
 	req := &request.RegisterUserRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
-	}
-
-	// Validate input
-	if req.Email == "" || req.Password == "" || req.Name == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "email, password, and name are required",
-		})
+		return err
 	}
 
 	// Register user
 	resp, err := h.userService.Register(c.Request().Context(), req)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
-	// Generate refresh token
-	refreshToken, err := h.tokenService.GenerateRefreshToken(resp.User.ID)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to generate refresh token",
-		})
+	// Ask the new user to confirm their email. The account exists either
+	// way, so a mail failure is logged rather than failing the sign-up;
+	// they can ask for another link.
+	if err := h.accountService.SendVerification(c.Request().Context(), resp.User.ID); err != nil {
+		c.Logger().Errorf("failed to send verification email: %v", err)
 	}
 
 	// Set HTTP-only cookies for both access and refresh tokens
-	c.SetCookie(&http.Cookie{
-		Name:     middleware.AccessTokenCookie,
-		Value:    resp.Token,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   15 * 60, // 15 minutes
-	})
-
-	c.SetCookie(&http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   7 * 24 * 60 * 60, // 7 days
-	})
+	setAuthCookies(c, resp.Token, resp.RefreshToken)
 
 	return c.JSON(http.StatusCreated, resp)
 }
@@ -87,54 +68,17 @@ func (h *UserHandler) Register(c echo.Context) error {
 func (h *UserHandler) Login(c echo.Context) error {
 	req := &request.LoginRequest{}
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "invalid request body",
-		})
-	}
-
-	// Validate input
-	if req.Email == "" || req.Password == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "email and password are required",
-		})
+		return err
 	}
 
 	// Login user
-	resp, err := h.userService.Login(c.Request().Context(), req)
+	resp, err := h.userService.Login(c.Request().Context(), req, c.RealIP())
 	if err != nil {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": err.Error(),
-		})
-	}
-
-	// Generate refresh token
-	refreshToken, err := h.tokenService.GenerateRefreshToken(resp.User.ID)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to generate refresh token",
-		})
+		return err
 	}
 
 	// Set HTTP-only cookies for both access and refresh tokens
-	c.SetCookie(&http.Cookie{
-		Name:     middleware.AccessTokenCookie,
-		Value:    resp.Token,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   15 * 60, // 15 minutes
-	})
-
-	c.SetCookie(&http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   7 * 24 * 60 * 60, // 7 days
-	})
+	setAuthCookies(c, resp.Token, resp.RefreshToken)
 
 	return c.JSON(http.StatusOK, resp)
 }
@@ -142,53 +86,35 @@ func (h *UserHandler) Login(c echo.Context) error {
 // Refresh handles POST /api/auth/refresh requests
 func (h *UserHandler) Refresh(c echo.Context) error {
 	// Get refresh token from cookie
-	cookie, err := c.Cookie("refresh_token")
+	cookie, err := c.Cookie(middleware.RefreshTokenCookie)
 	if err != nil {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "missing refresh token",
-		})
+		return errMissingRefreshToken
 	}
 
-	// Refresh access token
+	// Rotate refresh token and issue a new access token
 	resp, err := h.userService.Refresh(c.Request().Context(), cookie.Value)
 	if err != nil {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": err.Error(),
-		})
+		clearAuthCookies(c)
+		return err
 	}
 
-	// Set new access token cookie
-	c.SetCookie(&http.Cookie{
-		Name:     middleware.AccessTokenCookie,
-		Value:    resp.Token,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false, // Set to true in production with HTTPS
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   15 * 60, // 15 minutes
-	})
+	// Replace both cookies with the rotated tokens
+	setAuthCookies(c, resp.Token, resp.RefreshToken)
 
 	return c.JSON(http.StatusOK, resp)
 }
 
 // Logout handles POST /api/auth/logout requests
 func (h *UserHandler) Logout(c echo.Context) error {
-	// Clear cookies
-	c.SetCookie(&http.Cookie{
-		Name:     middleware.AccessTokenCookie,
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	// Revoke the stored refresh token server-side
+	if cookie, err := c.Cookie(middleware.RefreshTokenCookie); err == nil {
+		if err := h.userService.Logout(c.Request().Context(), cookie.Value); err != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+	}
 
-	c.SetCookie(&http.Cookie{
-		Name:     "refresh_token",
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	// Clear cookies
+	clearAuthCookies(c)
 
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "logged out successfully",
@@ -199,16 +125,12 @@ func (h *UserHandler) Logout(c echo.Context) error {
 func (h *UserHandler) GetMe(c echo.Context) error {
 	userID := c.Get(middleware.UserIDCtxKey)
 	if userID == nil {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "unauthorized",
-		})
+		return errUnauthenticated
 	}
 
 	user, err := h.userService.GetUser(c.Request().Context(), userID.(string))
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, user)
@@ -216,14 +138,65 @@ func (h *UserHandler) GetMe(c echo.Context) error {
 
 // GetCSRFToken handles GET /api/csrf requests
 func (h *UserHandler) GetCSRFToken(c echo.Context) error {
-	token, err := h.csrfService.GenerateToken()
+	token, err := h.csrfService.GenerateToken(middleware.GetCSRFSubject(c))
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error": "failed to generate csrf token",
-		})
+		return fmt.Errorf("failed to generate csrf token: %w", err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"token": token,
+	// Double-submit cookie checked against the X-CSRF-Token header
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.CSRFTokenCookie,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   false, // Set to true in production with HTTPS
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(h.csrfService.TokenExpiry().Seconds()),
+	})
+
+	return c.JSON(http.StatusOK, response.CSRFTokenResponse{
+		Token: token,
+	})
+}
+
+// setAuthCookies stores the access and refresh tokens in HTTP-only cookies
+func setAuthCookies(c echo.Context, accessToken, refreshToken string) {
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.AccessTokenCookie,
+		Value:    accessToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   false, // Set to true in production with HTTPS
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   15 * 60, // 15 minutes
+	})
+
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.RefreshTokenCookie,
+		Value:    refreshToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   false, // Set to true in production with HTTPS
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   7 * 24 * 60 * 60, // 7 days
+	})
+}
+
+// clearAuthCookies expires the access and refresh token cookies
+func clearAuthCookies(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.AccessTokenCookie,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		MaxAge:   -1,
+	})
+
+	c.SetCookie(&http.Cookie{
+		Name:     middleware.RefreshTokenCookie,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		MaxAge:   -1,
 	})
 }
@@ -1,91 +1,171 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"net/http"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/apikey"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
 	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
 	"github.com/labstack/echo/v4"
 )
 
 const (
-	AccessTokenCookie = "access_token"
+	AccessTokenCookie  = "access_token"
 	RefreshTokenCookie = "refresh_token"
-	UserIDCtxKey      = "user_id"
-	UserEmailCtxKey   = "user_email"
-	ClaimsCtxKey      = "claims"
+	CSRFTokenCookie    = "csrf_token"
+	CSRFTokenHeader    = "X-CSRF-Token"
+	UserIDCtxKey       = "user_id"
+	UserEmailCtxKey    = "user_email"
+	ClaimsCtxKey       = "claims"
+	AuthMethodCtxKey   = "auth_method"
 )
 
-// AuthMiddleware validates JWT token from HTTP-only cookie
-func AuthMiddleware(tokenService token.TokenService) echo.MiddlewareFunc {
+// AuthMethod is how a request was authenticated
+type AuthMethod string
+
+const (
+	// AuthMethodCookie is an access token in the access_token cookie
+	AuthMethodCookie AuthMethod = "cookie"
+	// AuthMethodBearer is an access token in the Authorization header
+	AuthMethodBearer AuthMethod = "bearer"
+	// AuthMethodAPIKey is a personal API key in the Authorization header
+	AuthMethodAPIKey AuthMethod = "api_key"
+)
+
+var (
+	errMissingToken      = apperror.New(apperror.ErrUnauthorized, "missing_token", "missing authentication token")
+	errInvalidToken      = apperror.New(apperror.ErrUnauthorized, "invalid_token", "invalid or expired token")
+	errInvalidAuthHeader = apperror.New(apperror.ErrUnauthorized, "invalid_authorization_header", "authorization header must be Bearer <token>")
+	errAPIKeyNotAllowed  = apperror.New(apperror.ErrForbidden, "api_key_not_allowed", "api keys cannot be used here")
+	errNoUserInContext   = apperror.New(apperror.ErrUnauthorized, "unauthorized", "user not found in context")
+	errInvalidUserID     = apperror.New(apperror.ErrBadRequest, "invalid_user_id", "invalid user id")
+	errMissingCSRFToken  = apperror.New(apperror.ErrForbidden, "missing_csrf_token", "missing csrf token")
+	errCSRFTokenMismatch = apperror.New(apperror.ErrForbidden, "csrf_token_mismatch", "csrf token mismatch")
+	errInvalidCSRFToken  = apperror.New(apperror.ErrForbidden, "invalid_csrf_token", "invalid csrf token")
+)
+
+// AuthMiddleware authenticates requests with an access token from the
+// Authorization header or the access_token cookie, or with a personal API
+// key from the Authorization header. The header wins when both are sent.
+func AuthMiddleware(tokenService token.TokenService, apiKeyService apikey.APIKeyService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Get token from HTTP-only cookie
-			cookie, err := c.Cookie(AccessTokenCookie)
+			claims, method, err := authenticate(c, tokenService, apiKeyService)
 			if err != nil {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "missing authentication token",
-				})
+				return err
 			}
 
-			// Validate token
-			claims, err := tokenService.ValidateAccessToken(cookie.Value)
-			if err != nil {
-				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "invalid or expired token",
-				})
-			}
+			setClaims(c, claims, method)
+			return next(c)
+		}
+	}
+}
 
-			// Store user info in context
-			c.Set(UserIDCtxKey, claims.UserID.String())
-			c.Set(UserEmailCtxKey, claims.Email)
-			c.Set(ClaimsCtxKey, claims)
+// OptionalAuthMiddleware authenticates like AuthMiddleware but lets
+// requests without valid credentials through anonymously
+func OptionalAuthMiddleware(tokenService token.TokenService, apiKeyService apikey.APIKeyService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if claims, method, err := authenticate(c, tokenService, apiKeyService); err == nil {
+				setClaims(c, claims, method)
+			}
 
 			return next(c)
 		}
 	}
 }
 
-// OptionalAuthMiddleware validates JWT but doesn't require it
-func OptionalAuthMiddleware(tokenService token.TokenService) echo.MiddlewareFunc {
+// DenyAPIKeys rejects requests authenticated with an API key, for routes
+// that must only be used from a signed-in session. It must run after
+// AuthMiddleware.
+func DenyAPIKeys() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Try to get token from HTTP-only cookie
-			cookie, err := c.Cookie(AccessTokenCookie)
-			if err != nil {
-				// Token not present, continue without authentication
-				return next(c)
+			if GetAuthMethod(c) == AuthMethodAPIKey {
+				return errAPIKeyNotAllowed
 			}
 
-			// Validate token
-			claims, err := tokenService.ValidateAccessToken(cookie.Value)
+			return next(c)
+		}
+	}
+}
+
+// authenticate reads the caller's credentials and returns their claims and
+// how they were presented
+func authenticate(c echo.Context, tokenService token.TokenService, apiKeyService apikey.APIKeyService) (*token.TokenClaims, AuthMethod, error) {
+	if header := c.Request().Header.Get(echo.HeaderAuthorization); header != "" {
+		scheme, credential, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || credential == "" {
+			return nil, "", errInvalidAuthHeader
+		}
+
+		if strings.HasPrefix(credential, apikey.KeyPrefix) {
+			claims, err := apiKeyService.Authenticate(c.Request().Context(), credential)
 			if err != nil {
-				// Invalid token, continue without authentication
-				return next(c)
+				return nil, "", err
 			}
+			return claims, AuthMethodAPIKey, nil
+		}
 
-			// Store user info in context
-			c.Set(UserIDCtxKey, claims.UserID.String())
-			c.Set(UserEmailCtxKey, claims.Email)
-			c.Set(ClaimsCtxKey, claims)
-
-			return next(c)
+		claims, err := tokenService.ValidateAccessToken(credential)
+		if err != nil {
+			return nil, "", errInvalidToken
 		}
+		return claims, AuthMethodBearer, nil
+	}
+
+	cookie, err := c.Cookie(AccessTokenCookie)
+	if err != nil {
+		return nil, "", errMissingToken
+	}
+
+	claims, err := tokenService.ValidateAccessToken(cookie.Value)
+	if err != nil {
+		return nil, "", errInvalidToken
 	}
+	return claims, AuthMethodCookie, nil
+}
+
+// setClaims stores the authenticated user's info in the context
+func setClaims(c echo.Context, claims *token.TokenClaims, method AuthMethod) {
+	c.Set(UserIDCtxKey, claims.UserID.String())
+	c.Set(UserEmailCtxKey, claims.Email)
+	c.Set(ClaimsCtxKey, claims)
+	c.Set(AuthMethodCtxKey, method)
 }
 
-// CSRFMiddleware validates CSRF tokens for state-changing operations
-func CSRFMiddleware() echo.MiddlewareFunc {
+// CSRFMiddleware validates CSRF tokens for state-changing operations.
+// It uses the double-submit pattern: the X-CSRF-Token header must match the
+// csrf_token cookie, and the token must carry a valid, unexpired signature
+// for the authenticated user. Requests authenticated by the Authorization
+// header skip the check: browsers never attach it on their own, so it
+// cannot be forged cross-site.
+func CSRFMiddleware(csrfService csrf.CSRFService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			if method := GetAuthMethod(c); method == AuthMethodBearer || method == AuthMethodAPIKey {
+				return next(c)
+			}
+
 			// Only validate for state-changing operations
 			switch c.Request().Method {
 			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
-				csrfToken := c.Request().Header.Get("X-CSRF-Token")
+				csrfToken := c.Request().Header.Get(CSRFTokenHeader)
 				if csrfToken == "" {
-					return c.JSON(http.StatusForbidden, map[string]string{
-						"error": "missing csrf token",
-					})
+					return errMissingCSRFToken
+				}
+
+				cookie, err := c.Cookie(CSRFTokenCookie)
+				if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(csrfToken)) != 1 {
+					return errCSRFTokenMismatch
+				}
+
+				if !csrfService.ValidateToken(csrfToken, GetCSRFSubject(c)) {
+					return errInvalidCSRFToken
 				}
 				c.Set("csrf_token", csrfToken)
 			}
@@ -95,16 +175,23 @@ func CSRFMiddleware() echo.MiddlewareFunc {
 	}
 }
 
+// GetCSRFSubject returns the identity CSRF tokens are bound to: the
+// authenticated user ID, or an empty string for anonymous requests
+func GetCSRFSubject(c echo.Context) string {
+	userID, _ := c.Get(UserIDCtxKey).(string)
+	return userID
+}
+
 // GetUserIDFromContext extracts user ID from context
 func GetUserIDFromContext(c echo.Context) (uuid.UUID, error) {
 	userID := c.Get(UserIDCtxKey)
 	if userID == nil {
-		return uuid.UUID{}, echo.NewHTTPError(http.StatusUnauthorized, "user not found in context")
+		return uuid.UUID{}, errNoUserInContext
 	}
 
 	id, err := uuid.Parse(userID.(string))
 	if err != nil {
-		return uuid.UUID{}, echo.NewHTTPError(http.StatusBadRequest, "invalid user id")
+		return uuid.UUID{}, errInvalidUserID
 	}
 
 	return id, nil
@@ -119,6 +206,13 @@ func GetEmailFromContext(c echo.Context) string {
 	return email.(string)
 }
 
+// GetAuthMethod returns how the request was authenticated, or an empty
+// string for anonymous requests
+func GetAuthMethod(c echo.Context) AuthMethod {
+	method, _ := c.Get(AuthMethodCtxKey).(AuthMethod)
+	return method
+}
+
 // GetClaimsFromContext extracts token claims from context
 func GetClaimsFromContext(c echo.Context) *token.TokenClaims {
 	claims := c.Get(ClaimsCtxKey)
@@ -0,0 +1,208 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/apikey"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+	"github.com/labstack/echo/v4"
+)
+
+const testAPIKey = apikey.KeyPrefix + "test-key"
+
+// stubAPIKeyService knows one API key, held by an admin and scoped to
+// reading invoices
+type stubAPIKeyService struct {
+	apikey.APIKeyService
+	userID uuid.UUID
+}
+
+func (s stubAPIKeyService) Authenticate(ctx context.Context, key string) (*token.TokenClaims, error) {
+	if key != testAPIKey {
+		return nil, apikey.ErrInvalidAPIKey
+	}
+	return &token.TokenClaims{UserID: s.userID, Roles: []string{"admin"}, Scopes: []string{"invoice:read"}}, nil
+}
+
+func newTestServer(t *testing.T) (*echo.Echo, string) {
+	t.Helper()
+
+	tokenService := token.NewTokenService(token.TokenConfig{
+		AccessTokenSecret: "test-access-secret",
+		AccessTokenExpiry: 15 * time.Minute,
+	})
+	accessToken, err := tokenService.GenerateAccessToken(uuid.New(), "test@example.com", "Test User", []string{"admin"})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		code := "internal_error"
+		if appErr, ok := err.(*apperror.Error); ok {
+			code = appErr.Code
+		}
+		_ = c.JSON(http.StatusTeapot, response.ProblemResponse{Code: code})
+	}
+	ok := func(c echo.Context) error {
+		return c.String(http.StatusOK, string(GetAuthMethod(c)))
+	}
+
+	protected := e.Group("", AuthMiddleware(tokenService, stubAPIKeyService{userID: uuid.New()}))
+	csrfProtection := CSRFMiddleware(csrf.NewCSRFService(csrf.CSRFConfig{Secret: "test-csrf-secret", Expiry: time.Hour}))
+	protected.GET("/me", ok)
+	protected.POST("/invoices", ok, RequirePermission(rbac.InvoiceWrite), csrfProtection)
+	protected.GET("/invoices", ok, RequirePermission(rbac.InvoiceRead))
+	protected.GET("/api-keys", ok, DenyAPIKeys())
+
+	return e, accessToken
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	e, accessToken := newTestServer(t)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		cookie         string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+		expectedCode   string
+	}{
+		{
+			name:           "should accept an access token cookie",
+			method:         http.MethodGet,
+			path:           "/me",
+			cookie:         accessToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "cookie",
+		},
+		{
+			name:           "should accept a bearer access token",
+			method:         http.MethodGet,
+			path:           "/me",
+			authorization:  "Bearer " + accessToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "bearer",
+		},
+		{
+			name:           "should accept the bearer scheme in any case",
+			method:         http.MethodGet,
+			path:           "/me",
+			authorization:  "bearer " + accessToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "bearer",
+		},
+		{
+			name:           "should accept an API key",
+			method:         http.MethodGet,
+			path:           "/me",
+			authorization:  "Bearer " + testAPIKey,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "api_key",
+		},
+		{
+			name:          "should prefer the header over the cookie",
+			method:        http.MethodGet,
+			path:          "/me",
+			cookie:        accessToken,
+			authorization: "Bearer not-a-token",
+			expectedCode:  "invalid_token",
+		},
+		{
+			name:         "should reject a request without credentials",
+			method:       http.MethodGet,
+			path:         "/me",
+			expectedCode: "missing_token",
+		},
+		{
+			name:          "should reject another authorization scheme",
+			method:        http.MethodGet,
+			path:          "/me",
+			authorization: "Basic dXNlcjpwYXNz",
+			expectedCode:  "invalid_authorization_header",
+		},
+		{
+			name:          "should reject an unknown API key",
+			method:        http.MethodGet,
+			path:          "/me",
+			authorization: "Bearer " + apikey.KeyPrefix + "unknown",
+			expectedCode:  "invalid_api_key",
+		},
+		{
+			name:         "should require a CSRF token with a cookie",
+			method:       http.MethodPost,
+			path:         "/invoices",
+			cookie:       accessToken,
+			expectedCode: "missing_csrf_token",
+		},
+		{
+			name:           "should skip CSRF for a bearer access token",
+			method:         http.MethodPost,
+			path:           "/invoices",
+			authorization:  "Bearer " + accessToken,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "bearer",
+		},
+		{
+			name:           "should allow an API key what it is scoped to",
+			method:         http.MethodGet,
+			path:           "/invoices",
+			authorization:  "Bearer " + testAPIKey,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "api_key",
+		},
+		{
+			name:          "should deny an API key what it is not scoped to",
+			method:        http.MethodPost,
+			path:          "/invoices",
+			authorization: "Bearer " + testAPIKey,
+			expectedCode:  "permission_denied",
+		},
+		{
+			name:          "should deny API keys where only sessions are allowed",
+			method:        http.MethodGet,
+			path:          "/api-keys",
+			authorization: "Bearer " + testAPIKey,
+			expectedCode:  "api_key_not_allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: AccessTokenCookie, Value: tt.cookie})
+			}
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if tt.expectedCode != "" {
+				var problem response.ProblemResponse
+				_ = json.Unmarshal(rec.Body.Bytes(), &problem)
+				if problem.Code != tt.expectedCode {
+					t.Errorf("expected code %s, got %d %s", tt.expectedCode, rec.Code, rec.Body.String())
+				}
+				return
+			}
+			if rec.Code != tt.expectedStatus || rec.Body.String() != tt.expectedBody {
+				t.Errorf("expected %d %s, got %d %s", tt.expectedStatus, tt.expectedBody, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
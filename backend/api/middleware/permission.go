@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"slices"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/labstack/echo/v4"
+)
+
+// RequirePermission lets a request through only when one of the caller's
+// roles grants permission and, for an API key, the key is scoped to it. It
+// must run after AuthMiddleware.
+func RequirePermission(permission rbac.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims := GetClaimsFromContext(c)
+			if claims == nil {
+				return errNoUserInContext
+			}
+
+			if !rbac.Allows(claims.Roles, permission) ||
+				(GetAuthMethod(c) == AuthMethodAPIKey && !slices.Contains(claims.Scopes, string(permission))) {
+				return apperror.Errorf(apperror.ErrForbidden, "permission_denied", "missing permission %s", permission)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package api
 import (
 	"github.com/kamil5b/clean-go-vite-react/backend/api/handler"
 	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/apikey"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
 	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
 	"github.com/labstack/echo/v4"
 )
@@ -14,10 +17,19 @@ func SetupRoutes(
 	counterHandler handler.CounterHandler,
 	userHandler *handler.UserHandler,
 	tokenService token.TokenService,
+	apiKeyService apikey.APIKeyService,
+	csrfService csrf.CSRFService,
 	notFoundHandler *handler.NotFoundHandler,
 	itemHandler *handler.ItemHandler,
 	tagHandler *handler.TagHandler,
+	customerHandler *handler.CustomerHandler,
+	taxRateHandler *handler.TaxRateHandler,
+	exchangeRateHandler *handler.ExchangeRateHandler,
 	invoiceHandler *handler.InvoiceHandler,
+	invoiceTemplateHandler *handler.InvoiceTemplateHandler,
+	adminHandler *handler.AdminHandler,
+	apiKeyHandler *handler.APIKeyHandler,
+	accountHandler *handler.AccountHandler,
 ) {
 	api := e.Group("/api")
 
@@ -28,44 +40,104 @@ func SetupRoutes(
 	api.POST("/auth/register", userHandler.Register)
 	api.POST("/auth/login", userHandler.Login)
 	api.POST("/auth/refresh", userHandler.Refresh)
-	api.GET("/csrf", userHandler.GetCSRFToken)
+	// Emailed single-use tokens are the credential for these
+	api.POST("/auth/password/forgot", accountHandler.ForgotPassword)
+	api.POST("/auth/password/reset", accountHandler.ResetPassword)
+	api.POST("/auth/verify-email", accountHandler.VerifyEmail)
+	// CSRF tokens are bound to the caller's session when one is present
+	api.GET("/csrf", userHandler.GetCSRFToken, middleware.OptionalAuthMiddleware(tokenService, apiKeyService))
 
-	// Protected routes (require authentication)
+	// Protected routes (require an access token or API key)
 	protected := api.Group("")
-	protected.Use(middleware.AuthMiddleware(tokenService))
+	protected.Use(middleware.AuthMiddleware(tokenService, apiKeyService))
+	csrfProtection := middleware.CSRFMiddleware(csrfService)
+	// Resource routes also need a permission granted by the caller's
+	// roles; see model/rbac
+	require := middleware.RequirePermission
 
 	// Auth protected endpoints
 	protected.GET("/auth/me", userHandler.GetMe)
 
 	// Logout requires auth + CSRF protection (it's a POST request)
-	protected.POST("/auth/logout", userHandler.Logout, middleware.CSRFMiddleware())
+	protected.POST("/auth/logout", userHandler.Logout, csrfProtection)
+
+	// Email a new verification link to the signed-in user
+	protected.POST("/auth/verify-email/resend", accountHandler.ResendVerification, csrfProtection)
+
+	// Personal API keys are managed from a signed-in session, so a leaked
+	// key cannot mint more keys
+	denyAPIKeys := middleware.DenyAPIKeys()
+	protected.GET("/auth/api-keys", apiKeyHandler.List, denyAPIKeys)
+	protected.POST("/auth/api-keys", apiKeyHandler.Create, denyAPIKeys, csrfProtection)
+	protected.DELETE("/auth/api-keys/:id", apiKeyHandler.Revoke, denyAPIKeys, csrfProtection)
 
 	// Counter endpoints (protected)
 	protected.GET("/counter", counterHandler.GetCounter)
 
 	// Counter POST requires auth + CSRF protection
-	protected.POST("/counter", counterHandler.IncrementCounter, middleware.CSRFMiddleware())
+	protected.POST("/counter", counterHandler.IncrementCounter, csrfProtection)
 
 	// Item endpoints (protected)
-	protected.GET("/items", itemHandler.GetAll)
-	protected.GET("/items/:id", itemHandler.GetByID)
-	protected.POST("/items", itemHandler.Create, middleware.CSRFMiddleware())
-	protected.PUT("/items/:id", itemHandler.Update, middleware.CSRFMiddleware())
-	protected.DELETE("/items/:id", itemHandler.Delete, middleware.CSRFMiddleware())
+	protected.GET("/items", itemHandler.GetAll, require(rbac.ItemRead))
+	protected.GET("/items/:id", itemHandler.GetByID, require(rbac.ItemRead))
+	protected.POST("/items", itemHandler.Create, require(rbac.ItemWrite), csrfProtection)
+	protected.PUT("/items/:id", itemHandler.Update, require(rbac.ItemWrite), csrfProtection)
+	protected.DELETE("/items/:id", itemHandler.Delete, require(rbac.ItemWrite), csrfProtection)
 
 	// Tag endpoints (protected)
-	protected.GET("/tags", tagHandler.GetAll)
-	protected.GET("/tags/:id", tagHandler.GetByID)
-	protected.POST("/tags", tagHandler.Create, middleware.CSRFMiddleware())
-	protected.PUT("/tags/:id", tagHandler.Update, middleware.CSRFMiddleware())
-	protected.DELETE("/tags/:id", tagHandler.Delete, middleware.CSRFMiddleware())
+	protected.GET("/tags", tagHandler.GetAll, require(rbac.TagRead))
+	protected.GET("/tags/:id", tagHandler.GetByID, require(rbac.TagRead))
+	protected.POST("/tags", tagHandler.Create, require(rbac.TagWrite), csrfProtection)
+	protected.PUT("/tags/:id", tagHandler.Update, require(rbac.TagWrite), csrfProtection)
+	protected.DELETE("/tags/:id", tagHandler.Delete, require(rbac.TagWrite), csrfProtection)
+
+	// Customer endpoints (protected)
+	protected.GET("/customers", customerHandler.GetAll, require(rbac.CustomerRead))
+	protected.GET("/customers/:id", customerHandler.GetByID, require(rbac.CustomerRead))
+	protected.POST("/customers", customerHandler.Create, require(rbac.CustomerWrite), csrfProtection)
+	protected.PUT("/customers/:id", customerHandler.Update, require(rbac.CustomerWrite), csrfProtection)
+	protected.DELETE("/customers/:id", customerHandler.Delete, require(rbac.CustomerWrite), csrfProtection)
+
+	// Tax rate endpoints (protected)
+	protected.GET("/tax-rates", taxRateHandler.GetAll, require(rbac.TaxRateRead))
+	protected.GET("/tax-rates/:id", taxRateHandler.GetByID, require(rbac.TaxRateRead))
+	protected.POST("/tax-rates", taxRateHandler.Create, require(rbac.TaxRateWrite), csrfProtection)
+	protected.PUT("/tax-rates/:id", taxRateHandler.Update, require(rbac.TaxRateWrite), csrfProtection)
+	protected.DELETE("/tax-rates/:id", taxRateHandler.Delete, require(rbac.TaxRateWrite), csrfProtection)
+
+	// Exchange rate endpoints (protected)
+	protected.GET("/exchange-rates", exchangeRateHandler.GetAll, require(rbac.ExchangeRateRead))
+	protected.GET("/exchange-rates/:id", exchangeRateHandler.GetByID, require(rbac.ExchangeRateRead))
+	protected.POST("/exchange-rates", exchangeRateHandler.Create, require(rbac.ExchangeRateWrite), csrfProtection)
+	protected.POST("/exchange-rates/import", exchangeRateHandler.Import, require(rbac.ExchangeRateWrite), csrfProtection)
+	protected.DELETE("/exchange-rates/:id", exchangeRateHandler.Delete, require(rbac.ExchangeRateWrite), csrfProtection)
 
 	// Invoice endpoints (protected)
-	protected.GET("/invoices", invoiceHandler.GetAll)
-	protected.GET("/invoices/:id", invoiceHandler.GetByID)
-	protected.POST("/invoices", invoiceHandler.Create, middleware.CSRFMiddleware())
-	protected.PUT("/invoices/:id", invoiceHandler.Update, middleware.CSRFMiddleware())
-	protected.DELETE("/invoices/:id", invoiceHandler.Delete, middleware.CSRFMiddleware())
+	protected.GET("/invoices", invoiceHandler.GetAll, require(rbac.InvoiceRead))
+	protected.GET("/invoices/summary", invoiceHandler.Summary, require(rbac.InvoiceRead))
+	protected.GET("/invoices/:id", invoiceHandler.GetByID, require(rbac.InvoiceRead))
+	protected.GET("/invoices/:id/pdf", invoiceHandler.GetPDF, require(rbac.InvoiceRead))
+	protected.POST("/invoices", invoiceHandler.Create, require(rbac.InvoiceWrite), csrfProtection)
+	protected.PUT("/invoices/:id", invoiceHandler.Update, require(rbac.InvoiceWrite), csrfProtection)
+	protected.DELETE("/invoices/:id", invoiceHandler.Delete, require(rbac.InvoiceWrite), csrfProtection)
+	protected.POST("/invoices/:id/issue", invoiceHandler.Issue, require(rbac.InvoiceWrite), csrfProtection)
+	protected.GET("/invoices/:id/payments", invoiceHandler.GetPayments, require(rbac.InvoiceRead))
+	protected.POST("/invoices/:id/payments", invoiceHandler.CreatePayment, require(rbac.InvoiceWrite), csrfProtection)
+	protected.GET("/invoices/:id/credit-notes", invoiceHandler.GetCreditNotes, require(rbac.InvoiceRead))
+	protected.POST("/invoices/:id/credit-notes", invoiceHandler.CreateCreditNote, require(rbac.InvoiceWrite), csrfProtection)
+	protected.POST("/invoices/:id/void", invoiceHandler.Void, require(rbac.InvoiceWrite), csrfProtection)
+
+	// Recurring invoice template routes
+	protected.GET("/invoice-templates", invoiceTemplateHandler.GetAll, require(rbac.InvoiceTemplateRead))
+	protected.GET("/invoice-templates/:id", invoiceTemplateHandler.GetByID, require(rbac.InvoiceTemplateRead))
+	protected.POST("/invoice-templates", invoiceTemplateHandler.Create, require(rbac.InvoiceTemplateWrite), csrfProtection)
+	protected.PUT("/invoice-templates/:id", invoiceTemplateHandler.Update, require(rbac.InvoiceTemplateWrite), csrfProtection)
+	protected.DELETE("/invoice-templates/:id", invoiceTemplateHandler.Delete, require(rbac.InvoiceTemplateWrite), csrfProtection)
+
+	// User administration routes
+	protected.GET("/admin/users", adminHandler.ListUsers, require(rbac.UserManage))
+	protected.PUT("/admin/users/:id/roles", adminHandler.UpdateRoles, require(rbac.UserManage), csrfProtection)
+	protected.POST("/admin/users/:id/unlock", adminHandler.Unlock, require(rbac.UserManage), csrfProtection)
 
 	api.Any("/*", notFoundHandler.Handle)
 }
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/handler"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/middleware"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+)
+
+// protectedRoutes is every route that requires a permission, with the
+// least privileged role granted it
+var protectedRoutes = []struct {
+	method     string
+	path       string
+	permission rbac.Permission
+	minRole    string
+}{
+	{http.MethodGet, "/api/items", rbac.ItemRead, "viewer"},
+	{http.MethodGet, "/api/items/:id", rbac.ItemRead, "viewer"},
+	{http.MethodPost, "/api/items", rbac.ItemWrite, "accountant"},
+	{http.MethodPut, "/api/items/:id", rbac.ItemWrite, "accountant"},
+	{http.MethodDelete, "/api/items/:id", rbac.ItemWrite, "accountant"},
+	{http.MethodGet, "/api/tags", rbac.TagRead, "viewer"},
+	{http.MethodGet, "/api/tags/:id", rbac.TagRead, "viewer"},
+	{http.MethodPost, "/api/tags", rbac.TagWrite, "accountant"},
+	{http.MethodPut, "/api/tags/:id", rbac.TagWrite, "accountant"},
+	{http.MethodDelete, "/api/tags/:id", rbac.TagWrite, "accountant"},
+	{http.MethodGet, "/api/customers", rbac.CustomerRead, "viewer"},
+	{http.MethodGet, "/api/customers/:id", rbac.CustomerRead, "viewer"},
+	{http.MethodPost, "/api/customers", rbac.CustomerWrite, "accountant"},
+	{http.MethodPut, "/api/customers/:id", rbac.CustomerWrite, "accountant"},
+	{http.MethodDelete, "/api/customers/:id", rbac.CustomerWrite, "accountant"},
+	{http.MethodGet, "/api/tax-rates", rbac.TaxRateRead, "viewer"},
+	{http.MethodGet, "/api/tax-rates/:id", rbac.TaxRateRead, "viewer"},
+	{http.MethodPost, "/api/tax-rates", rbac.TaxRateWrite, "accountant"},
+	{http.MethodPut, "/api/tax-rates/:id", rbac.TaxRateWrite, "accountant"},
+	{http.MethodDelete, "/api/tax-rates/:id", rbac.TaxRateWrite, "accountant"},
+	{http.MethodGet, "/api/exchange-rates", rbac.ExchangeRateRead, "viewer"},
+	{http.MethodGet, "/api/exchange-rates/:id", rbac.ExchangeRateRead, "viewer"},
+	{http.MethodPost, "/api/exchange-rates", rbac.ExchangeRateWrite, "accountant"},
+	{http.MethodPost, "/api/exchange-rates/import", rbac.ExchangeRateWrite, "accountant"},
+	{http.MethodDelete, "/api/exchange-rates/:id", rbac.ExchangeRateWrite, "accountant"},
+	{http.MethodGet, "/api/invoices", rbac.InvoiceRead, "viewer"},
+	{http.MethodGet, "/api/invoices/summary", rbac.InvoiceRead, "viewer"},
+	{http.MethodGet, "/api/invoices/:id", rbac.InvoiceRead, "viewer"},
+	{http.MethodGet, "/api/invoices/:id/pdf", rbac.InvoiceRead, "viewer"},
+	{http.MethodPost, "/api/invoices", rbac.InvoiceWrite, "accountant"},
+	{http.MethodPut, "/api/invoices/:id", rbac.InvoiceWrite, "accountant"},
+	{http.MethodDelete, "/api/invoices/:id", rbac.InvoiceWrite, "accountant"},
+	{http.MethodPost, "/api/invoices/:id/issue", rbac.InvoiceWrite, "accountant"},
+	{http.MethodGet, "/api/invoices/:id/payments", rbac.InvoiceRead, "viewer"},
+	{http.MethodPost, "/api/invoices/:id/payments", rbac.InvoiceWrite, "accountant"},
+	{http.MethodGet, "/api/invoices/:id/credit-notes", rbac.InvoiceRead, "viewer"},
+	{http.MethodPost, "/api/invoices/:id/credit-notes", rbac.InvoiceWrite, "accountant"},
+	{http.MethodPost, "/api/invoices/:id/void", rbac.InvoiceWrite, "accountant"},
+	{http.MethodGet, "/api/invoice-templates", rbac.InvoiceTemplateRead, "viewer"},
+	{http.MethodGet, "/api/invoice-templates/:id", rbac.InvoiceTemplateRead, "viewer"},
+	{http.MethodPost, "/api/invoice-templates", rbac.InvoiceTemplateWrite, "accountant"},
+	{http.MethodPut, "/api/invoice-templates/:id", rbac.InvoiceTemplateWrite, "accountant"},
+	{http.MethodDelete, "/api/invoice-templates/:id", rbac.InvoiceTemplateWrite, "accountant"},
+	{http.MethodGet, "/api/admin/users", rbac.UserManage, "admin"},
+	{http.MethodPut, "/api/admin/users/:id/roles", rbac.UserManage, "admin"},
+	{http.MethodPost, "/api/admin/users/:id/unlock", rbac.UserManage, "admin"},
+}
+
+// unprotectedRoutes need no permission: they are public or only about the
+// caller's own session
+var unprotectedRoutes = []string{
+	"GET /api/message",
+	"POST /api/auth/register",
+	"POST /api/auth/login",
+	"POST /api/auth/refresh",
+	"POST /api/auth/password/forgot",
+	"POST /api/auth/password/reset",
+	"POST /api/auth/verify-email",
+	"GET /api/csrf",
+	"GET /api/auth/me",
+	"POST /api/auth/logout",
+	"POST /api/auth/verify-email/resend",
+	"GET /api/auth/api-keys",
+	"POST /api/auth/api-keys",
+	"DELETE /api/auth/api-keys/:id",
+	"GET /api/counter",
+	"POST /api/counter",
+}
+
+// newTestRouter sets up the API routes with handlers that have no services.
+// Requests that get past the permission check fail in the handler (or the
+// CSRF check), which Recover turns into a 500.
+func newTestRouter(tokenService token.TokenService) *echo.Echo {
+	e := echo.New()
+	e.HTTPErrorHandler = handler.HandleError
+	e.Logger.SetOutput(io.Discard)
+	e.Use(echomw.Recover())
+	SetupRoutes(
+		e,
+		handler.MessageHandler{},
+		handler.CounterHandler{},
+		handler.NewUserHandler(nil, nil, tokenService, nil),
+		tokenService,
+		nil,
+		csrf.NewCSRFService(csrf.CSRFConfig{Secret: "test-csrf-secret"}),
+		handler.NewNotFoundHandler(),
+		&handler.ItemHandler{},
+		&handler.TagHandler{},
+		&handler.CustomerHandler{},
+		&handler.TaxRateHandler{},
+		&handler.ExchangeRateHandler{},
+		&handler.InvoiceHandler{},
+		&handler.InvoiceTemplateHandler{},
+		&handler.AdminHandler{},
+		&handler.APIKeyHandler{},
+		&handler.AccountHandler{},
+	)
+	return e
+}
+
+func TestEveryRouteIsCovered(t *testing.T) {
+	e := newTestRouter(token.NewTokenService(token.TokenConfig{}))
+
+	covered := slices.Clone(unprotectedRoutes)
+	for _, route := range protectedRoutes {
+		covered = append(covered, route.method+" "+route.path)
+	}
+
+	for _, route := range e.Routes() {
+		key := route.Method + " " + route.Path
+		// Skip the not found catch-all and echo's own routes
+		if !strings.HasPrefix(route.Path, "/api/") || route.Path == "/api/*" || slices.Contains(covered, key) {
+			continue
+		}
+		t.Errorf("route %s is missing from protectedRoutes", key)
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	tokenService := token.NewTokenService(token.TokenConfig{
+		AccessTokenSecret: "test-access-secret",
+		AccessTokenExpiry: 15 * time.Minute,
+	})
+	e := newTestRouter(tokenService)
+
+	// Each role is granted everything the roles before it are
+	ladder := []string{"viewer", "accountant", "admin"}
+
+	for _, route := range protectedRoutes {
+		path := strings.ReplaceAll(route.path, ":id", uuid.NewString())
+
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			t.Run("should require authentication", func(t *testing.T) {
+				req := httptest.NewRequest(route.method, path, nil)
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+
+				if rec.Code != http.StatusUnauthorized {
+					t.Errorf("expected status 401, got %d", rec.Code)
+				}
+			})
+
+			roles := append([]string{""}, ladder...)
+			minRole := slices.Index(ladder, route.minRole) + 1
+			for i, role := range roles {
+				granted := i >= minRole
+				name := "should deny a user without roles"
+				switch {
+				case role != "" && granted:
+					name = "should allow " + role
+				case role != "":
+					name = "should deny " + role
+				}
+
+				t.Run(name, func(t *testing.T) {
+					var tokenRoles []string
+					if role != "" {
+						tokenRoles = []string{role}
+					}
+					accessToken, err := tokenService.GenerateAccessToken(uuid.New(), "test@example.com", "Test User", tokenRoles)
+					if err != nil {
+						t.Fatalf("failed to generate token: %v", err)
+					}
+
+					req := httptest.NewRequest(route.method, path, nil)
+					req.AddCookie(&http.Cookie{Name: middleware.AccessTokenCookie, Value: accessToken})
+					rec := httptest.NewRecorder()
+					e.ServeHTTP(rec, req)
+
+					var problem response.ProblemResponse
+					_ = json.Unmarshal(rec.Body.Bytes(), &problem)
+
+					if !granted {
+						if rec.Code != http.StatusForbidden || problem.Code != "permission_denied" {
+							t.Fatalf("expected 403 permission_denied, got %d %s", rec.Code, problem.Code)
+						}
+						if want := "missing permission " + string(route.permission); problem.Detail != want {
+							t.Errorf("expected detail %q, got %q", want, problem.Detail)
+						}
+						return
+					}
+					if problem.Code == "permission_denied" {
+						t.Errorf("expected the request to be allowed, got %d %s", rec.Code, problem.Code)
+					}
+				})
+			}
+		})
+	}
+}
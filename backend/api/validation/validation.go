@@ -0,0 +1,95 @@
+// Package validation checks request bodies against the validate tags of the
+// DTOs in model/request. Echo runs it on every c.Bind through Binder.
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/labstack/echo/v4"
+)
+
+// colorHexPattern matches a CSS hex color such as #1e90ff or #fff
+var colorHexPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// ErrInvalidBody is returned by Binder for a body that cannot be decoded
+var ErrInvalidBody = apperror.New(apperror.ErrBadRequest, "invalid_body", "invalid request body")
+
+// Validator is the echo.Validator of the API. Besides the built-in rules it
+// knows colorhex, a #RGB or #RRGGBB color.
+type Validator struct {
+	validate *validator.Validate
+}
+
+// NewValidator creates a Validator that reports fields by their JSON names
+func NewValidator() *Validator {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	validate.RegisterValidation("colorhex", func(fl validator.FieldLevel) bool {
+		return colorHexPattern.MatchString(fl.Field().String())
+	})
+
+	return &Validator{validate: validate}
+}
+
+// Validate checks i against its validate tags. A failure is an
+// apperror.ErrValidation listing each field, e.g. items[0].quantity, with
+// the rule it broke.
+// Values that are not structs have no tags and always pass.
+func (v *Validator) Validate(i any) error {
+	value := reflect.ValueOf(i)
+	for value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	err := v.validate.Struct(i)
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	invalid := apperror.New(apperror.ErrValidation, "validation_failed", "validation failed")
+	names := make([]string, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		// The namespace starts with the struct name, e.g. CreateInvoiceRequest.items[0].quantity
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+		invalid.Fields = append(invalid.Fields, apperror.FieldError{
+			Field: field,
+			Rule:  fe.Tag(),
+			Param: fe.Param(),
+		})
+		names[i] = field
+	}
+	invalid.Message = "validation failed: " + strings.Join(names, ", ")
+	return invalid
+}
+
+// Binder binds requests as echo.DefaultBinder does and then validates the
+// result with the echo.Validator, so every c.Bind enforces validate tags
+type Binder struct {
+	echo.DefaultBinder
+}
+
+// Bind binds and validates i. A body that cannot be decoded is ErrInvalidBody.
+func (b *Binder) Bind(i any, c echo.Context) error {
+	if err := b.DefaultBinder.Bind(i, c); err != nil {
+		return ErrInvalidBody
+	}
+	return c.Validate(i)
+}
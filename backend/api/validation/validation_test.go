@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected []apperror.FieldError
+	}{
+		{
+			name:  "should pass a valid request",
+			input: &request.CreateTagRequest{Name: "urgent", ColorHex: "#ff0000"},
+		},
+		{
+			name:  "should accept a short hex color",
+			input: &request.CreateTagRequest{Name: "urgent", ColorHex: "#f00"},
+		},
+		{
+			name:  "should report every failing field by its JSON name",
+			input: &request.CreateTagRequest{ColorHex: "red"},
+			expected: []apperror.FieldError{
+				{Field: "name", Rule: "required"},
+				{Field: "color_hex", Rule: "colorhex"},
+			},
+		},
+		{
+			name:  "should reject a malformed email",
+			input: &request.RegisterUserRequest{Email: "not-an-email", Password: "secret", Name: "Jane"},
+			expected: []apperror.FieldError{
+				{Field: "email", Rule: "email"},
+			},
+		},
+		{
+			name:  "should report the rule's parameter",
+			input: &request.CreateTaxRateRequest{Name: "VAT", Rate: 12000},
+			expected: []apperror.FieldError{
+				{Field: "rate", Rule: "max", Param: "10000"},
+			},
+		},
+		{
+			name: "should validate each line of a list",
+			input: &request.CreateInvoiceRequest{
+				CustomerID: uuid.New(),
+				Items: []request.InvoiceItemInput{
+					{ItemID: uuid.New(), Quantity: 1},
+					{ItemID: uuid.New()},
+				},
+			},
+			expected: []apperror.FieldError{
+				{Field: "items[1].quantity", Rule: "required"},
+			},
+		},
+		{
+			name:  "should pass values that are not structs",
+			input: &map[string]string{"name": ""},
+		},
+	}
+
+	v := NewValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Validate(tt.input)
+			if tt.expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var invalid *apperror.Error
+			if !errors.As(err, &invalid) || !errors.Is(err, apperror.ErrValidation) {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+			if !reflect.DeepEqual(invalid.Fields, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, invalid.Fields)
+			}
+		})
+	}
+}
@@ -1,28 +1,49 @@
 package di
 
 import (
+	"context"
 	"log"
-	"os"
 	"time"
 
 	"github.com/kamil5b/clean-go-vite-react/backend/api"
 	"github.com/kamil5b/clean-go-vite-react/backend/api/handler"
+	"github.com/kamil5b/clean-go-vite-react/backend/api/validation"
 	"github.com/kamil5b/clean-go-vite-react/backend/platform"
 
+	apiKeyRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/apikey"
 	counterRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/counter"
+	customerRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/customer"
+	exchangeRateRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/exchangerate"
 	invoiceRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/invoice"
+	invoiceTemplateRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/invoicetemplate"
 	itemRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/item"
+	loginAttemptRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/loginattempt"
 	messageRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/message"
+	migrationRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	refreshTokenRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/refreshtoken"
 	tagRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/tag"
+	taxRateRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/taxrate"
+	unitOfWorkRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 	userRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/user"
+	userTokenRepo "github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/usertoken"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 
+	accountSvc "github.com/kamil5b/clean-go-vite-react/backend/service/account"
+	apiKeySvc "github.com/kamil5b/clean-go-vite-react/backend/service/apikey"
 	counterSvc "github.com/kamil5b/clean-go-vite-react/backend/service/counter"
 	csrfSvc "github.com/kamil5b/clean-go-vite-react/backend/service/csrf"
+	currencySvc "github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+	customerSvc "github.com/kamil5b/clean-go-vite-react/backend/service/customer"
+	exchangeRateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/exchangerate"
 	healthSvc "github.com/kamil5b/clean-go-vite-react/backend/service/health"
 	invoiceSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoice"
+	invoiceTemplateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoicetemplate"
 	itemSvc "github.com/kamil5b/clean-go-vite-react/backend/service/item"
+	mailSvc "github.com/kamil5b/clean-go-vite-react/backend/service/mail"
 	messageSvc "github.com/kamil5b/clean-go-vite-react/backend/service/message"
+	pdfSvc "github.com/kamil5b/clean-go-vite-react/backend/service/pdf"
 	tagSvc "github.com/kamil5b/clean-go-vite-react/backend/service/tag"
+	taxRateSvc "github.com/kamil5b/clean-go-vite-react/backend/service/taxrate"
 	tokenSvc "github.com/kamil5b/clean-go-vite-react/backend/service/token"
 	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
 
@@ -31,39 +52,67 @@ import (
 
 // Container holds all application dependencies
 type Container struct {
-	Config   *platform.Config
-	Echo     *echo.Echo
-	Services *Services
+	Config    *platform.Config
+	Echo      *echo.Echo
+	Services  *Services
+	Scheduler *invoiceTemplateSvc.Scheduler
 }
 
 // Services holds all service layer dependencies
 type Services struct {
-	Message messageSvc.MessageService
-	Health  healthSvc.HealthService
-	Counter counterSvc.CounterService
-	User    userSvc.UserService
-	Token   tokenSvc.TokenService
-	CSRF    csrfSvc.CSRFService
-	Item    itemSvc.ItemService
-	Tag     tagSvc.TagService
-	Invoice invoiceSvc.InvoiceService
+	Message         messageSvc.MessageService
+	Health          healthSvc.HealthService
+	Counter         counterSvc.CounterService
+	User            userSvc.UserService
+	Account         accountSvc.AccountService
+	Token           tokenSvc.TokenService
+	APIKey          apiKeySvc.APIKeyService
+	CSRF            csrfSvc.CSRFService
+	Item            itemSvc.ItemService
+	Tag             tagSvc.TagService
+	Customer        customerSvc.CustomerService
+	TaxRate         taxRateSvc.TaxRateService
+	ExchangeRate    exchangeRateSvc.ExchangeRateService
+	Invoice         invoiceSvc.InvoiceService
+	InvoicePDF      pdfSvc.InvoiceRenderer
+	InvoiceTemplate invoiceTemplateSvc.InvoiceTemplateService
 }
 
 // Handlers holds all HTTP handler dependencies
 type Handlers struct {
-	Message *handler.MessageHandler
-	Health  *handler.HealthHandler
-	Counter *handler.CounterHandler
-	User    *handler.UserHandler
-	Item    *handler.ItemHandler
-	Tag     *handler.TagHandler
-	Invoice *handler.InvoiceHandler
+	Message         *handler.MessageHandler
+	Health          *handler.HealthHandler
+	JWKS            *handler.JWKSHandler
+	Counter         *handler.CounterHandler
+	User            *handler.UserHandler
+	Account         *handler.AccountHandler
+	Item            *handler.ItemHandler
+	Tag             *handler.TagHandler
+	Customer        *handler.CustomerHandler
+	TaxRate         *handler.TaxRateHandler
+	ExchangeRate    *handler.ExchangeRateHandler
+	Invoice         *handler.InvoiceHandler
+	InvoiceTemplate *handler.InvoiceTemplateHandler
+	Admin           *handler.AdminHandler
+	APIKey          *handler.APIKeyHandler
 }
 
 // NewContainer creates and initializes a new dependency container
 func NewContainer(cfg *platform.Config) *Container {
-	// Initialize Echo
+	// Refuse to run production on the development secrets
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Initialize Echo, validating every bound request body and writing
+	// every error as a problem response
 	e := echo.New()
+	e.Validator = validation.NewValidator()
+	e.Binder = &validation.Binder{}
+	e.HTTPErrorHandler = handler.HandleError
+	// Trust X-Forwarded-For only from proxies on private networks, so
+	// clients cannot pick the IP failed sign-ins are counted against
+	e.IPExtractor = echo.ExtractIPFromXFFHeader()
 
 	// Initialize database
 	db := cfg.Database.Gorm
@@ -72,6 +121,19 @@ func NewContainer(cfg *platform.Config) *Container {
 		cfg.Database.Gorm = db
 	}
 
+	// The schema is managed by `server migrate`; refuse to serve an outdated one
+	migrator, err := migrationRepo.NewGORMMigrator(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+	pending, err := migrator.Pending(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to check migrations: %v", err)
+	}
+	if len(pending) > 0 {
+		log.Fatalf("Database schema is missing %d migrations from %s; run `server migrate up`", len(pending), pending[0])
+	}
+
 	// Initialize repositories
 	counterRepository, err := counterRepo.NewGORMCounterRepository(db)
 	if err != nil {
@@ -88,6 +150,36 @@ func NewContainer(cfg *platform.Config) *Container {
 		log.Fatalf("Failed to initialize user repository: %v", err)
 	}
 
+	refreshTokenRepository, err := refreshTokenRepo.NewGORMRefreshTokenRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize refresh token repository: %v", err)
+	}
+
+	userTokenRepository, err := userTokenRepo.NewGORMUserTokenRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize user token repository: %v", err)
+	}
+
+	apiKeyRepository, err := apiKeyRepo.NewGORMAPIKeyRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize API key repository: %v", err)
+	}
+
+	// Count failed sign-ins in the database, shared by every instance,
+	// unless told to keep them in memory
+	var loginAttemptRepository interfaces.LoginAttemptRepository
+	switch cfg.Login.AttemptStore {
+	case "database":
+		loginAttemptRepository, err = loginAttemptRepo.NewGORMLoginAttemptRepository(db)
+		if err != nil {
+			log.Fatalf("Failed to initialize login attempt repository: %v", err)
+		}
+	case "memory":
+		loginAttemptRepository = loginAttemptRepo.NewMemoryLoginAttemptRepository()
+	default:
+		log.Fatalf("Invalid LOGIN_ATTEMPT_STORE %q: must be database or memory", cfg.Login.AttemptStore)
+	}
+
 	itemRepository, err := itemRepo.NewGORMItemRepository(db)
 	if err != nil {
 		log.Fatalf("Failed to initialize item repository: %v", err)
@@ -98,59 +190,175 @@ func NewContainer(cfg *platform.Config) *Container {
 		log.Fatalf("Failed to initialize tag repository: %v", err)
 	}
 
+	taxRateRepository, err := taxRateRepo.NewGORMTaxRateRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize tax rate repository: %v", err)
+	}
+
+	customerRepository, err := customerRepo.NewGORMCustomerRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize customer repository: %v", err)
+	}
+
+	exchangeRateRepository, err := exchangeRateRepo.NewGORMExchangeRateRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize exchange rate repository: %v", err)
+	}
+
 	invoiceRepository, err := invoiceRepo.NewGORMInvoiceRepository(db)
 	if err != nil {
 		log.Fatalf("Failed to initialize invoice repository: %v", err)
 	}
 
-	// Initialize token service with configuration from environment
+	invoiceTemplateRepository, err := invoiceTemplateRepo.NewGORMInvoiceTemplateRepository(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize invoice template repository: %v", err)
+	}
+
+	unitOfWork, err := unitOfWorkRepo.NewGORMUnitOfWork(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize unit of work: %v", err)
+	}
+
+	// Initialize token service, signing access tokens with the key files
+	// when given and with the secret otherwise
+	signingKeys, err := tokenSvc.LoadSigningKeys(cfg.Auth.SigningKeyFiles)
+	if err != nil {
+		log.Fatalf("Invalid JWT_SIGNING_KEY_FILES: %v", err)
+	}
 	tokenConfig := tokenSvc.TokenConfig{
-		AccessTokenSecret:  getEnv("JWT_ACCESS_SECRET", "access-secret-key-change-in-production"),
+		AccessTokenSecret:  cfg.Auth.AccessTokenSecret,
+		AccessTokenKeys:    signingKeys,
 		AccessTokenExpiry:  15 * time.Minute,
-		RefreshTokenSecret: getEnv("JWT_REFRESH_SECRET", "refresh-secret-key-change-in-production"),
+		RefreshTokenSecret: cfg.Auth.RefreshTokenSecret,
 		RefreshTokenExpiry: 7 * 24 * time.Hour,
 	}
 	tokenService := tokenSvc.NewTokenService(tokenConfig)
 
+	// Initialize CSRF service
+	csrfService := csrfSvc.NewCSRFService(csrfSvc.CSRFConfig{
+		Secret: cfg.Auth.CSRFSecret,
+		Expiry: 2 * time.Hour,
+	})
+
+	// Validate login limits before anyone signs in
+	if cfg.Login.MaxFailures < 1 || cfg.Login.IPMaxFailures < 1 || cfg.Login.BaseDelay <= 0 ||
+		cfg.Login.MaxDelay < cfg.Login.BaseDelay || cfg.Login.LockoutDuration < cfg.Login.MaxDelay {
+		log.Fatalf("Invalid login limits: LOGIN_MAX_FAILURES and LOGIN_IP_MAX_FAILURES must be at least 1, and LOGIN_BACKOFF_BASE <= LOGIN_BACKOFF_MAX <= LOGIN_LOCKOUT_DURATION")
+	}
+	loginThrottle := userSvc.LoginThrottleConfig{
+		MaxFailures:     cfg.Login.MaxFailures,
+		IPMaxFailures:   cfg.Login.IPMaxFailures,
+		BaseDelay:       cfg.Login.BaseDelay,
+		MaxDelay:        cfg.Login.MaxDelay,
+		LockoutDuration: cfg.Login.LockoutDuration,
+	}
+
+	// Send account email through the configured driver, logging or
+	// writing files by default so local development needs no mail server
+	var mailer mailSvc.Mailer
+	switch cfg.Mail.Driver {
+	case "file":
+		mailer = mailSvc.NewFileMailer(cfg.Mail.Dir, cfg.Mail.From)
+	case "smtp":
+		mailer = mailSvc.NewSMTPMailer(mailSvc.SMTPConfig{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+	default:
+		log.Fatalf("Invalid MAIL_DRIVER %q: must be file or smtp", cfg.Mail.Driver)
+	}
+	if cfg.Account.PasswordResetExpiry <= 0 || cfg.Account.EmailVerificationExpiry <= 0 {
+		log.Fatalf("Invalid account token expiries: PASSWORD_RESET_EXPIRY and EMAIL_VERIFICATION_EXPIRY must be positive")
+	}
+	accountConfig := accountSvc.AccountConfig{
+		AppURL:                  cfg.Server.PublicURL,
+		PasswordResetExpiry:     cfg.Account.PasswordResetExpiry,
+		EmailVerificationExpiry: cfg.Account.EmailVerificationExpiry,
+	}
+
+	// Validate invoice numbering before any invoice can be issued
+	if err := invoiceSvc.ValidateNumberFormat(cfg.Invoice.NumberFormat); err != nil {
+		log.Fatalf("Invalid INVOICE_NUMBER_FORMAT: %v", err)
+	}
+	if err := invoiceSvc.ValidateNumberFormat(cfg.Invoice.CreditNoteNumberFormat); err != nil {
+		log.Fatalf("Invalid CREDIT_NOTE_NUMBER_FORMAT: %v", err)
+	}
+	baseCurrency, err := currencySvc.Normalize(cfg.Invoice.BaseCurrency)
+	if err != nil {
+		log.Fatalf("Invalid INVOICE_BASE_CURRENCY: %v", err)
+	}
+	invoiceRenderer, err := pdfSvc.NewInvoiceRenderer(cfg.Invoice.PDFTemplate)
+	if err != nil {
+		log.Fatalf("Invalid INVOICE_PDF_TEMPLATE: %v", err)
+	}
+
 	// Initialize services
 	services := &Services{
-		Message: messageSvc.NewMessageService(messageRepository),
-		Health:  healthSvc.NewHealthService(),
-		Counter: counterSvc.NewCounterService(counterRepository),
-		User:    userSvc.NewUserService(userRepository, tokenService),
-		Token:   tokenService,
-		CSRF:    csrfSvc.NewCSRFService(),
-		Item:    itemSvc.NewItemService(itemRepository),
-		Tag:     tagSvc.NewTagService(tagRepository),
-		Invoice: invoiceSvc.NewInvoiceService(invoiceRepository, tagRepository),
+		Message:      messageSvc.NewMessageService(messageRepository),
+		Health:       healthSvc.NewHealthService(),
+		Counter:      counterSvc.NewCounterService(counterRepository),
+		User:         userSvc.NewUserService(userRepository, refreshTokenRepository, loginAttemptRepository, tokenService, loginThrottle),
+		Account:      accountSvc.NewAccountService(userRepository, userTokenRepository, refreshTokenRepository, unitOfWork, mailer, accountConfig),
+		Token:        tokenService,
+		APIKey:       apiKeySvc.NewAPIKeyService(apiKeyRepository, userRepository),
+		CSRF:         csrfService,
+		Item:         itemSvc.NewItemService(itemRepository),
+		Tag:          tagSvc.NewTagService(tagRepository),
+		Customer:     customerSvc.NewCustomerService(customerRepository),
+		TaxRate:      taxRateSvc.NewTaxRateService(taxRateRepository),
+		ExchangeRate: exchangeRateSvc.NewExchangeRateService(exchangeRateRepository),
+		Invoice: invoiceSvc.NewInvoiceService(invoiceRepository, customerRepository, itemRepository, tagRepository, taxRateRepository, exchangeRateRepository, unitOfWork, invoiceSvc.InvoiceConfig{
+			NumberFormat:           cfg.Invoice.NumberFormat,
+			CreditNoteNumberFormat: cfg.Invoice.CreditNoteNumberFormat,
+			BaseCurrency:           baseCurrency,
+		}),
+		InvoicePDF: invoiceRenderer,
+	}
+	services.InvoiceTemplate = invoiceTemplateSvc.NewInvoiceTemplateService(invoiceTemplateRepository, customerRepository, itemRepository, tagRepository, taxRateRepository, services.Invoice, unitOfWork, invoiceTemplateSvc.InvoiceTemplateConfig{
+		BaseCurrency: baseCurrency,
+	})
+
+	// Validate page sizes before any list is served
+	if cfg.Pagination.DefaultLimit < 1 || cfg.Pagination.MaxLimit < cfg.Pagination.DefaultLimit {
+		log.Fatalf("Invalid pagination limits: PAGINATION_DEFAULT_LIMIT must be at least 1 and at most PAGINATION_MAX_LIMIT")
+	}
+	pagination := handler.Pagination{
+		DefaultLimit: cfg.Pagination.DefaultLimit,
+		MaxLimit:     cfg.Pagination.MaxLimit,
 	}
 
 	// Initialize handlers
 	handlers := &Handlers{
-		Message: handler.NewMessageHandler(services.Message),
-		Health:  handler.NewHealthHandler(services.Health),
-		Counter: handler.NewCounterHandler(services.Counter),
-		User:    handler.NewUserHandler(services.User, services.Token, services.CSRF),
-		Item:    handler.NewItemHandler(services.Item),
-		Tag:     handler.NewTagHandler(services.Tag),
-		Invoice: handler.NewInvoiceHandler(services.Invoice),
+		Message:         handler.NewMessageHandler(services.Message),
+		Health:          handler.NewHealthHandler(services.Health),
+		JWKS:            handler.NewJWKSHandler(services.Token),
+		Counter:         handler.NewCounterHandler(services.Counter),
+		User:            handler.NewUserHandler(services.User, services.Account, services.Token, services.CSRF),
+		Account:         handler.NewAccountHandler(services.Account),
+		Item:            handler.NewItemHandler(services.Item, pagination),
+		Tag:             handler.NewTagHandler(services.Tag, pagination),
+		Customer:        handler.NewCustomerHandler(services.Customer, pagination),
+		TaxRate:         handler.NewTaxRateHandler(services.TaxRate, pagination),
+		ExchangeRate:    handler.NewExchangeRateHandler(services.ExchangeRate, pagination),
+		Invoice:         handler.NewInvoiceHandler(services.Invoice, services.InvoicePDF, pagination),
+		InvoiceTemplate: handler.NewInvoiceTemplateHandler(services.InvoiceTemplate, pagination),
+		Admin:           handler.NewAdminHandler(services.User, pagination),
+		APIKey:          handler.NewAPIKeyHandler(services.APIKey),
 	}
 
 	// Setup routes with dependencies
-	api.SetupRoutes(e, *handlers.Message, *handlers.Counter, handlers.User, services.Token, handler.NewNotFoundHandler(), handlers.Item, handlers.Tag, handlers.Invoice)
+	api.SetupRoutes(e, *handlers.Message, *handlers.Counter, handlers.User, services.Token, services.APIKey, services.CSRF, handler.NewNotFoundHandler(), handlers.Item, handlers.Tag, handlers.Customer, handlers.TaxRate, handlers.ExchangeRate, handlers.Invoice, handlers.InvoiceTemplate, handlers.Admin, handlers.APIKey, handlers.Account)
 	e.GET("/api/health", handlers.Health.Check)
+	e.GET("/.well-known/jwks.json", handlers.JWKS.Get)
 
 	return &Container{
-		Config:   cfg,
-		Echo:     e,
-		Services: services,
-	}
-}
-
-// Helper function to get environment variables
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+		Config:    cfg,
+		Echo:      e,
+		Services:  services,
+		Scheduler: invoiceTemplateSvc.NewScheduler(services.InvoiceTemplate, cfg.Invoice.SchedulerInterval),
 	}
-	return defaultValue
 }
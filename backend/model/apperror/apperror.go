@@ -0,0 +1,74 @@
+// Package apperror holds the errors services and repositories return to
+// the API. Each Error has a kind, which decides the HTTP status, and a
+// stable code clients can branch on without parsing messages.
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Kinds of error. Match them with errors.Is.
+var (
+	// ErrBadRequest is a request that cannot be read, such as a malformed id or query
+	ErrBadRequest = errors.New("bad request")
+	// ErrValidation is a request that can be read but holds invalid values
+	ErrValidation = errors.New("validation failed")
+	// ErrUnauthorized is a request without valid credentials
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is a request the caller is not allowed to make
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is a record that does not exist or is not the caller's
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is a request the current state of a record does not allow
+	ErrConflict = errors.New("conflict")
+	// ErrTooManyRequests is a request refused until the caller waits
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// FieldError is a request field that broke a validation rule.
+// Param is the rule's argument, e.g. "1" for min=1.
+type FieldError struct {
+	Field string
+	Rule  string
+	Param string
+}
+
+// Error is a domain error. Its message is shown to clients as is, so it
+// never holds internal details.
+type Error struct {
+	Kind    error
+	Code    string
+	Message string
+	// Fields lists the failing fields of an ErrValidation, when known
+	Fields []FieldError
+	// RetryAfter is how long to wait before retrying an ErrTooManyRequests
+	RetryAfter time.Duration
+	cause      error
+}
+
+// New creates an error of kind with a stable code
+func New(kind error, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Errorf creates an error of kind with a formatted message. An error
+// wrapped with %w stays reachable through errors.Is and errors.As.
+func Errorf(kind error, code, format string, args ...any) *Error {
+	err := fmt.Errorf(format, args...)
+	return &Error{Kind: kind, Code: code, Message: err.Error(), cause: errors.Unwrap(err)}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is the kind of e
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
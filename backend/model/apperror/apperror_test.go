@@ -0,0 +1,67 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestError(t *testing.T) {
+	errMissing := New(ErrNotFound, "thing_not_found", "thing not found")
+	cause := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		kind     error
+		code     string
+		message  string
+		wrapping error
+	}{
+		{
+			name:    "should match its kind and keep its message",
+			err:     errMissing,
+			kind:    ErrNotFound,
+			code:    "thing_not_found",
+			message: "thing not found",
+		},
+		{
+			name:     "should keep its kind and code when wrapped",
+			err:      fmt.Errorf("%w: id 42", errMissing),
+			kind:     ErrNotFound,
+			code:     "thing_not_found",
+			message:  "thing not found: id 42",
+			wrapping: errMissing,
+		},
+		{
+			name:     "should format its message and unwrap to the cause",
+			err:      Errorf(ErrValidation, "invalid_line", "line %d: %w", 3, cause),
+			kind:     ErrValidation,
+			code:     "invalid_line",
+			message:  "line 3: boom",
+			wrapping: cause,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.kind) {
+				t.Errorf("expected kind %v", tt.kind)
+			}
+			if errors.Is(tt.err, ErrConflict) {
+				t.Errorf("unexpected kind %v", ErrConflict)
+			}
+			if tt.err.Error() != tt.message {
+				t.Errorf("expected message %q, got %q", tt.message, tt.err.Error())
+			}
+
+			var appErr *Error
+			if !errors.As(tt.err, &appErr) || appErr.Code != tt.code {
+				t.Errorf("expected code %q, got %+v", tt.code, appErr)
+			}
+			if tt.wrapping != nil && !errors.Is(tt.err, tt.wrapping) {
+				t.Errorf("expected %v to be wrapped", tt.wrapping)
+			}
+		})
+	}
+}
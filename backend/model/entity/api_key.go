@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyEntity is a personal API key. Only a SHA-256 hash of the key is
+// stored; Prefix keeps its first characters so the owner can tell keys apart.
+type APIKeyEntity struct {
+	ID      uuid.UUID `gorm:"primaryKey"`
+	UserID  uuid.UUID `gorm:"index"`
+	Name    string    `gorm:"type:varchar(255);not null"`
+	Prefix  string    `gorm:"type:varchar(20);not null"`
+	KeyHash string    `gorm:"uniqueIndex;not null"`
+	// Scopes are the space-separated permissions the key is limited to
+	Scopes     string `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName specifies the table name for APIKeyEntity
+func (APIKeyEntity) TableName() string {
+	return "api_keys"
+}
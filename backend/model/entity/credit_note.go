@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreditNoteEntity credits part of an issued invoice. It is numbered from
+// its own per-owner sequence and is never changed once created; Total, in
+// minor units of the invoice currency, reduces what the invoice still owes.
+// Total is Subtotal + TaxTotal.
+type CreditNoteEntity struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	OwnerID   uuid.UUID `gorm:"index;uniqueIndex:idx_credit_notes_owner_number"`
+	InvoiceID uuid.UUID `gorm:"index"`
+	Number    string    `gorm:"type:varchar(64);uniqueIndex:idx_credit_notes_owner_number"`
+	Reason    string    `gorm:"type:text;not null"`
+	Subtotal  int64     `gorm:"default:0"`
+	TaxTotal  int64     `gorm:"default:0"`
+	Total     int64     `gorm:"default:0"`
+	IssuedAt  time.Time `gorm:"not null"`
+	CreatedAt time.Time
+	Lines     []CreditNoteLineEntity `gorm:"foreignKey:CreditNoteID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for CreditNoteEntity
+func (CreditNoteEntity) TableName() string {
+	return "credit_notes"
+}
+
+// CreditNoteLineEntity credits a quantity of one invoice line. Amount is the
+// credited share of the line's discounted subtotal and TaxAmount the tax on it.
+type CreditNoteLineEntity struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	CreditNoteID  uuid.UUID `gorm:"index"`
+	InvoiceItemID uuid.UUID `gorm:"index"`
+	Quantity      int       `gorm:"default:0"`
+	Amount        int64     `gorm:"default:0"`
+	TaxAmount     int64     `gorm:"default:0"`
+	Total         int64     `gorm:"default:0"`
+	Position      int       `gorm:"default:0"`
+}
+
+// TableName specifies the table name for CreditNoteLineEntity
+func (CreditNoteLineEntity) TableName() string {
+	return "credit_note_lines"
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CustomerEntity is the party an invoice is billed to
+type CustomerEntity struct {
+	ID             uuid.UUID `gorm:"primaryKey"`
+	OwnerID        uuid.UUID `gorm:"index"`
+	Name           string    `gorm:"type:varchar(255);not null"`
+	Email          string    `gorm:"type:varchar(255);default:''"`
+	BillingAddress string    `gorm:"type:text;default:''"`
+	TaxID          string    `gorm:"type:varchar(64);default:''"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+}
+
+// TableName specifies the table name for CustomerEntity
+func (CustomerEntity) TableName() string {
+	return "customers"
+}
+
+// CustomerSnapshot is a copy of a customer's billing details taken when an
+// invoice is issued, so later edits to the customer leave it unchanged
+type CustomerSnapshot struct {
+	Name           string `gorm:"type:varchar(255)"`
+	Email          string `gorm:"type:varchar(255)"`
+	BillingAddress string `gorm:"type:text"`
+	TaxID          string `gorm:"type:varchar(64)"`
+}
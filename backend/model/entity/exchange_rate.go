@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExchangeRateEntity is the value of one unit of BaseCurrency in QuoteCurrency
+// from EffectiveDate onwards. Rate is kept as a decimal string so it survives
+// storage exactly.
+type ExchangeRateEntity struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	OwnerID       uuid.UUID `gorm:"index;uniqueIndex:idx_exchange_rates_pair_date"`
+	BaseCurrency  string    `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	QuoteCurrency string    `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	Rate          string    `gorm:"type:varchar(32);not null"`
+	EffectiveDate time.Time `gorm:"not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TableName specifies the table name for ExchangeRateEntity
+func (ExchangeRateEntity) TableName() string {
+	return "exchange_rates"
+}
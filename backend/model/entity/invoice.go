@@ -7,15 +7,55 @@ import (
 	"gorm.io/gorm"
 )
 
-// InvoiceEntity represents an invoice in the system
+// InvoiceStatus is the lifecycle state of an invoice
+type InvoiceStatus string
+
+const (
+	InvoiceStatusDraft         InvoiceStatus = "draft"
+	InvoiceStatusIssued        InvoiceStatus = "issued"
+	InvoiceStatusPartiallyPaid InvoiceStatus = "partially_paid"
+	InvoiceStatusPaid          InvoiceStatus = "paid"
+	InvoiceStatusVoid          InvoiceStatus = "void"
+)
+
+// InvoiceEntity represents an invoice in the system.
+// Monetary amounts are stored in integer minor units of Currency (e.g. cents).
+// GrandPrice is Subtotal - DiscountTotal + TaxTotal. TaxRate is the
+// invoice-level rate in basis points, applied to lines without their own.
+// Issuing snapshots the exchange rate to the base currency and the converted
+// grand total, so later rate changes do not alter issued invoices, and
+// copies the customer's billing details into BillTo for the same reason.
+// Credit notes reduce what is owed to GrandPrice - CreditedTotal.
 type InvoiceEntity struct {
-	ID         uuid.UUID `gorm:"primaryKey"`
-	GrandPrice float64   `gorm:"column:grand_price;default:0"`
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  gorm.DeletedAt      `gorm:"index"`
-	Items      []InvoiceItemEntity `gorm:"foreignKey:InvoiceID;constraint:OnDelete:CASCADE"`
-	Tags       []TagEntity         `gorm:"many2many:invoice_to_tags;constraint:OnDelete:CASCADE"`
+	ID             uuid.UUID     `gorm:"primaryKey"`
+	OwnerID        uuid.UUID     `gorm:"index;uniqueIndex:idx_invoices_owner_number"`
+	Number         *string       `gorm:"type:varchar(64);uniqueIndex:idx_invoices_owner_number"`
+	Status         InvoiceStatus `gorm:"type:varchar(20);index;default:draft"`
+	CustomerID     *uuid.UUID    `gorm:"index"`
+	Currency       string        `gorm:"type:varchar(3);index;not null;default:USD"`
+	Subtotal       int64         `gorm:"default:0"`
+	DiscountTotal  int64         `gorm:"default:0"`
+	TaxRateID      *uuid.UUID    `gorm:"index"`
+	TaxRate        int64         `gorm:"default:0"`
+	TaxTotal       int64         `gorm:"default:0"`
+	GrandPrice     int64         `gorm:"column:grand_price;default:0"`
+	AmountPaid     int64         `gorm:"column:amount_paid;default:0"` // net of the payments ledger
+	CreditedTotal  int64         `gorm:"default:0"`                    // sum of the credit notes' totals
+	BaseCurrency   *string       `gorm:"type:varchar(3)"`
+	ExchangeRate   *string       `gorm:"type:varchar(32)"`
+	BaseGrandPrice *int64
+	BillTo         CustomerSnapshot `gorm:"embedded;embeddedPrefix:bill_to_"`
+	IssuedAt       *time.Time
+	PaidAt         *time.Time
+	VoidedAt       *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt      `gorm:"index"`
+	Customer       *CustomerEntity     `gorm:"foreignKey:CustomerID"`
+	Items          []InvoiceItemEntity `gorm:"foreignKey:InvoiceID;constraint:OnDelete:CASCADE"`
+	Tags           []TagEntity         `gorm:"many2many:invoice_to_tags;constraint:OnDelete:CASCADE"`
+	Payments       []PaymentEntity     `gorm:"foreignKey:InvoiceID"`
+	CreditNotes    []CreditNoteEntity  `gorm:"foreignKey:InvoiceID"`
 }
 
 // TableName specifies the table name for InvoiceEntity
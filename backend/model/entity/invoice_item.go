@@ -7,18 +7,38 @@ import (
 	"gorm.io/gorm"
 )
 
-// InvoiceItemEntity represents an invoice item in the system
+// DiscountType is how a line discount is expressed
+type DiscountType string
+
+const (
+	// DiscountTypePercent discounts a share of the line, in basis points
+	DiscountTypePercent DiscountType = "percent"
+	// DiscountTypeFixed discounts a fixed amount in minor units
+	DiscountTypeFixed DiscountType = "fixed"
+)
+
+// InvoiceItemEntity represents an invoice item in the system.
+// Amounts are stored in integer minor units (e.g. cents) and TaxRate in basis
+// points, copied from the tax rate when the line was priced.
+// TotalPrice is Subtotal - DiscountAmount + TaxAmount.
 type InvoiceItemEntity struct {
-	ID         uuid.UUID `gorm:"primaryKey"`
-	InvoiceID  uuid.UUID `gorm:"index;default:0"`
-	ItemID     uuid.UUID `gorm:"index;default:0"`
-	Quantity   int       `gorm:"default:0"`
-	UnitPrice  float64   `gorm:"column:unit_price;default:0"`
-	TotalPrice float64   `gorm:"column:total_price;default:0"`
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	DeletedAt  gorm.DeletedAt `gorm:"index"`
-	Item       ItemEntity     `gorm:"foreignKey:ItemID"`
+	ID             uuid.UUID    `gorm:"primaryKey"`
+	InvoiceID      uuid.UUID    `gorm:"index;not null"`
+	ItemID         uuid.UUID    `gorm:"index;not null"`
+	Quantity       int          `gorm:"default:0"`
+	UnitPrice      int64        `gorm:"column:unit_price;default:0"`
+	Subtotal       int64        `gorm:"default:0"`
+	DiscountType   DiscountType `gorm:"type:varchar(10);default:''"`
+	DiscountValue  int64        `gorm:"default:0"`
+	DiscountAmount int64        `gorm:"default:0"`
+	TaxRateID      *uuid.UUID   `gorm:"index"`
+	TaxRate        int64        `gorm:"default:0"`
+	TaxAmount      int64        `gorm:"default:0"`
+	TotalPrice     int64        `gorm:"column:total_price;default:0"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	Item           ItemEntity     `gorm:"foreignKey:ItemID"`
 }
 
 // TableName specifies the table name for InvoiceItemEntity
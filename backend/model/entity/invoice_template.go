@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TemplateCadence is how often a recurring invoice template runs
+type TemplateCadence string
+
+const (
+	TemplateCadenceWeekly    TemplateCadence = "weekly"
+	TemplateCadenceMonthly   TemplateCadence = "monthly"
+	TemplateCadenceQuarterly TemplateCadence = "quarterly"
+	TemplateCadenceYearly    TemplateCadence = "yearly"
+)
+
+// InvoiceTemplateEntity describes an invoice that is created on a schedule.
+// NextRunDate is the next calendar date (midnight UTC) an invoice is due;
+// AnchorDay is the day of month monthly, quarterly and yearly schedules
+// return to after a shorter month moved a run earlier. When AutoIssue is set
+// the created invoice is issued straight away. FailureCount counts the runs
+// that failed in a row, and the scheduler leaves the template alone until
+// NextAttemptAt.
+type InvoiceTemplateEntity struct {
+	ID            uuid.UUID       `gorm:"primaryKey"`
+	OwnerID       uuid.UUID       `gorm:"index"`
+	Name          string          `gorm:"type:varchar(255);not null"`
+	CustomerID    *uuid.UUID      `gorm:"index"`
+	Currency      string          `gorm:"type:varchar(3);not null;default:USD"`
+	TaxRateID     *uuid.UUID      `gorm:"index"`
+	Cadence       TemplateCadence `gorm:"type:varchar(20);not null"`
+	NextRunDate   time.Time       `gorm:"index:idx_invoice_templates_due,priority:2"`
+	AnchorDay     int             `gorm:"default:1"`
+	AutoIssue     bool            `gorm:"default:false"`
+	Active        bool            `gorm:"index:idx_invoice_templates_due,priority:1"`
+	LastRunAt     *time.Time
+	LastError     *string `gorm:"type:text"`
+	FailureCount  int     `gorm:"default:0"`
+	NextAttemptAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt              `gorm:"index"`
+	Customer      *CustomerEntity             `gorm:"foreignKey:CustomerID"`
+	Items         []InvoiceTemplateItemEntity `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE"`
+	Tags          []TagEntity                 `gorm:"many2many:invoice_template_to_tags;constraint:OnDelete:CASCADE"`
+}
+
+// TableName specifies the table name for InvoiceTemplateEntity
+func (InvoiceTemplateEntity) TableName() string {
+	return "invoice_templates"
+}
+
+// InvoiceTemplateItemEntity is a line copied onto every invoice created from
+// a template. It is priced when the invoice is created, with the tax rates in
+// effect at that time.
+type InvoiceTemplateItemEntity struct {
+	ID            uuid.UUID    `gorm:"primaryKey"`
+	TemplateID    uuid.UUID    `gorm:"index"`
+	ItemID        uuid.UUID    `gorm:"index"`
+	Quantity      int          `gorm:"default:0"`
+	UnitPrice     int64        `gorm:"column:unit_price;default:0"`
+	DiscountType  DiscountType `gorm:"type:varchar(10);default:''"`
+	DiscountValue int64        `gorm:"default:0"`
+	TaxRateID     *uuid.UUID   `gorm:"index"`
+	Position      int          `gorm:"default:0"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Item          ItemEntity `gorm:"foreignKey:ItemID"`
+}
+
+// TableName specifies the table name for InvoiceTemplateItemEntity
+func (InvoiceTemplateItemEntity) TableName() string {
+	return "invoice_template_items"
+}
+
+// InvoiceTemplateRunEntity records the invoice created for one scheduled
+// date of a template. The unique index makes creating a second invoice for
+// the same date fail, so a run is never repeated.
+type InvoiceTemplateRunEntity struct {
+	ID         uuid.UUID `gorm:"primaryKey"`
+	TemplateID uuid.UUID `gorm:"uniqueIndex:idx_invoice_template_runs_date"`
+	RunDate    time.Time `gorm:"uniqueIndex:idx_invoice_template_runs_date"`
+	InvoiceID  uuid.UUID `gorm:"index"`
+	CreatedAt  time.Time
+}
+
+// TableName specifies the table name for InvoiceTemplateRunEntity
+func (InvoiceTemplateRunEntity) TableName() string {
+	return "invoice_template_runs"
+}
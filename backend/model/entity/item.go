@@ -10,6 +10,7 @@ import (
 // ItemEntity represents an item in the system
 type ItemEntity struct {
 	ID        uuid.UUID `gorm:"primaryKey"`
+	OwnerID   uuid.UUID `gorm:"index"`
 	Name      string    `gorm:"default:''"`
 	Desc      string    `gorm:"column:desc;default:''"`
 	CreatedAt time.Time
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// LoginAttemptEntity counts the recent failed sign-ins of one key, such as
+// "email:alice@example.com" or "ip:203.0.113.7"
+type LoginAttemptEntity struct {
+	Key          string    `gorm:"primaryKey;type:varchar(320)"`
+	Failures     int       `gorm:"not null"`
+	LastFailedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for LoginAttemptEntity
+func (LoginAttemptEntity) TableName() string {
+	return "login_attempts"
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentKind distinguishes money received from money returned
+type PaymentKind string
+
+const (
+	PaymentKindPayment PaymentKind = "payment"
+	PaymentKindRefund  PaymentKind = "refund"
+)
+
+// PaymentMethod is how a payment or refund was made
+type PaymentMethod string
+
+const (
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodCard         PaymentMethod = "card"
+	PaymentMethodCheque       PaymentMethod = "cheque"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+// PaymentEntity is an entry in an invoice's payments ledger. Entries are
+// never changed or deleted; a refund is recorded as a new entry. Amount is
+// always positive and stored in integer minor units (e.g. cents).
+type PaymentEntity struct {
+	ID        uuid.UUID     `gorm:"primaryKey"`
+	InvoiceID uuid.UUID     `gorm:"index"`
+	OwnerID   uuid.UUID     `gorm:"index"`
+	Kind      PaymentKind   `gorm:"type:varchar(10);not null"`
+	Amount    int64         `gorm:"not null"`
+	Method    PaymentMethod `gorm:"type:varchar(20);not null"`
+	PaidAt    time.Time     `gorm:"not null"`
+	Reference string        `gorm:"type:varchar(255);default:''"`
+	CreatedAt time.Time
+}
+
+// TableName specifies the table name for PaymentEntity
+func (PaymentEntity) TableName() string {
+	return "payments"
+}
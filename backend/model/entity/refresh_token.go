@@ -7,13 +7,23 @@ import (
 	"gorm.io/gorm"
 )
 
-// RefreshTokenEntity represents a refresh token stored in the database
+// RefreshTokenEntity represents a refresh token stored in the database.
+// Tokens issued from the same login share a FamilyID so that reuse of a
+// rotated token can revoke every descendant at once.
 type RefreshTokenEntity struct {
-	ID        uuid.UUID `gorm:"primaryKey"`
-	UserID    uuid.UUID
-	Token     string
-	ExpiresAt time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID           uuid.UUID `gorm:"primaryKey"`
+	UserID       uuid.UUID `gorm:"index"`
+	FamilyID     uuid.UUID `gorm:"index"`
+	TokenHash    string    `gorm:"column:token_hash;uniqueIndex"`
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	ReplacedByID *uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+// TableName specifies the table name for RefreshTokenEntity
+func (RefreshTokenEntity) TableName() string {
+	return "refresh_tokens"
 }
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SequenceEntity holds the next value of a named, per-owner counter used to
+// allocate gap-free document numbers such as invoice numbers
+type SequenceEntity struct {
+	OwnerID   uuid.UUID `gorm:"primaryKey"`
+	Name      string    `gorm:"primaryKey;type:varchar(50)"`
+	NextValue int64     `gorm:"not null;default:1"`
+	UpdatedAt time.Time
+}
+
+// TableName specifies the table name for SequenceEntity
+func (SequenceEntity) TableName() string {
+	return "sequences"
+}
@@ -10,6 +10,7 @@ import (
 // TagEntity represents a tag in the system
 type TagEntity struct {
 	ID        uuid.UUID `gorm:"primaryKey"`
+	OwnerID   uuid.UUID `gorm:"index"`
 	Name      string    `gorm:"default:''"`
 	ColorHex  string    `gorm:"column:color_hex;default:'#000000'"`
 	CreatedAt time.Time
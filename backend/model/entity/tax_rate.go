@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// TaxRateEntity represents a named tax rate that can be applied to invoices.
+// Rate is stored in basis points (1/100 of a percent), so 1250 is 12.5%.
+type TaxRateEntity struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	OwnerID   uuid.UUID `gorm:"index"`
+	Name      string    `gorm:"default:''"`
+	Rate      int64     `gorm:"not null;default:0"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// TableName specifies the table name for TaxRateEntity
+func (TaxRateEntity) TableName() string {
+	return "tax_rates"
+}
@@ -7,6 +7,18 @@ import (
 	"gorm.io/gorm"
 )
 
+// Role names a set of permissions a user is granted
+type Role string
+
+const (
+	// RoleAdmin can do everything, including assigning roles
+	RoleAdmin Role = "admin"
+	// RoleAccountant can read and change all invoicing data
+	RoleAccountant Role = "accountant"
+	// RoleViewer can only read
+	RoleViewer Role = "viewer"
+)
+
 // User represents a user in the system
 type UserEntity struct {
 	ID        uuid.UUID `gorm:"primaryKey"`
@@ -15,5 +27,20 @@ type UserEntity struct {
 	Name      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	DeletedAt gorm.DeletedAt   `gorm:"index"`
+	Roles     []UserRoleEntity `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	// VerifiedAt is when the user proved they own Email, nil until then
+	VerifiedAt *time.Time
+}
+
+// UserRoleEntity grants a role to a user
+type UserRoleEntity struct {
+	UserID    uuid.UUID `gorm:"primaryKey"`
+	Role      Role      `gorm:"primaryKey;type:varchar(20);index"`
+	CreatedAt time.Time
+}
+
+// TableName specifies the table name for UserRoleEntity
+func (UserRoleEntity) TableName() string {
+	return "user_roles"
 }
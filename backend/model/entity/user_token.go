@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPurpose is what a single-use user token may be redeemed for
+type TokenPurpose string
+
+const (
+	// TokenPurposePasswordReset lets the user choose a new password
+	TokenPurposePasswordReset TokenPurpose = "password_reset"
+	// TokenPurposeEmailVerification confirms the user owns their email
+	TokenPurposeEmailVerification TokenPurpose = "email_verification"
+)
+
+// UserTokenEntity is a single-use, expiring token emailed to a user. Only a
+// SHA-256 hash of the token is stored.
+type UserTokenEntity struct {
+	ID        uuid.UUID    `gorm:"primaryKey"`
+	UserID    uuid.UUID    `gorm:"index"`
+	Purpose   TokenPurpose `gorm:"type:varchar(32);not null"`
+	TokenHash string       `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time    `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// TableName specifies the table name for UserTokenEntity
+func (UserTokenEntity) TableName() string {
+	return "user_tokens"
+}
@@ -0,0 +1,129 @@
+package query
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor marks the position of a row in a sorted list, so the next page can
+// start right after it whatever rows were added or removed in between
+type Cursor struct {
+	Sort   []Sort // the sort the list was requested in
+	Key    []Key  // the row's sorted fields followed by its id; empty for the first page
+	Before bool   // page backwards from the row instead of forwards
+}
+
+// Key is the value of a sorted field in the row a cursor points at
+type Key struct {
+	Field string
+	Value any
+}
+
+// Cursors point at the pages either side of a page. Either is nil when
+// there is no page that way.
+type Cursors struct {
+	Next *Cursor
+	Prev *Cursor
+}
+
+// encodedCursor is the JSON form of a Cursor. Values are kept as strings
+// and converted back by the fields' parsers.
+type encodedCursor struct {
+	Sort   string      `json:"s,omitempty"`
+	Key    [][2]string `json:"k,omitempty"`
+	Before bool        `json:"b,omitempty"`
+}
+
+// String encodes the cursor as an opaque URL-safe token
+func (c Cursor) String() string {
+	encoded := encodedCursor{Sort: formatSort(c.Sort), Before: c.Before}
+	for _, key := range c.Key {
+		encoded.Key = append(encoded.Key, [2]string{key.Field, formatValue(key.Value)})
+	}
+
+	data, _ := json.Marshal(encoded)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseCursor decodes a token made by Cursor.String. An empty token is the
+// first page.
+func parseCursor(token string, fields Fields) (*Cursor, error) {
+	if token == "" {
+		return &Cursor{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+	}
+	var encoded encodedCursor
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+	}
+
+	sorts, err := parseSort(encoded.Sort, fields)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &Cursor{Sort: sorts, Before: encoded.Before}
+	for _, pair := range encoded.Key {
+		parse := UUID
+		if pair[0] != "id" {
+			field, ok := fields[pair[0]]
+			if !ok || !field.Sortable || field.Parse == nil {
+				return nil, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+			}
+			parse = field.Parse
+		}
+		value, err := parse(pair[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed cursor", ErrInvalid)
+		}
+		cursor.Key = append(cursor.Key, Key{Field: pair[0], Value: value})
+	}
+
+	return cursor, nil
+}
+
+// formatSort writes sorts in the form parseSort reads
+func formatSort(sorts []Sort) string {
+	var out []byte
+	for i, s := range sorts {
+		if i > 0 {
+			out = append(out, ',')
+		}
+		if s.Desc {
+			out = append(out, '-')
+		}
+		out = append(out, s.Field...)
+	}
+	return string(out)
+}
+
+// formatValue writes a key value in the form its field's parser reads
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uuid.UUID:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Token encodes c like String, or returns nil when there is no cursor
+func Token(c *Cursor) *string {
+	if c == nil {
+		return nil
+	}
+	token := c.String()
+	return &token
+}
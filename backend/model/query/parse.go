@@ -0,0 +1,199 @@
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Parser converts the query string value of a field to the value filtered on
+type Parser func(value string) (any, error)
+
+// Field is a field a list endpoint can be filtered or sorted by. Parse reads
+// its values in filters and, for sortable fields, in cursors.
+type Field struct {
+	Parse    Parser
+	Ops      []Operator // operators allowed in filters; none when it cannot be filtered
+	Sortable bool
+	Nullable bool // cursors cannot page through a list sorted by it
+}
+
+// Fields whitelists the fields of a list endpoint by name
+type Fields map[string]Field
+
+// String accepts any value as is
+func String(value string) (any, error) {
+	return value, nil
+}
+
+// Int accepts a whole number
+func Int(value string) (any, error) {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a whole number", value)
+	}
+	return n, nil
+}
+
+// Time accepts an RFC 3339 timestamp or a YYYY-MM-DD date, taken as
+// midnight UTC
+func Time(value string) (any, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a date", value)
+	}
+	return t, nil
+}
+
+// UUID accepts a UUID
+func UUID(value string) (any, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a UUID", value)
+	}
+	return id, nil
+}
+
+// Parse reads a Spec from values, accepting only the filters and sort
+// fields whitelisted by fields:
+//
+//	search=acme                        Spec.Search
+//	status=issued                      equal
+//	status=issued,paid                 any of
+//	created_at[gte]=2024-01-01         compared with gt, gte, lt or lte
+//	sort=-created_at,name              by created_at descending, then name
+//	cursor=                            the first page of cursor pagination
+//	cursor=<token>                     the page a Cursor.String token points at
+//
+// Other parameters without an operator are ignored, so endpoints can take
+// options that are not filters, such as page and limit.
+func Parse(values url.Values, fields Fields) (Spec, error) {
+	spec := Spec{Search: values.Get("search")}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name, op, explicit, err := splitKey(key)
+		if err != nil {
+			return Spec{}, err
+		}
+		field, ok := fields[name]
+		if !ok || len(field.Ops) == 0 {
+			if explicit {
+				return Spec{}, fmt.Errorf("%w: cannot filter by %q", ErrInvalid, name)
+			}
+			continue
+		}
+
+		var raw []string
+		for _, value := range values[key] {
+			for _, part := range strings.Split(value, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					raw = append(raw, part)
+				}
+			}
+		}
+		if len(raw) == 0 {
+			continue
+		}
+		if !explicit && len(raw) > 1 {
+			op = OpIn
+		}
+		if !slices.Contains(field.Ops, op) {
+			return Spec{}, fmt.Errorf("%w: cannot filter %s with %s", ErrInvalid, name, op)
+		}
+		if op != OpIn && len(raw) > 1 {
+			return Spec{}, fmt.Errorf("%w: %s[%s] takes one value", ErrInvalid, name, op)
+		}
+
+		parsed := make([]any, len(raw))
+		for i, value := range raw {
+			if parsed[i], err = field.Parse(value); err != nil {
+				return Spec{}, fmt.Errorf("%w: %s: %w", ErrInvalid, name, err)
+			}
+		}
+		if op == OpIn {
+			spec.Filters = append(spec.Filters, Filter{Field: name, Op: op, Value: parsed})
+		} else {
+			spec.Filters = append(spec.Filters, Filter{Field: name, Op: op, Value: parsed[0]})
+		}
+	}
+
+	sorts, err := parseSort(values.Get("sort"), fields)
+	if err != nil {
+		return Spec{}, err
+	}
+	spec.Sort = sorts
+
+	if values.Has("cursor") {
+		for _, s := range sorts {
+			if fields[s.Field].Nullable {
+				return Spec{}, fmt.Errorf("%w: cursors cannot page through a list sorted by %s", ErrInvalid, s.Field)
+			}
+		}
+		cursor, err := parseCursor(values.Get("cursor"), fields)
+		if err != nil {
+			return Spec{}, err
+		}
+		if values.Get("cursor") == "" {
+			cursor.Sort = sorts
+		} else if !slices.Equal(cursor.Sort, sorts) {
+			return Spec{}, fmt.Errorf("%w: cursor was issued for a different sort", ErrInvalid)
+		}
+		spec.Cursor = cursor
+	}
+
+	return spec, nil
+}
+
+// splitKey splits a parameter name such as created_at[gte] into the field
+// and operator. A name without an operator compares for equality.
+func splitKey(key string) (name string, op Operator, explicit bool, err error) {
+	open := strings.IndexByte(key, '[')
+	if open < 0 {
+		return key, OpEq, false, nil
+	}
+	if !strings.HasSuffix(key, "]") {
+		return "", "", false, fmt.Errorf("%w: malformed parameter %q", ErrInvalid, key)
+	}
+
+	return key[:open], Operator(key[open+1 : len(key)-1]), true, nil
+}
+
+// parseSort reads a comma-separated list of sortable fields, each prefixed
+// with - to sort descending
+func parseSort(value string, fields Fields) ([]Sort, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	var sorts []Sort
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		s := Sort{Field: strings.TrimPrefix(part, "-"), Desc: strings.HasPrefix(part, "-")}
+		if field, ok := fields[s.Field]; !ok || !field.Sortable {
+			return nil, fmt.Errorf("%w: cannot sort by %q", ErrInvalid, s.Field)
+		}
+		if seen[s.Field] {
+			return nil, fmt.Errorf("%w: %q is sorted by more than once", ErrInvalid, s.Field)
+		}
+		seen[s.Field] = true
+		sorts = append(sorts, s)
+	}
+
+	return sorts, nil
+}
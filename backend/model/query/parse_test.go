@@ -0,0 +1,189 @@
+package query
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var testFields = Fields{
+	"name":        {Parse: String, Sortable: true},
+	"status":      {Parse: String, Ops: EqualityOps, Sortable: true},
+	"grand_price": {Parse: Int, Ops: RangeOps, Sortable: true},
+	"created_at":  {Parse: Time, Ops: RangeOps, Sortable: true},
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expected    Spec
+		expectError bool
+	}{
+		{
+			name:     "should read search and ignore other parameters",
+			query:    "search=acme&page=2&limit=10",
+			expected: Spec{Search: "acme"},
+		},
+		{
+			name:  "should read an equality filter",
+			query: "status=issued",
+			expected: Spec{Filters: []Filter{
+				{Field: "status", Op: OpEq, Value: "issued"},
+			}},
+		},
+		{
+			name:  "should read a list of values as any of them",
+			query: "status=issued,paid",
+			expected: Spec{Filters: []Filter{
+				{Field: "status", Op: OpIn, Value: []any{"issued", "paid"}},
+			}},
+		},
+		{
+			name:  "should read ranges in order of the field",
+			query: "grand_price[lte]=5000&created_at[gte]=2024-01-01&created_at[lt]=2024-02-01T12:00:00Z",
+			expected: Spec{Filters: []Filter{
+				{Field: "created_at", Op: OpGte, Value: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{Field: "created_at", Op: OpLt, Value: time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC)},
+				{Field: "grand_price", Op: OpLte, Value: int64(5000)},
+			}},
+		},
+		{
+			name:  "should read several sort fields",
+			query: "sort=-created_at,name",
+			expected: Spec{Sort: []Sort{
+				{Field: "created_at", Desc: true},
+				{Field: "name"},
+			}},
+		},
+		{
+			name:        "should reject sorting by a field that is not whitelisted",
+			query:       "sort=password",
+			expectError: true,
+		},
+		{
+			name:        "should reject sorting by a field twice",
+			query:       "sort=name,-name",
+			expectError: true,
+		},
+		{
+			name:        "should reject an operator the field does not allow",
+			query:       "status[gt]=issued",
+			expectError: true,
+		},
+		{
+			name:        "should reject equality on a range field",
+			query:       "grand_price=100",
+			expectError: true,
+		},
+		{
+			name:        "should reject an operator on a field that is not whitelisted",
+			query:       "password[eq]=secret",
+			expectError: true,
+		},
+		{
+			name:        "should reject several values for a range",
+			query:       "grand_price[gte]=1,2",
+			expectError: true,
+		},
+		{
+			name:        "should reject a value the field cannot parse",
+			query:       "created_at[gte]=yesterday",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("invalid test query: %v", err)
+			}
+
+			spec, err := Parse(values, testFields)
+			if tt.expectError {
+				if !errors.Is(err, ErrInvalid) {
+					t.Fatalf("expected ErrInvalid, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(spec, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, spec)
+			}
+		})
+	}
+}
+
+func TestParseCursor(t *testing.T) {
+	fields := Fields{
+		"name":   {Parse: String, Sortable: true},
+		"number": {Sortable: true, Nullable: true},
+	}
+	id := uuid.New()
+	sorts := []Sort{{Field: "name", Desc: true}}
+	cursor := Cursor{
+		Sort:   sorts,
+		Key:    []Key{{Field: "name", Value: "bravo"}, {Field: "id", Value: id}},
+		Before: true,
+	}
+
+	tests := []struct {
+		name        string
+		query       url.Values
+		expected    *Cursor
+		expectError bool
+	}{
+		{
+			name:     "should start from the first page with an empty cursor",
+			query:    url.Values{"cursor": {""}, "sort": {"-name"}},
+			expected: &Cursor{Sort: sorts},
+		},
+		{
+			name:     "should decode a cursor it encoded",
+			query:    url.Values{"cursor": {cursor.String()}, "sort": {"-name"}},
+			expected: &cursor,
+		},
+		{
+			name:        "should reject a cursor issued for another sort",
+			query:       url.Values{"cursor": {cursor.String()}, "sort": {"name"}},
+			expectError: true,
+		},
+		{
+			name:        "should reject a malformed cursor",
+			query:       url.Values{"cursor": {"not a cursor"}, "sort": {"-name"}},
+			expectError: true,
+		},
+		{
+			name:        "should reject paging through a nullable sort",
+			query:       url.Values{"cursor": {""}, "sort": {"number"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := Parse(tt.query, fields)
+			if tt.expectError {
+				if !errors.Is(err, ErrInvalid) {
+					t.Fatalf("expected ErrInvalid, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(spec.Cursor, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, spec.Cursor)
+			}
+		})
+	}
+}
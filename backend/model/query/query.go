@@ -0,0 +1,52 @@
+// Package query describes how list endpoints filter and order their
+// results. Handlers parse a Spec from the query string against the Fields
+// an endpoint whitelists, and each repository translates it to its store.
+package query
+
+import "github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+
+// ErrInvalid is wrapped by every error Parse returns
+var ErrInvalid = apperror.New(apperror.ErrBadRequest, "invalid_query", "invalid query")
+
+// Operator compares a field with the value of a filter
+type Operator string
+
+const (
+	OpEq  Operator = "eq"
+	OpIn  Operator = "in"
+	OpGt  Operator = "gt"
+	OpGte Operator = "gte"
+	OpLt  Operator = "lt"
+	OpLte Operator = "lte"
+)
+
+var (
+	// EqualityOps match one value, or any of a comma-separated list
+	EqualityOps = []Operator{OpEq, OpIn}
+	// RangeOps bound a field from either side
+	RangeOps = []Operator{OpGt, OpGte, OpLt, OpLte}
+)
+
+// Filter keeps the results whose Field compares to Value with Op.
+// For OpIn, Value is a []any holding every accepted value.
+type Filter struct {
+	Field string
+	Op    Operator
+	Value any
+}
+
+// Sort orders results by Field, descending when Desc is set
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Spec is a parsed list query. Filters all have to match, and results are
+// ordered by each Sort in turn. Lists are paged by offset unless Cursor is
+// set.
+type Spec struct {
+	Search  string
+	Filters []Filter
+	Sort    []Sort
+	Cursor  *Cursor
+}
@@ -0,0 +1,86 @@
+// Package rbac defines the permissions API routes require and the
+// permissions each role grants
+package rbac
+
+import (
+	"slices"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// Permission allows one kind of action on one resource, e.g. invoice:write
+type Permission string
+
+const (
+	ItemRead             Permission = "item:read"
+	ItemWrite            Permission = "item:write"
+	TagRead              Permission = "tag:read"
+	TagWrite             Permission = "tag:write"
+	CustomerRead         Permission = "customer:read"
+	CustomerWrite        Permission = "customer:write"
+	TaxRateRead          Permission = "tax_rate:read"
+	TaxRateWrite         Permission = "tax_rate:write"
+	ExchangeRateRead     Permission = "exchange_rate:read"
+	ExchangeRateWrite    Permission = "exchange_rate:write"
+	InvoiceRead          Permission = "invoice:read"
+	InvoiceWrite         Permission = "invoice:write"
+	InvoiceTemplateRead  Permission = "invoice_template:read"
+	InvoiceTemplateWrite Permission = "invoice_template:write"
+	// UserManage allows listing users and assigning their roles
+	UserManage Permission = "user:manage"
+)
+
+var (
+	readPermissions = []Permission{
+		ItemRead, TagRead, CustomerRead, TaxRateRead, ExchangeRateRead, InvoiceRead, InvoiceTemplateRead,
+	}
+	writePermissions = []Permission{
+		ItemWrite, TagWrite, CustomerWrite, TaxRateWrite, ExchangeRateWrite, InvoiceWrite, InvoiceTemplateWrite,
+	}
+	allPermissions = slices.Concat(readPermissions, writePermissions, []Permission{UserManage})
+)
+
+// rolePermissions is what each role grants
+var rolePermissions = map[entity.Role][]Permission{
+	entity.RoleAdmin:      allPermissions,
+	entity.RoleAccountant: slices.Concat(readPermissions, writePermissions),
+	entity.RoleViewer:     readPermissions,
+}
+
+// IsPermission reports whether permission is one routes require
+func IsPermission(permission string) bool {
+	return slices.Contains(allPermissions, Permission(permission))
+}
+
+// IsRole reports whether role can be assigned
+func IsRole(role string) bool {
+	_, ok := rolePermissions[entity.Role(role)]
+	return ok
+}
+
+// Allows reports whether any of roles grants permission
+func Allows(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		if slices.Contains(rolePermissions[entity.Role(role)], permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Permissions returns every permission roles grant, sorted and without
+// duplicates. Unknown roles grant nothing.
+func Permissions(roles []string) []Permission {
+	seen := make(map[Permission]bool)
+	permissions := []Permission{}
+	for _, role := range roles {
+		for _, permission := range rolePermissions[entity.Role(role)] {
+			if !seen[permission] {
+				seen[permission] = true
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	slices.Sort(permissions)
+	return permissions
+}
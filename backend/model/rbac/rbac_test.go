@@ -0,0 +1,90 @@
+package rbac
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []string
+		permission Permission
+		expected   bool
+	}{
+		{name: "should let an admin manage users", roles: []string{"admin"}, permission: UserManage, expected: true},
+		{name: "should let an admin write invoices", roles: []string{"admin"}, permission: InvoiceWrite, expected: true},
+		{name: "should let an accountant write invoices", roles: []string{"accountant"}, permission: InvoiceWrite, expected: true},
+		{name: "should not let an accountant manage users", roles: []string{"accountant"}, permission: UserManage},
+		{name: "should let a viewer read invoices", roles: []string{"viewer"}, permission: InvoiceRead, expected: true},
+		{name: "should not let a viewer write invoices", roles: []string{"viewer"}, permission: InvoiceWrite},
+		{name: "should grant what any role grants", roles: []string{"viewer", "accountant"}, permission: TagWrite, expected: true},
+		{name: "should grant nothing to unknown roles", roles: []string{"owner"}, permission: ItemRead},
+		{name: "should grant nothing without roles", permission: ItemRead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Allows(tt.roles, tt.permission); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		expected []Permission
+	}{
+		{
+			name:  "should list a viewer's permissions in order",
+			roles: []string{"viewer"},
+			expected: []Permission{
+				CustomerRead, ExchangeRateRead, InvoiceRead, InvoiceTemplateRead, ItemRead, TagRead, TaxRateRead,
+			},
+		},
+		{
+			name:  "should list overlapping permissions once",
+			roles: []string{"viewer", "viewer"},
+			expected: []Permission{
+				CustomerRead, ExchangeRateRead, InvoiceRead, InvoiceTemplateRead, ItemRead, TagRead, TaxRateRead,
+			},
+		},
+		{
+			name:     "should list nothing for unknown roles",
+			roles:    []string{"owner"},
+			expected: []Permission{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Permissions(tt.roles); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission string
+		expected   bool
+	}{
+		{name: "should know a read permission", permission: "invoice:read", expected: true},
+		{name: "should know user management", permission: "user:manage", expected: true},
+		{name: "should not know a role", permission: "admin"},
+		{name: "should not know an unknown permission", permission: "invoice:delete"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPermission(tt.permission); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package request
+
+// ForgotPasswordRequest asks for a password reset link to be emailed
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest sets a new password with an emailed reset token
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// VerifyEmailRequest confirms an email with an emailed verification token
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
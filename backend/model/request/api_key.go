@@ -0,0 +1,12 @@
+package request
+
+import "time"
+
+// CreateAPIKeyRequest creates a personal API key limited to Scopes, which
+// must be permissions the user's roles grant. The key never expires when
+// ExpiresAt is not given.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,max=255"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,required"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
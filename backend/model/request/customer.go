@@ -0,0 +1,19 @@
+package request
+
+// CreateCustomerRequest creates a customer invoices can be billed to.
+// BillingAddress is free text and may span several lines.
+type CreateCustomerRequest struct {
+	Name           string `json:"name" validate:"required"`
+	Email          string `json:"email" validate:"omitempty,email"`
+	BillingAddress string `json:"billing_address"`
+	TaxID          string `json:"tax_id"`
+}
+
+// UpdateCustomerRequest replaces a customer's details. Issued invoices keep
+// the details they were issued with.
+type UpdateCustomerRequest struct {
+	Name           string `json:"name" validate:"required"`
+	Email          string `json:"email" validate:"omitempty,email"`
+	BillingAddress string `json:"billing_address"`
+	TaxID          string `json:"tax_id"`
+}
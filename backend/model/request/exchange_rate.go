@@ -0,0 +1,12 @@
+package request
+
+// CreateExchangeRateRequest stores the value of one unit of BaseCurrency in
+// QuoteCurrency, e.g. EUR→USD at "1.0845". Rate is a decimal string so it is
+// kept exactly. EffectiveDate is YYYY-MM-DD and defaults to today; a rate for
+// the same pair and date is replaced.
+type CreateExchangeRateRequest struct {
+	BaseCurrency  string `json:"base_currency" validate:"required,len=3"`
+	QuoteCurrency string `json:"quote_currency" validate:"required,len=3"`
+	Rate          string `json:"rate" validate:"required"`
+	EffectiveDate string `json:"effective_date,omitempty"`
+}
@@ -1,21 +1,70 @@
 package request
 
-import "github.com/google/uuid"
+import (
+	"time"
 
+	"github.com/google/uuid"
+)
+
+// InvoiceItemInput is a single invoice line. UnitPrice is in minor units (e.g. cents).
+// DiscountValue is in basis points for a "percent" DiscountType and in minor
+// units for a "fixed" one. TaxRateID taxes the line at its own rate instead of
+// the invoice-level rate.
 type InvoiceItemInput struct {
-	ItemID    uuid.UUID `json:"item_id" validate:"required"`
-	Quantity  int       `json:"quantity" validate:"required,min=1"`
-	UnitPrice float64   `json:"unit_price" validate:"required,min=0"`
+	ItemID        uuid.UUID  `json:"item_id" validate:"required"`
+	Quantity      int        `json:"quantity" validate:"required,min=1"`
+	UnitPrice     int64      `json:"unit_price" validate:"min=0"`
+	DiscountType  string     `json:"discount_type,omitempty"`
+	DiscountValue int64      `json:"discount_value,omitempty" validate:"min=0"`
+	TaxRateID     *uuid.UUID `json:"tax_rate_id,omitempty"`
 }
 
+// CreateInvoiceRequest creates an invoice. The grand total is computed by the
+// server; GrandPrice is optional and, when sent, must match the computed value.
+// TaxRateID taxes every line that has no tax rate of its own. Currency is an
+// ISO 4217 code and defaults to the configured base currency; all amounts are
+// in its minor unit. CustomerID is the customer billed and is required.
 type CreateInvoiceRequest struct {
-	GrandPrice float64            `json:"grand_price" validate:"required,min=0"`
-	Items      []InvoiceItemInput `json:"items" validate:"required,min=1"`
+	CustomerID uuid.UUID          `json:"customer_id" validate:"required"`
+	Currency   string             `json:"currency,omitempty"`
+	GrandPrice *int64             `json:"grand_price,omitempty"`
+	TaxRateID  *uuid.UUID         `json:"tax_rate_id,omitempty"`
+	Items      []InvoiceItemInput `json:"items" validate:"required,min=1,dive"`
 	Tags       []uuid.UUID        `json:"tags"`
 }
 
+// UpdateInvoiceRequest replaces an invoice's customer, lines, tags and tax rate.
+// GrandPrice follows the same rules as in CreateInvoiceRequest. An empty
+// Currency keeps the invoice's current one.
 type UpdateInvoiceRequest struct {
-	GrandPrice float64            `json:"grand_price" validate:"required,min=0"`
-	Items      []InvoiceItemInput `json:"items" validate:"required,min=1"`
+	CustomerID uuid.UUID          `json:"customer_id" validate:"required"`
+	Currency   string             `json:"currency,omitempty"`
+	GrandPrice *int64             `json:"grand_price,omitempty"`
+	TaxRateID  *uuid.UUID         `json:"tax_rate_id,omitempty"`
+	Items      []InvoiceItemInput `json:"items" validate:"required,min=1,dive"`
 	Tags       []uuid.UUID        `json:"tags"`
 }
+
+// CreatePaymentRequest records a payment or refund against an invoice.
+// Amount is in minor units (e.g. cents). Kind defaults to "payment", Method
+// to "other" and PaidAt to the current time.
+type CreatePaymentRequest struct {
+	Kind      string     `json:"kind"`
+	Amount    int64      `json:"amount" validate:"required,min=1"`
+	Method    string     `json:"method"`
+	PaidAt    *time.Time `json:"paid_at"`
+	Reference string     `json:"reference"`
+}
+
+// CreditNoteLineInput credits Quantity units of one of the invoice's lines
+type CreditNoteLineInput struct {
+	InvoiceItemID uuid.UUID `json:"invoice_item_id" validate:"required"`
+	Quantity      int       `json:"quantity" validate:"required,min=1"`
+}
+
+// CreateCreditNoteRequest credits lines of an issued invoice. Each line may
+// be credited up to the quantity not already credited by earlier notes.
+type CreateCreditNoteRequest struct {
+	Reason string                `json:"reason" validate:"required"`
+	Lines  []CreditNoteLineInput `json:"lines" validate:"required,min=1,dive"`
+}
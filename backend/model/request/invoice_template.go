@@ -0,0 +1,38 @@
+package request
+
+import "github.com/google/uuid"
+
+// CreateInvoiceTemplateRequest creates a recurring invoice template.
+// CustomerID, Items, Tags, TaxRateID and Currency mean the same as in
+// CreateInvoiceRequest and are copied onto every invoice the template creates. Cadence is "weekly",
+// "monthly", "quarterly" or "yearly", and NextRunDate (YYYY-MM-DD) is the
+// first date an invoice is created, defaulting to today. AutoIssue issues
+// each invoice as soon as it is created instead of leaving a draft.
+type CreateInvoiceTemplateRequest struct {
+	Name        string             `json:"name" validate:"required"`
+	CustomerID  uuid.UUID          `json:"customer_id" validate:"required"`
+	Currency    string             `json:"currency,omitempty"`
+	TaxRateID   *uuid.UUID         `json:"tax_rate_id,omitempty"`
+	Items       []InvoiceItemInput `json:"items" validate:"required,min=1,dive"`
+	Tags        []uuid.UUID        `json:"tags"`
+	Cadence     string             `json:"cadence" validate:"required"`
+	NextRunDate string             `json:"next_run_date,omitempty"`
+	AutoIssue   bool               `json:"auto_issue"`
+	Active      *bool              `json:"active,omitempty"`
+}
+
+// UpdateInvoiceTemplateRequest replaces a template. An empty Currency keeps
+// the current one, an empty NextRunDate keeps the schedule and a missing
+// Active keeps the template running or paused.
+type UpdateInvoiceTemplateRequest struct {
+	Name        string             `json:"name" validate:"required"`
+	CustomerID  uuid.UUID          `json:"customer_id" validate:"required"`
+	Currency    string             `json:"currency,omitempty"`
+	TaxRateID   *uuid.UUID         `json:"tax_rate_id,omitempty"`
+	Items       []InvoiceItemInput `json:"items" validate:"required,min=1,dive"`
+	Tags        []uuid.UUID        `json:"tags"`
+	Cadence     string             `json:"cadence" validate:"required"`
+	NextRunDate string             `json:"next_run_date,omitempty"`
+	AutoIssue   bool               `json:"auto_issue"`
+	Active      *bool              `json:"active,omitempty"`
+}
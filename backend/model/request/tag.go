@@ -2,10 +2,10 @@ package request
 
 type CreateTagRequest struct {
 	Name     string `json:"name" validate:"required"`
-	ColorHex string `json:"color_hex" validate:"required"`
+	ColorHex string `json:"color_hex" validate:"required,colorhex"`
 }
 
 type UpdateTagRequest struct {
 	Name     string `json:"name" validate:"required"`
-	ColorHex string `json:"color_hex" validate:"required"`
+	ColorHex string `json:"color_hex" validate:"required,colorhex"`
 }
@@ -0,0 +1,14 @@
+package request
+
+// CreateTaxRateRequest creates a tax rate. Rate is in basis points (1/100 of a percent).
+type CreateTaxRateRequest struct {
+	Name string `json:"name" validate:"required"`
+	Rate int64  `json:"rate" validate:"min=0,max=10000"`
+}
+
+// UpdateTaxRateRequest renames or changes a tax rate. Invoices keep the rate
+// they were priced with.
+type UpdateTaxRateRequest struct {
+	Name string `json:"name" validate:"required"`
+	Rate int64  `json:"rate" validate:"min=0,max=10000"`
+}
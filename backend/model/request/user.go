@@ -1,12 +1,17 @@
 package request
 
 type RegisterUserRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Name     string `json:"name"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+	Name     string `json:"name" validate:"required"`
 }
 
 type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// UpdateUserRolesRequest replaces the roles granted to a user
+type UpdateUserRolesRequest struct {
+	Roles []string `json:"roles" validate:"required,min=1,dive,oneof=admin accountant viewer"`
 }
@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyResponse describes a personal API key without the key itself
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyResponse is a new API key. Key is only ever shown here.
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyListResponse struct {
+	Data []APIKeyResponse `json:"data"`
+}
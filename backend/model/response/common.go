@@ -5,3 +5,25 @@ import "github.com/google/uuid"
 type CommonIDResponse struct {
 	ID uuid.UUID `json:"value"`
 }
+
+// FieldErrorResponse is a request field that broke a validation rule.
+// Param is the rule's argument, e.g. "1" for min=1.
+type FieldErrorResponse struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+	Param string `json:"param,omitempty"`
+}
+
+// ProblemResponse is an RFC 7807 problem details body, sent as
+// application/problem+json for every failed request. Code is a stable
+// identifier of the error, and Fields lists the failing fields of a
+// request that did not validate.
+type ProblemResponse struct {
+	Type     string               `json:"type"`
+	Title    string               `json:"title"`
+	Status   int                  `json:"status"`
+	Detail   string               `json:"detail,omitempty"`
+	Instance string               `json:"instance,omitempty"`
+	Code     string               `json:"code"`
+	Fields   []FieldErrorResponse `json:"fields,omitempty"`
+}
@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CustomerResponse struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	BillingAddress string    `json:"billing_address"`
+	TaxID          string    `json:"tax_id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type CustomerPaginationMeta struct {
+	TotalData int `json:"totalData"`
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	TotalPage int `json:"totalPage"`
+}
+
+type CustomerPaginationResponse struct {
+	Data []CustomerResponse     `json:"data"`
+	Meta CustomerPaginationMeta `json:"meta"`
+}
@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateResponse struct {
+	ID            uuid.UUID `json:"id"`
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	Rate          string    `json:"rate"`
+	EffectiveDate string    `json:"effective_date"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ExchangeRateImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+type ExchangeRatePaginationMeta struct {
+	TotalData int `json:"totalData"`
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	TotalPage int `json:"totalPage"`
+}
+
+type ExchangeRatePaginationResponse struct {
+	Data []ExchangeRateResponse     `json:"data"`
+	Meta ExchangeRatePaginationMeta `json:"meta"`
+}
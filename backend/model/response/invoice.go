@@ -7,44 +7,159 @@ import (
 )
 
 type InvoiceItemResponse struct {
-	ID         uuid.UUID    `json:"id"`
-	ItemID     uuid.UUID    `json:"item_id"`
-	Item       ItemResponse `json:"item"`
-	Quantity   int          `json:"quantity"`
-	UnitPrice  float64      `json:"unit_price"`
-	TotalPrice float64      `json:"total_price"`
+	ID             uuid.UUID    `json:"id"`
+	ItemID         uuid.UUID    `json:"item_id"`
+	Item           ItemResponse `json:"item"`
+	Quantity       int          `json:"quantity"`
+	UnitPrice      int64        `json:"unit_price"`
+	Subtotal       int64        `json:"subtotal"`
+	DiscountType   string       `json:"discount_type"`
+	DiscountValue  int64        `json:"discount_value"`
+	DiscountAmount int64        `json:"discount_amount"`
+	TaxRateID      *uuid.UUID   `json:"tax_rate_id"`
+	TaxRate        int64        `json:"tax_rate"`
+	TaxAmount      int64        `json:"tax_amount"`
+	TotalPrice     int64        `json:"total_price"`
+}
+
+type PaymentResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Kind      string    `json:"kind"`
+	Amount    int64     `json:"amount"`
+	Method    string    `json:"method"`
+	PaidAt    time.Time `json:"paid_at"`
+	Reference string    `json:"reference"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreditNoteLineResponse is the credited share of one invoice line
+type CreditNoteLineResponse struct {
+	ID            uuid.UUID `json:"id"`
+	InvoiceItemID uuid.UUID `json:"invoice_item_id"`
+	Quantity      int       `json:"quantity"`
+	Amount        int64     `json:"amount"`
+	TaxAmount     int64     `json:"tax_amount"`
+	Total         int64     `json:"total"`
+}
+
+type CreditNoteResponse struct {
+	ID        uuid.UUID                `json:"id"`
+	Number    string                   `json:"number"`
+	Reason    string                   `json:"reason"`
+	Subtotal  int64                    `json:"subtotal"`
+	TaxTotal  int64                    `json:"tax_total"`
+	Total     int64                    `json:"total"`
+	IssuedAt  time.Time                `json:"issued_at"`
+	Lines     []CreditNoteLineResponse `json:"lines"`
+	CreatedAt time.Time                `json:"created_at"`
+}
+
+// InvoiceCustomerResponse is who an invoice is billed to: the customer's
+// current details while it is a draft, and the details snapshotted when it
+// was issued from then on
+type InvoiceCustomerResponse struct {
+	Name           string `json:"name"`
+	Email          string `json:"email"`
+	BillingAddress string `json:"billing_address"`
+	TaxID          string `json:"tax_id"`
 }
 
 type InvoiceResponse struct {
 	ID         uuid.UUID `json:"id"`
-	GrandPrice float64   `json:"grand_price"`
+	GrandPrice int64     `json:"grand_price"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 type InvoiceDetailResponse struct {
-	ID         uuid.UUID             `json:"id"`
-	GrandPrice float64               `json:"grand_price"`
-	Items      []InvoiceItemResponse `json:"items"`
-	Tags       []TagResponse         `json:"tags"`
-	CreatedAt  time.Time             `json:"created_at"`
-	UpdatedAt  time.Time             `json:"updated_at"`
+	ID                 uuid.UUID                `json:"id"`
+	Number             *string                  `json:"number"`
+	Status             string                   `json:"status"`
+	CustomerID         *uuid.UUID               `json:"customer_id"`
+	Customer           *InvoiceCustomerResponse `json:"customer"`
+	Currency           string                   `json:"currency"`
+	Subtotal           int64                    `json:"subtotal"`
+	DiscountTotal      int64                    `json:"discount_total"`
+	TaxRateID          *uuid.UUID               `json:"tax_rate_id"`
+	TaxRate            int64                    `json:"tax_rate"`
+	TaxTotal           int64                    `json:"tax_total"`
+	GrandPrice         int64                    `json:"grand_price"`
+	AmountPaid         int64                    `json:"amount_paid"`
+	CreditedTotal      int64                    `json:"credited_total"`
+	OutstandingBalance int64                    `json:"outstanding_balance"`
+	CreditBalance      int64                    `json:"credit_balance"`
+	BaseCurrency       *string                  `json:"base_currency"`
+	ExchangeRate       *string                  `json:"exchange_rate"`
+	BaseGrandPrice     *int64                   `json:"base_grand_price"`
+	IssuedAt           *time.Time               `json:"issued_at"`
+	PaidAt             *time.Time               `json:"paid_at"`
+	VoidedAt           *time.Time               `json:"voided_at"`
+	Items              []InvoiceItemResponse    `json:"items"`
+	Tags               []TagResponse            `json:"tags"`
+	Payments           []PaymentResponse        `json:"payments"`
+	CreditNotes        []CreditNoteResponse     `json:"credit_notes"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
 }
 
 type InvoiceListItem struct {
-	ID         uuid.UUID     `json:"id"`
-	GrandPrice float64       `json:"grand_price"`
-	Tags       []TagResponse `json:"tags"`
-	TotalItem  int           `json:"totalItem"`
-	CreatedAt  time.Time     `json:"created_at"`
-	UpdatedAt  time.Time     `json:"updated_at"`
+	ID                 uuid.UUID                `json:"id"`
+	Number             *string                  `json:"number"`
+	Status             string                   `json:"status"`
+	CustomerID         *uuid.UUID               `json:"customer_id"`
+	Customer           *InvoiceCustomerResponse `json:"customer"`
+	Currency           string                   `json:"currency"`
+	GrandPrice         int64                    `json:"grand_price"`
+	AmountPaid         int64                    `json:"amount_paid"`
+	OutstandingBalance int64                    `json:"outstanding_balance"`
+	Converted          *ConvertedAmounts        `json:"converted,omitempty"`
+	Tags               []TagResponse            `json:"tags"`
+	TotalItem          int                      `json:"totalItem"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+}
+
+// ConvertedAmounts are invoice totals converted into another currency, in
+// its minor unit. ExchangeRate is empty when totals were converted at more
+// than one rate.
+type ConvertedAmounts struct {
+	Currency           string `json:"currency"`
+	ExchangeRate       string `json:"exchange_rate,omitempty"`
+	GrandPrice         int64  `json:"grand_price"`
+	AmountPaid         int64  `json:"amount_paid"`
+	OutstandingBalance int64  `json:"outstanding_balance"`
+}
+
+// InvoiceCurrencyTotals sums the invoices in one currency, in its minor unit,
+// alongside the same totals converted into the summary currency
+type InvoiceCurrencyTotals struct {
+	Currency           string           `json:"currency"`
+	Count              int64            `json:"count"`
+	GrandPrice         int64            `json:"grand_price"`
+	AmountPaid         int64            `json:"amount_paid"`
+	OutstandingBalance int64            `json:"outstanding_balance"`
+	Converted          ConvertedAmounts `json:"converted"`
+}
+
+// InvoiceSummaryResponse totals invoices in a single currency
+type InvoiceSummaryResponse struct {
+	Currency           string                  `json:"currency"`
+	Count              int64                   `json:"count"`
+	GrandPrice         int64                   `json:"grand_price"`
+	AmountPaid         int64                   `json:"amount_paid"`
+	OutstandingBalance int64                   `json:"outstanding_balance"`
+	ByCurrency         []InvoiceCurrencyTotals `json:"by_currency"`
 }
 
+// InvoicePaginationMeta describes a page of invoices. Pages read by cursor
+// are not counted and carry the cursors of the pages either side instead.
 type InvoicePaginationMeta struct {
-	TotalData int `json:"totalData"`
-	Page      int `json:"page"`
-	Limit     int `json:"limit"`
-	TotalPage int `json:"totalPage"`
+	TotalData  int     `json:"totalData"`
+	Page       int     `json:"page"`
+	Limit      int     `json:"limit"`
+	TotalPage  int     `json:"totalPage"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+	PrevCursor *string `json:"prevCursor,omitempty"`
 }
 
 type InvoicePaginationResponse struct {
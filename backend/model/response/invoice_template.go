@@ -0,0 +1,51 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvoiceTemplateItemResponse struct {
+	ID            uuid.UUID    `json:"id"`
+	ItemID        uuid.UUID    `json:"item_id"`
+	Item          ItemResponse `json:"item"`
+	Quantity      int          `json:"quantity"`
+	UnitPrice     int64        `json:"unit_price"`
+	DiscountType  string       `json:"discount_type"`
+	DiscountValue int64        `json:"discount_value"`
+	TaxRateID     *uuid.UUID   `json:"tax_rate_id"`
+}
+
+// InvoiceTemplateResponse is a recurring invoice template. LastError explains
+// why the latest scheduled run failed and is cleared by the next success.
+type InvoiceTemplateResponse struct {
+	ID          uuid.UUID                     `json:"id"`
+	Name        string                        `json:"name"`
+	CustomerID  *uuid.UUID                    `json:"customer_id"`
+	Customer    *InvoiceCustomerResponse      `json:"customer"`
+	Currency    string                        `json:"currency"`
+	TaxRateID   *uuid.UUID                    `json:"tax_rate_id"`
+	Cadence     string                        `json:"cadence"`
+	NextRunDate string                        `json:"next_run_date"`
+	AutoIssue   bool                          `json:"auto_issue"`
+	Active      bool                          `json:"active"`
+	LastRunAt   *time.Time                    `json:"last_run_at"`
+	LastError   *string                       `json:"last_error"`
+	Items       []InvoiceTemplateItemResponse `json:"items"`
+	Tags        []TagResponse                 `json:"tags"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+}
+
+type InvoiceTemplatePaginationMeta struct {
+	TotalData int `json:"totalData"`
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	TotalPage int `json:"totalPage"`
+}
+
+type InvoiceTemplatePaginationResponse struct {
+	Data []InvoiceTemplateResponse     `json:"data"`
+	Meta InvoiceTemplatePaginationMeta `json:"meta"`
+}
@@ -14,11 +14,15 @@ type ItemResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// ItemPaginationMeta describes a page of items. Pages read by cursor are not
+// counted and carry the cursors of the pages either side instead.
 type ItemPaginationMeta struct {
-	TotalData int `json:"totalData"`
-	Page      int `json:"page"`
-	Limit     int `json:"limit"`
-	TotalPage int `json:"totalPage"`
+	TotalData  int     `json:"totalData"`
+	Page       int     `json:"page"`
+	Limit      int     `json:"limit"`
+	TotalPage  int     `json:"totalPage"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+	PrevCursor *string `json:"prevCursor,omitempty"`
 }
 
 type ItemPaginationResponse struct {
@@ -0,0 +1,20 @@
+package response
+
+// JWK is the public half of a key access tokens are signed with (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	// N and E are the modulus and exponent of an RSA key
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Curve and X are the curve and public key of an Ed25519 key
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKSResponse lists the keys access tokens can be verified with
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
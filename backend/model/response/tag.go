@@ -14,11 +14,15 @@ type TagResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// TagPaginationMeta describes a page of tags. Pages read by cursor are not
+// counted and carry the cursors of the pages either side instead.
 type TagPaginationMeta struct {
-	TotalData int `json:"totalData"`
-	Page      int `json:"page"`
-	Limit     int `json:"limit"`
-	TotalPage int `json:"totalPage"`
+	TotalData  int     `json:"totalData"`
+	Page       int     `json:"page"`
+	Limit      int     `json:"limit"`
+	TotalPage  int     `json:"totalPage"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+	PrevCursor *string `json:"prevCursor,omitempty"`
 }
 
 type TagPaginationResponse struct {
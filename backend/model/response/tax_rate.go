@@ -0,0 +1,27 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaxRateResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Rate      int64     `json:"rate"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TaxRatePaginationMeta struct {
+	TotalData int `json:"totalData"`
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	TotalPage int `json:"totalPage"`
+}
+
+type TaxRatePaginationResponse struct {
+	Data []TaxRateResponse     `json:"data"`
+	Meta TaxRatePaginationMeta `json:"meta"`
+}
@@ -1,26 +1,60 @@
 package response
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+)
 
 // GetUser represents a user in the system
 type GetUser struct {
-	ID    uuid.UUID `json:"id"`
-	Email string    `json:"email"`
-	Name  string    `json:"name"`
+	ID          uuid.UUID         `json:"id"`
+	Email       string            `json:"email"`
+	Name        string            `json:"name"`
+	Roles       []string          `json:"roles"`
+	Permissions []rbac.Permission `json:"permissions"`
+	// VerifiedAt is when the user confirmed their email, null until then
+	VerifiedAt *time.Time `json:"verified_at"`
+}
+
+// UserResponse is a user as listed to admins
+type UserResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Email      string     `json:"email"`
+	Name       string     `json:"name"`
+	Roles      []string   `json:"roles"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type UserPaginationMeta struct {
+	TotalData int `json:"totalData"`
+	Page      int `json:"page"`
+	Limit     int `json:"limit"`
+	TotalPage int `json:"totalPage"`
+}
+
+type UserPaginationResponse struct {
+	Data []UserResponse     `json:"data"`
+	Meta UserPaginationMeta `json:"meta"`
 }
 
 type LoginResponse struct {
-	Token string  `json:"token"`
-	User  GetUser `json:"user"`
+	Token        string  `json:"token"`
+	RefreshToken string  `json:"-"`
+	User         GetUser `json:"user"`
 }
 
 type RegisterResponse struct {
-	Token string  `json:"token"`
-	User  GetUser `json:"user"`
+	Token        string  `json:"token"`
+	RefreshToken string  `json:"-"`
+	User         GetUser `json:"user"`
 }
 
 type RefreshResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"-"`
 }
 
 type CSRFTokenResponse struct {
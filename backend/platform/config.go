@@ -1,8 +1,10 @@
 package platform
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -10,13 +12,33 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	Auth       AuthConfig
+	Login      LoginConfig
+	Account    AccountConfig
+	Mail       MailConfig
+	Invoice    InvoiceConfig
+	Pagination PaginationConfig
 }
 
+// EnvironmentProduction is the APP_ENV that refuses the default secrets
+const EnvironmentProduction = "production"
+
+// Defaults of the auth secrets, only fit for local development
+const (
+	defaultAccessTokenSecret  = "access-secret-key-change-in-production"
+	defaultRefreshTokenSecret = "refresh-secret-key-change-in-production"
+	defaultCSRFSecret         = "csrf-secret-key-change-in-production"
+)
+
 // ServerConfig holds HTTP server configuration
 type ServerConfig struct {
+	// Environment is e.g. "development" or "production"
+	Environment string
+	// PublicURL is where users reach the app, used in links sent by email
+	PublicURL    string
 	Port         int
 	Host         string
 	ReadTimeout  time.Duration
@@ -42,10 +64,87 @@ type RedisConfig struct {
 	Password string
 }
 
+// AuthConfig holds the secrets and keys tokens are signed with
+type AuthConfig struct {
+	// AccessTokenSecret signs access tokens with HS256 when no
+	// SigningKeyFiles are given
+	AccessTokenSecret  string
+	RefreshTokenSecret string
+	CSRFSecret         string
+	// SigningKeyFiles are PEM files of RS256 or EdDSA keys access tokens
+	// are signed with. The first signs new tokens and all of them verify.
+	SigningKeyFiles []string
+}
+
+// LoginConfig holds the limits on failed sign-ins
+type LoginConfig struct {
+	// MaxFailures locks an account after this many failed sign-ins
+	MaxFailures int
+	// IPMaxFailures blocks a client IP after this many failed sign-ins
+	IPMaxFailures int
+	// BaseDelay is the wait after a failed sign-in to an account, doubling
+	// with each further failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LockoutDuration is how long a lockout lasts, and how long failures
+	// are remembered
+	LockoutDuration time.Duration
+	// AttemptStore is where failures are counted: "database", shared by
+	// every instance, or "memory"
+	AttemptStore string
+}
+
+// AccountConfig holds how long emailed account tokens stay valid
+type AccountConfig struct {
+	PasswordResetExpiry     time.Duration
+	EmailVerificationExpiry time.Duration
+}
+
+// MailConfig holds how email is sent
+type MailConfig struct {
+	// Driver is "file", which writes each message to Dir or logs it when
+	// Dir is empty, or "smtp"
+	Driver string
+	Dir    string
+	// From is the address messages are sent from
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// InvoiceConfig holds invoicing configuration
+type InvoiceConfig struct {
+	// NumberFormat builds invoice numbers, e.g. "INV-{YYYY}-{SEQ:6}"
+	NumberFormat string
+	// CreditNoteNumberFormat builds credit note numbers, e.g. "CN-{YYYY}-{SEQ:6}"
+	CreditNoteNumberFormat string
+	// BaseCurrency is the ISO 4217 code invoices default to and are
+	// converted into when issued, e.g. "USD"
+	BaseCurrency string
+	// PDFTemplate is the path of the layout template invoice PDFs are
+	// rendered with; empty uses the built-in template
+	PDFTemplate string
+	// SchedulerInterval is how often due recurring invoice templates are
+	// checked; zero or less disables the scheduler
+	SchedulerInterval time.Duration
+}
+
+// PaginationConfig holds the page sizes list endpoints accept
+type PaginationConfig struct {
+	// DefaultLimit is the page size when a request does not give one
+	DefaultLimit int
+	// MaxLimit is the largest page size a request can ask for
+	MaxLimit int
+}
+
 // NewConfig loads configuration from environment variables
 func NewConfig() *Config {
 	return &Config{
 		Server: ServerConfig{
+			Environment:  getEnv("APP_ENV", "development"),
+			PublicURL:    getEnv("APP_URL", "http://localhost:8080"),
 			Port:         getEnvInt("SERVER_PORT", 8080),
 			Host:         getEnv("SERVER_HOST", ""),
 			ReadTimeout:  getEnvDuration("SERVER_READ_TIMEOUT", 15*time.Second),
@@ -65,7 +164,68 @@ func NewConfig() *Config {
 			DB:       getEnvInt("REDIS_DB", 0),
 			Password: getEnv("REDIS_PASSWORD", ""),
 		},
+		Auth: AuthConfig{
+			AccessTokenSecret:  getEnv("JWT_ACCESS_SECRET", defaultAccessTokenSecret),
+			RefreshTokenSecret: getEnv("JWT_REFRESH_SECRET", defaultRefreshTokenSecret),
+			CSRFSecret:         getEnv("CSRF_SECRET", defaultCSRFSecret),
+			SigningKeyFiles:    getEnvList("JWT_SIGNING_KEY_FILES"),
+		},
+		Login: LoginConfig{
+			MaxFailures:     getEnvInt("LOGIN_MAX_FAILURES", 5),
+			IPMaxFailures:   getEnvInt("LOGIN_IP_MAX_FAILURES", 20),
+			BaseDelay:       getEnvDuration("LOGIN_BACKOFF_BASE", time.Second),
+			MaxDelay:        getEnvDuration("LOGIN_BACKOFF_MAX", 30*time.Second),
+			LockoutDuration: getEnvDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+			AttemptStore:    getEnv("LOGIN_ATTEMPT_STORE", "database"),
+		},
+		Account: AccountConfig{
+			PasswordResetExpiry:     getEnvDuration("PASSWORD_RESET_EXPIRY", time.Hour),
+			EmailVerificationExpiry: getEnvDuration("EMAIL_VERIFICATION_EXPIRY", 24*time.Hour),
+		},
+		Mail: MailConfig{
+			Driver:       getEnv("MAIL_DRIVER", "file"),
+			Dir:          getEnv("MAIL_DIR", ""),
+			From:         getEnv("MAIL_FROM", "no-reply@localhost"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		},
+		Invoice: InvoiceConfig{
+			NumberFormat:           getEnv("INVOICE_NUMBER_FORMAT", "INV-{YYYY}-{SEQ:6}"),
+			CreditNoteNumberFormat: getEnv("CREDIT_NOTE_NUMBER_FORMAT", "CN-{YYYY}-{SEQ:6}"),
+			BaseCurrency:           getEnv("INVOICE_BASE_CURRENCY", "USD"),
+			PDFTemplate:            getEnv("INVOICE_PDF_TEMPLATE", ""),
+			SchedulerInterval:      getEnvDuration("INVOICE_SCHEDULER_INTERVAL", time.Minute),
+		},
+		Pagination: PaginationConfig{
+			DefaultLimit: getEnvInt("PAGINATION_DEFAULT_LIMIT", 10),
+			MaxLimit:     getEnvInt("PAGINATION_MAX_LIMIT", 100),
+		},
+	}
+}
+
+// Validate reports configuration unsafe to run with. In production every
+// secret must be set, except JWT_ACCESS_SECRET when signing key files are.
+func (c *Config) Validate() error {
+	if c.Server.Environment != EnvironmentProduction {
+		return nil
+	}
+
+	var defaults []string
+	if c.Auth.AccessTokenSecret == defaultAccessTokenSecret && len(c.Auth.SigningKeyFiles) == 0 {
+		defaults = append(defaults, "JWT_ACCESS_SECRET")
+	}
+	if c.Auth.RefreshTokenSecret == defaultRefreshTokenSecret {
+		defaults = append(defaults, "JWT_REFRESH_SECRET")
+	}
+	if c.Auth.CSRFSecret == defaultCSRFSecret {
+		defaults = append(defaults, "CSRF_SECRET")
+	}
+	if len(defaults) > 0 {
+		return fmt.Errorf("%s must be set in production", strings.Join(defaults, ", "))
 	}
+	return nil
 }
 
 // Helper functions for environment variable parsing
@@ -93,6 +253,17 @@ func getEnvBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// getEnvList splits a comma-separated variable, skipping empty entries
+func getEnvList(key string) []string {
+	var list []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -2,6 +2,7 @@ package platform
 
 import (
 	"os"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -81,6 +82,203 @@ func TestNewConfig_DatabaseConfig(t *testing.T) {
 	}
 }
 
+func TestNewConfig_InvoiceConfig(t *testing.T) {
+	clearEnv()
+	defer clearEnv()
+
+	cfg := NewConfig()
+	if cfg.Invoice.NumberFormat != "INV-{YYYY}-{SEQ:6}" {
+		t.Errorf("expected default invoice number format, got %q", cfg.Invoice.NumberFormat)
+	}
+	if cfg.Invoice.CreditNoteNumberFormat != "CN-{YYYY}-{SEQ:6}" {
+		t.Errorf("expected default credit note number format, got %q", cfg.Invoice.CreditNoteNumberFormat)
+	}
+
+	os.Setenv("INVOICE_NUMBER_FORMAT", "{YY}{MM}-{SEQ}")
+	cfg = NewConfig()
+	if cfg.Invoice.NumberFormat != "{YY}{MM}-{SEQ}" {
+		t.Errorf("expected invoice number format override, got %q", cfg.Invoice.NumberFormat)
+	}
+
+	if cfg.Invoice.BaseCurrency != "USD" {
+		t.Errorf("expected default base currency USD, got %q", cfg.Invoice.BaseCurrency)
+	}
+	os.Setenv("INVOICE_BASE_CURRENCY", "EUR")
+	cfg = NewConfig()
+	if cfg.Invoice.BaseCurrency != "EUR" {
+		t.Errorf("expected base currency override, got %q", cfg.Invoice.BaseCurrency)
+	}
+
+	if cfg.Invoice.PDFTemplate != "" {
+		t.Errorf("expected built-in PDF template by default, got %q", cfg.Invoice.PDFTemplate)
+	}
+	os.Setenv("INVOICE_PDF_TEMPLATE", "/etc/app/invoice.tmpl")
+	cfg = NewConfig()
+	if cfg.Invoice.PDFTemplate != "/etc/app/invoice.tmpl" {
+		t.Errorf("expected PDF template override, got %q", cfg.Invoice.PDFTemplate)
+	}
+
+	if cfg.Invoice.SchedulerInterval != time.Minute {
+		t.Errorf("expected default scheduler interval of 1m, got %v", cfg.Invoice.SchedulerInterval)
+	}
+	os.Setenv("INVOICE_SCHEDULER_INTERVAL", "0s")
+	cfg = NewConfig()
+	if cfg.Invoice.SchedulerInterval != 0 {
+		t.Errorf("expected scheduler to be disabled, got %v", cfg.Invoice.SchedulerInterval)
+	}
+}
+
+func TestNewConfig_PaginationConfig(t *testing.T) {
+	clearEnv()
+	defer clearEnv()
+
+	cfg := NewConfig()
+	if cfg.Pagination.DefaultLimit != 10 || cfg.Pagination.MaxLimit != 100 {
+		t.Errorf("expected default page sizes 10 and 100, got %+v", cfg.Pagination)
+	}
+
+	os.Setenv("PAGINATION_DEFAULT_LIMIT", "25")
+	os.Setenv("PAGINATION_MAX_LIMIT", "50")
+	cfg = NewConfig()
+	if cfg.Pagination.DefaultLimit != 25 || cfg.Pagination.MaxLimit != 50 {
+		t.Errorf("expected page size overrides, got %+v", cfg.Pagination)
+	}
+}
+
+func TestNewConfig_AuthConfig(t *testing.T) {
+	clearEnv()
+	defer clearEnv()
+
+	cfg := NewConfig()
+	if cfg.Server.Environment != "development" {
+		t.Errorf("expected development environment by default, got %q", cfg.Server.Environment)
+	}
+	if cfg.Auth.SigningKeyFiles != nil {
+		t.Errorf("expected no signing key files by default, got %v", cfg.Auth.SigningKeyFiles)
+	}
+
+	os.Setenv("JWT_SIGNING_KEY_FILES", "/keys/current.pem, /keys/previous.pem,")
+	cfg = NewConfig()
+	if !reflect.DeepEqual(cfg.Auth.SigningKeyFiles, []string{"/keys/current.pem", "/keys/previous.pem"}) {
+		t.Errorf("expected both signing key files, got %v", cfg.Auth.SigningKeyFiles)
+	}
+}
+
+func TestNewConfig_LoginConfig(t *testing.T) {
+	clearEnv()
+	defer clearEnv()
+
+	cfg := NewConfig()
+	expected := LoginConfig{
+		MaxFailures:     5,
+		IPMaxFailures:   20,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		LockoutDuration: 15 * time.Minute,
+		AttemptStore:    "database",
+	}
+	if cfg.Login != expected {
+		t.Errorf("expected default login limits %+v, got %+v", expected, cfg.Login)
+	}
+
+	os.Setenv("LOGIN_MAX_FAILURES", "3")
+	os.Setenv("LOGIN_LOCKOUT_DURATION", "1h")
+	os.Setenv("LOGIN_ATTEMPT_STORE", "memory")
+	cfg = NewConfig()
+	if cfg.Login.MaxFailures != 3 || cfg.Login.LockoutDuration != time.Hour || cfg.Login.AttemptStore != "memory" {
+		t.Errorf("expected login limit overrides, got %+v", cfg.Login)
+	}
+}
+
+func TestNewConfig_MailConfig(t *testing.T) {
+	clearEnv()
+	defer clearEnv()
+
+	cfg := NewConfig()
+	expected := MailConfig{
+		Driver:   "file",
+		From:     "no-reply@localhost",
+		SMTPHost: "localhost",
+		SMTPPort: 587,
+	}
+	if cfg.Mail != expected {
+		t.Errorf("expected default mail config %+v, got %+v", expected, cfg.Mail)
+	}
+	if cfg.Server.PublicURL != "http://localhost:8080" {
+		t.Errorf("expected default public URL http://localhost:8080, got %s", cfg.Server.PublicURL)
+	}
+	if cfg.Account.PasswordResetExpiry != time.Hour || cfg.Account.EmailVerificationExpiry != 24*time.Hour {
+		t.Errorf("expected default token expiries 1h and 24h, got %+v", cfg.Account)
+	}
+
+	os.Setenv("APP_URL", "https://app.example.com")
+	os.Setenv("MAIL_DRIVER", "smtp")
+	os.Setenv("SMTP_HOST", "smtp.example.com")
+	os.Setenv("SMTP_PORT", "2525")
+	os.Setenv("PASSWORD_RESET_EXPIRY", "30m")
+	cfg = NewConfig()
+	if cfg.Server.PublicURL != "https://app.example.com" || cfg.Mail.Driver != "smtp" || cfg.Mail.SMTPHost != "smtp.example.com" ||
+		cfg.Mail.SMTPPort != 2525 || cfg.Account.PasswordResetExpiry != 30*time.Minute {
+		t.Errorf("expected mail overrides, got %+v %+v %+v", cfg.Server, cfg.Mail, cfg.Account)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		errorMsg string
+	}{
+		{
+			name: "should accept default secrets outside production",
+		},
+		{
+			name:     "should reject default secrets in production",
+			env:      map[string]string{"APP_ENV": "production"},
+			errorMsg: "JWT_ACCESS_SECRET, JWT_REFRESH_SECRET, CSRF_SECRET must be set in production",
+		},
+		{
+			name: "should reject a default secret left in production",
+			env: map[string]string{
+				"APP_ENV":            "production",
+				"JWT_ACCESS_SECRET":  "access",
+				"JWT_REFRESH_SECRET": "refresh",
+			},
+			errorMsg: "CSRF_SECRET must be set in production",
+		},
+		{
+			name: "should accept signing key files instead of the access secret",
+			env: map[string]string{
+				"APP_ENV":               "production",
+				"JWT_SIGNING_KEY_FILES": "/keys/current.pem",
+				"JWT_REFRESH_SECRET":    "refresh",
+				"CSRF_SECRET":           "csrf",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv()
+			defer clearEnv()
+			for key, value := range tt.env {
+				os.Setenv(key, value)
+			}
+
+			err := NewConfig().Validate()
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
 func TestGetEnv_WithValue(t *testing.T) {
 	os.Setenv("TEST_ENV_VAR", "test_value")
 	defer os.Unsetenv("TEST_ENV_VAR")
@@ -281,6 +479,12 @@ func clearEnv() {
 		"SERVER_PORT", "SERVER_HOST", "SERVER_READ_TIMEOUT", "SERVER_WRITE_TIMEOUT", "SERVER_IDLE_TIMEOUT",
 		"DATABASE_DSN", "DATABASE_MAX_OPEN_CONNS", "DATABASE_MAX_IDLE_CONNS", "DATABASE_CONN_MAX_LIFETIME",
 		"REDIS_HOST", "REDIS_PORT", "REDIS_DB", "REDIS_PASSWORD",
+		"INVOICE_NUMBER_FORMAT", "CREDIT_NOTE_NUMBER_FORMAT", "INVOICE_BASE_CURRENCY", "INVOICE_PDF_TEMPLATE", "INVOICE_SCHEDULER_INTERVAL",
+		"PAGINATION_DEFAULT_LIMIT", "PAGINATION_MAX_LIMIT",
+		"APP_ENV", "JWT_ACCESS_SECRET", "JWT_REFRESH_SECRET", "CSRF_SECRET", "JWT_SIGNING_KEY_FILES",
+		"LOGIN_MAX_FAILURES", "LOGIN_IP_MAX_FAILURES", "LOGIN_BACKOFF_BASE", "LOGIN_BACKOFF_MAX", "LOGIN_LOCKOUT_DURATION", "LOGIN_ATTEMPT_STORE",
+		"APP_URL", "PASSWORD_RESET_EXPIRY", "EMAIL_VERIFICATION_EXPIRY",
+		"MAIL_DRIVER", "MAIL_DIR", "MAIL_FROM", "SMTP_HOST", "SMTP_PORT", "SMTP_USERNAME", "SMTP_PASSWORD",
 	}
 	for _, v := range vars {
 		os.Unsetenv(v)
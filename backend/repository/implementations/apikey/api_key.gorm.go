@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMAPIKeyRepository is a GORM implementation of APIKeyRepository
+type GORMAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+// APIKeyModel represents the api_keys table schema
+type APIKeyModel = entity.APIKeyEntity
+
+// NewGORMAPIKeyRepository creates a new GORM API key repository
+func NewGORMAPIKeyRepository(db *gorm.DB) (*GORMAPIKeyRepository, error) {
+	return &GORMAPIKeyRepository{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMAPIKeyRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMAPIKeyRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	owner := uuid.New()
+	other := uuid.New()
+
+	id, err := repo.Create(ctx, entity.APIKeyEntity{ID: uuid.New(), UserID: owner, Name: "CI", Prefix: "gvr_abcdefgh", KeyHash: "hash", Scopes: "invoice:read"})
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+
+	t.Run("should find a key by its hash", func(t *testing.T) {
+		key, err := repo.FindByKeyHash(ctx, "hash")
+		if err != nil || key == nil || key.ID != *id {
+			t.Fatalf("expected the key, got %v (err %v)", key, err)
+		}
+
+		key, err = repo.FindByKeyHash(ctx, "other")
+		if err != nil || key != nil {
+			t.Errorf("expected nil key, got %v (err %v)", key, err)
+		}
+	})
+
+	t.Run("should record the last use", func(t *testing.T) {
+		usedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		if err := repo.Touch(ctx, *id, usedAt); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		key, _ := repo.FindByKeyHash(ctx, "hash")
+		if key.LastUsedAt == nil || !key.LastUsedAt.Equal(usedAt) {
+			t.Errorf("expected last use %v, got %v", usedAt, key.LastUsedAt)
+		}
+	})
+
+	t.Run("should list only the user's keys", func(t *testing.T) {
+		keys, err := repo.FindAll(ctx, owner)
+		if err != nil || len(keys) != 1 {
+			t.Errorf("expected 1 key, got %d (err %v)", len(keys), err)
+		}
+		keys, err = repo.FindAll(ctx, other)
+		if err != nil || len(keys) != 0 {
+			t.Errorf("expected no keys, got %d (err %v)", len(keys), err)
+		}
+	})
+
+	t.Run("should not revoke another user's key", func(t *testing.T) {
+		revoked, err := repo.Revoke(ctx, other, *id)
+		if err != nil || revoked {
+			t.Errorf("expected nothing revoked, got %v (err %v)", revoked, err)
+		}
+	})
+
+	t.Run("should revoke a key once", func(t *testing.T) {
+		revoked, err := repo.Revoke(ctx, owner, *id)
+		if err != nil || !revoked {
+			t.Fatalf("expected the key revoked, got %v (err %v)", revoked, err)
+		}
+		key, _ := repo.FindByKeyHash(ctx, "hash")
+		if key.RevokedAt == nil {
+			t.Errorf("expected revoked_at to be set")
+		}
+
+		revoked, err = repo.Revoke(ctx, owner, *id)
+		if err != nil || revoked {
+			t.Errorf("expected nothing revoked again, got %v (err %v)", revoked, err)
+		}
+	})
+}
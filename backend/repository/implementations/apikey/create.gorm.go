@@ -0,0 +1,25 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Create stores a new API key in GORM
+func (r *GORMAPIKeyRepository) Create(ctx context.Context, key entity.APIKeyEntity) (*uuid.UUID, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Create(&key).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &key.ID, nil
+}
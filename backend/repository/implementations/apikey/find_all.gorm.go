@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindAll finds the user's API keys, revoked ones included, newest first
+func (r *GORMAPIKeyRepository) FindAll(ctx context.Context, userID uuid.UUID) ([]entity.APIKeyEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var keys []entity.APIKeyEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id").
+		Find(&keys).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,30 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// FindByKeyHash finds an API key by its hash, returning nil when absent
+func (r *GORMAPIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*entity.APIKeyEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var key entity.APIKeyEntity
+	if err := unitofwork.DB(ctx, r.db).Where("key_hash = ?", keyHash).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &key, nil
+}
@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Revoke revokes one of the user's API keys by ID. It reports false when
+// the user has no such key or it was already revoked.
+func (r *GORMAPIKeyRepository) Revoke(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	default:
+	}
+
+	result := unitofwork.DB(ctx, r.db).Model(&APIKeyModel{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", time.Now().UTC())
+	if result.Error != nil {
+		return false, dberror.Translate(r.db, result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,27 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Touch records when an API key was last used
+func (r *GORMAPIKeyRepository) Touch(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Model(&APIKeyModel{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt).Error; err != nil {
+		return dberror.Translate(r.db, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package counter
 
-import "context"
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
 
 // GetCounter returns the current counter value from GORM
 func (r *GORMCounterRepository) GetCounter(ctx context.Context) (int, error) {
@@ -11,7 +15,7 @@ func (r *GORMCounterRepository) GetCounter(ctx context.Context) (int, error) {
 	}
 
 	var counter CounterModel
-	if err := r.db.WithContext(ctx).First(&counter).Error; err != nil {
+	if err := unitofwork.DB(ctx, r.db).First(&counter).Error; err != nil {
 		return 0, err
 	}
 
@@ -3,6 +3,7 @@ package counter
 import (
 	"context"
 
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 	"gorm.io/gorm"
 )
 
@@ -17,7 +18,7 @@ func (r *GORMCounterRepository) IncrementCounter(ctx context.Context) (int, erro
 	var counter CounterModel
 
 	// Use a transaction to atomically read and update the counter
-	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	if err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Read current value
 		if err := tx.First(&counter).Error; err != nil {
 			return err
@@ -0,0 +1,25 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Create creates a new customer in GORM
+func (r *GORMCustomerRepository) Create(ctx context.Context, customer entity.CustomerEntity) (*uuid.UUID, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Create(&customer).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &customer.ID, nil
+}
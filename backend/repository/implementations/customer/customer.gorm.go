@@ -0,0 +1,21 @@
+package customer
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMCustomerRepository is a GORM implementation of CustomerRepository
+type GORMCustomerRepository struct {
+	db *gorm.DB
+}
+
+// CustomerModel represents the customers table schema
+type CustomerModel = entity.CustomerEntity
+
+// NewGORMCustomerRepository creates a new GORM customer repository
+func NewGORMCustomerRepository(db *gorm.DB) (*GORMCustomerRepository, error) {
+	return &GORMCustomerRepository{
+		db: db,
+	}, nil
+}
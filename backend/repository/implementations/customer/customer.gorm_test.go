@@ -0,0 +1,128 @@
+package customer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMCustomerRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMCustomerRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	id, err := repo.Create(ctx, entity.CustomerEntity{ID: uuid.New(), OwnerID: ownerA, Name: "Acme Corp", Email: "billing@acme.test"})
+	if err != nil {
+		t.Fatalf("failed to create customer: %v", err)
+	}
+
+	t.Run("should hide the customer from another user", func(t *testing.T) {
+		customer, err := repo.FindByID(ctx, ownerB, *id)
+		if err != nil || customer != nil {
+			t.Errorf("expected nil customer, got %v (err %v)", customer, err)
+		}
+
+		// The search must not widen the owner scope
+		customers, total, err := repo.FindAll(ctx, ownerB, 1, 10, "acme")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 0 || len(customers) != 0 {
+			t.Errorf("expected no customers, got %d (total %d)", len(customers), total)
+		}
+	})
+
+	t.Run("should not update or delete another user's customer", func(t *testing.T) {
+		if err := repo.Update(ctx, ownerB, *id, entity.CustomerEntity{Name: "Hijacked"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.Delete(ctx, ownerB, *id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		customer, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || customer == nil {
+			t.Fatalf("expected customer to survive, got %v (err %v)", customer, err)
+		}
+		if customer.Name != "Acme Corp" {
+			t.Errorf("expected name Acme Corp, got %s", customer.Name)
+		}
+	})
+}
+
+func TestFindAllSearch(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+
+	for _, customer := range []entity.CustomerEntity{
+		{ID: uuid.New(), OwnerID: ownerID, Name: "Globex", Email: "ap@globex.test"},
+		{ID: uuid.New(), OwnerID: ownerID, Name: "Initech", Email: "finance@initech.test"},
+		{ID: uuid.New(), OwnerID: ownerID, Name: "Acme Corp", Email: "billing@acme.test"},
+	} {
+		if _, err := repo.Create(ctx, customer); err != nil {
+			t.Fatalf("failed to create customer: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		search   string
+		expected []string
+	}{
+		{name: "should list every customer by name", search: "", expected: []string{"Acme Corp", "Globex", "Initech"}},
+		{name: "should match the name", search: "glob", expected: []string{"Globex"}},
+		{name: "should match the email", search: "finance@", expected: []string{"Initech"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customers, total, err := repo.FindAll(ctx, ownerID, 1, 10, tt.search)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if int(total) != len(tt.expected) || len(customers) != len(tt.expected) {
+				t.Fatalf("expected %d customers, got %d (total %d)", len(tt.expected), len(customers), total)
+			}
+			for i, name := range tt.expected {
+				if customers[i].Name != name {
+					t.Errorf("expected %s at %d, got %s", name, i, customers[i].Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Delete soft deletes a customer by ID within the owner's scope
+func (r *GORMCustomerRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	return unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Delete(&entity.CustomerEntity{}).Error
+}
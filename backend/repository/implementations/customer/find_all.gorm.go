@@ -0,0 +1,42 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindAll finds the owner's customers with pagination and search
+func (r *GORMCustomerRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) ([]entity.CustomerEntity, int64, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	default:
+	}
+
+	var customers []entity.CustomerEntity
+	var total int64
+
+	query := unitofwork.DB(ctx, r.db).Model(&entity.CustomerEntity{}).
+		Where("owner_id = ?", ownerID)
+
+	// Apply search filter
+	if search != "" {
+		query = query.Where("name LIKE ? OR email LIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := query.Order("name").Offset(offset).Limit(limit).Find(&customers).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return customers, total, nil
+}
@@ -0,0 +1,32 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// FindByID finds a customer by ID within the owner's scope.
+// It returns nil when no matching customer exists.
+func (r *GORMCustomerRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.CustomerEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var customer entity.CustomerEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&customer).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &customer, nil
+}
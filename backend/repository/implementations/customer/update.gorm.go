@@ -0,0 +1,29 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Update updates a customer by ID within the owner's scope
+func (r *GORMCustomerRepository) Update(ctx context.Context, ownerID, id uuid.UUID, customer entity.CustomerEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Model(&entity.CustomerEntity{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Updates(map[string]interface{}{
+			"name":            customer.Name,
+			"email":           customer.Email,
+			"billing_address": customer.BillingAddress,
+			"tax_id":          customer.TaxID,
+		}).Error
+	return dberror.Translate(r.db, err)
+}
@@ -0,0 +1,36 @@
+// Package dberror translates GORM errors to the apperror kinds services
+// and handlers understand, so database details never reach clients
+package dberror
+
+import (
+	"errors"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrNotFound is a lookup that matched no record
+	ErrNotFound = apperror.New(apperror.ErrNotFound, "record_not_found", "record not found")
+	// ErrDuplicate is a write that broke a unique index
+	ErrDuplicate = apperror.New(apperror.ErrConflict, "duplicate_record", "record already exists")
+)
+
+// Translate returns ErrNotFound for gorm.ErrRecordNotFound, ErrDuplicate for
+// a unique violation reported by the dialect of db, and err otherwise
+func Translate(db *gorm.DB, err error) error {
+	if err == nil {
+		return nil
+	}
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNotFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicate
+	}
+	return err
+}
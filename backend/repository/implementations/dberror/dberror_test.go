@@ -0,0 +1,84 @@
+package dberror
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"gorm.io/gorm"
+)
+
+type record struct {
+	ID   uuid.UUID `gorm:"primaryKey"`
+	Code string    `gorm:"uniqueIndex"`
+}
+
+func TestTranslate(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&record{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if err := db.Create(&record{ID: uuid.New(), Code: "A"}).Error; err != nil {
+		t.Fatalf("failed to create record: %v", err)
+	}
+
+	other := errors.New("connection refused")
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+		kind     error
+	}{
+		{
+			name: "should keep nil",
+		},
+		{
+			name:     "should report a missing record as not found",
+			err:      db.First(&record{}, "code = ?", "B").Error,
+			expected: ErrNotFound,
+			kind:     apperror.ErrNotFound,
+		},
+		{
+			name:     "should report a unique violation as a conflict",
+			err:      db.Create(&record{ID: uuid.New(), Code: "A"}).Error,
+			expected: ErrDuplicate,
+			kind:     apperror.ErrConflict,
+		},
+		{
+			name:     "should pass other errors through",
+			err:      fmt.Errorf("query: %w", other),
+			expected: other,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Translate(db, tt.err)
+			if tt.expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if tt.kind != nil && !errors.Is(err, tt.kind) {
+				t.Errorf("expected kind %v, got %v", tt.kind, err)
+			}
+		})
+	}
+}
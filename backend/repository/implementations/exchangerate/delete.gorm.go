@@ -0,0 +1,23 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Delete deletes an exchange rate by ID within the owner's scope. Invoices
+// issued with it keep their snapshot.
+func (r *GORMExchangeRateRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	return unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Delete(&entity.ExchangeRateEntity{}).Error
+}
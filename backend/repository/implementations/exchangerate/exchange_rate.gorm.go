@@ -0,0 +1,21 @@
+package exchangerate
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMExchangeRateRepository is a GORM implementation of ExchangeRateRepository
+type GORMExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// ExchangeRateModel represents the exchange_rates table schema
+type ExchangeRateModel = entity.ExchangeRateEntity
+
+// NewGORMExchangeRateRepository creates a new GORM exchange rate repository
+func NewGORMExchangeRateRepository(db *gorm.DB) (*GORMExchangeRateRepository, error) {
+	return &GORMExchangeRateRepository{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,113 @@
+package exchangerate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMExchangeRateRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMExchangeRateRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func date(value string) time.Time {
+	parsed, _ := time.Parse("2006-01-02", value)
+	return parsed
+}
+
+func rate(ownerID uuid.UUID, value, effective string) entity.ExchangeRateEntity {
+	return entity.ExchangeRateEntity{
+		ID:            uuid.New(),
+		OwnerID:       ownerID,
+		BaseCurrency:  "EUR",
+		QuoteCurrency: "USD",
+		Rate:          value,
+		EffectiveDate: date(effective),
+	}
+}
+
+func TestUpsertAndFindLatest(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	if err := repo.Upsert(ctx, []entity.ExchangeRateEntity{
+		rate(ownerA, "1.08", "2024-01-01"),
+		rate(ownerA, "1.09", "2024-02-01"),
+		rate(ownerB, "2", "2024-01-01"),
+	}); err != nil {
+		t.Fatalf("failed to store rates: %v", err)
+	}
+
+	t.Run("should replace the rate of an existing pair and date", func(t *testing.T) {
+		if err := repo.Upsert(ctx, []entity.ExchangeRateEntity{rate(ownerA, "1.1", "2024-02-01")}); err != nil {
+			t.Fatalf("failed to replace rate: %v", err)
+		}
+		_, total, err := repo.FindAll(ctx, ownerA, 1, 10, interfaces.ExchangeRateFilter{BaseCurrency: "EUR"})
+		if err != nil || total != 2 {
+			t.Fatalf("expected 2 rates, got %d (err %v)", total, err)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		ownerID  uuid.UUID
+		at       string
+		expected string
+	}{
+		{name: "should find the rate effective at a date", ownerID: ownerA, at: "2024-01-15", expected: "1.08"},
+		{name: "should find the newest rate", ownerID: ownerA, at: "2024-03-01", expected: "1.1"},
+		{name: "should find nothing before the first rate", ownerID: ownerA, at: "2023-12-31"},
+		{name: "should only find the owner's rates", ownerID: ownerB, at: "2024-03-01", expected: "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := repo.FindLatest(ctx, tt.ownerID, "EUR", "USD", date(tt.at))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.expected == "" {
+				if found != nil {
+					t.Errorf("expected no rate, got %s", found.Rate)
+				}
+				return
+			}
+			if found == nil || found.Rate != tt.expected {
+				t.Errorf("expected rate %s, got %v", tt.expected, found)
+			}
+		})
+	}
+}
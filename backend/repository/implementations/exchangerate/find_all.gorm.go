@@ -0,0 +1,50 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+)
+
+// FindAll finds the owner's exchange rates with pagination and currency
+// filtering, newest first
+func (r *GORMExchangeRateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, filter interfaces.ExchangeRateFilter) ([]entity.ExchangeRateEntity, int64, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	default:
+	}
+
+	var rates []entity.ExchangeRateEntity
+	var total int64
+
+	query := unitofwork.DB(ctx, r.db).Model(&entity.ExchangeRateEntity{}).
+		Where("owner_id = ?", ownerID)
+
+	// Apply currency filters
+	if filter.BaseCurrency != "" {
+		query = query.Where("base_currency = ?", filter.BaseCurrency)
+	}
+	if filter.QuoteCurrency != "" {
+		query = query.Where("quote_currency = ?", filter.QuoteCurrency)
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := query.
+		Order("effective_date DESC, base_currency, quote_currency").
+		Offset(offset).Limit(limit).
+		Find(&rates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return rates, total, nil
+}
@@ -0,0 +1,32 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// FindByID finds an exchange rate by ID within the owner's scope.
+// It returns nil when no matching exchange rate exists.
+func (r *GORMExchangeRateRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ExchangeRateEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var rate entity.ExchangeRateEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&rate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rate, nil
+}
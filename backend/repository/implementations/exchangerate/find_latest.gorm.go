@@ -0,0 +1,34 @@
+package exchangerate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// FindLatest finds the owner's most recent rate for a currency pair that was
+// effective at the given time. It returns nil when there is none.
+func (r *GORMExchangeRateRepository) FindLatest(ctx context.Context, ownerID uuid.UUID, base, quote string, at time.Time) (*entity.ExchangeRateEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var rate entity.ExchangeRateEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("owner_id = ? AND base_currency = ? AND quote_currency = ? AND effective_date <= ?", ownerID, base, quote, at).
+		Order("effective_date DESC").
+		First(&rate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rate, nil
+}
@@ -0,0 +1,40 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm/clause"
+)
+
+// upsertBatchSize keeps each insert well below the database's bind parameter limit
+const upsertBatchSize = 500
+
+// Upsert inserts exchange rates, replacing the rate of any that already exist
+// for the same owner, currency pair and effective date
+func (r *GORMExchangeRateRepository) Upsert(ctx context.Context, rates []entity.ExchangeRateEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(rates) == 0 {
+		return nil
+	}
+
+	err := unitofwork.DB(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{
+				{Name: "owner_id"},
+				{Name: "base_currency"},
+				{Name: "quote_currency"},
+				{Name: "effective_date"},
+			},
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).
+		CreateInBatches(&rates, upsertBatchSize).Error
+	return dberror.Translate(r.db, err)
+}
@@ -0,0 +1,28 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// AddInvoiceTags associates existing tags with an invoice
+func (r *GORMInvoiceRepository) AddInvoiceTags(ctx context.Context, invoiceID uuid.UUID, tags []entity.TagEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return unitofwork.DB(ctx, r.db).
+		Model(&entity.InvoiceEntity{ID: invoiceID}).
+		Omit("Tags.*").
+		Association("Tags").
+		Append(&tags)
+}
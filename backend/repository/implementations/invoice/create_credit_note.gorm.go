@@ -0,0 +1,39 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// CreateCreditNote stores a credit note and its lines, numbers it from the
+// owner's credit note sequence and adds its total to the invoice's credited
+// total. All happen in one transaction, so a number is only consumed by a
+// credit note that was actually stored.
+func (r *GORMInvoiceRepository) CreateCreditNote(ctx context.Context, note entity.CreditNoteEntity, formatNumber func(seq int64) string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		seq, err := nextSequenceValue(tx, note.OwnerID, creditNoteSequence)
+		if err != nil {
+			return err
+		}
+		note.Number = formatNumber(seq)
+
+		if err := tx.Create(&note).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.InvoiceEntity{}).
+			Where("id = ? AND owner_id = ?", note.InvoiceID, note.OwnerID).
+			Update("credited_total", gorm.Expr("credited_total + ?", note.Total)).Error
+	})
+	return dberror.Translate(r.db, err)
+}
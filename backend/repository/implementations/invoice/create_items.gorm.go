@@ -0,0 +1,25 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// CreateInvoiceItems inserts line items for an existing invoice
+func (r *GORMInvoiceRepository) CreateInvoiceItems(ctx context.Context, items []entity.InvoiceItemEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := unitofwork.DB(ctx, r.db).Omit("Item").Create(&items).Error
+	return dberror.Translate(r.db, err)
+}
@@ -0,0 +1,21 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// CreatePayment appends an entry to an invoice's payments ledger
+func (r *GORMInvoiceRepository) CreatePayment(ctx context.Context, payment entity.PaymentEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Create(&payment).Error
+	return dberror.Translate(r.db, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // DeleteInvoiceItems deletes all items for an invoice
@@ -15,7 +16,7 @@ func (r *GORMInvoiceRepository) DeleteInvoiceItems(ctx context.Context, invoiceI
 	default:
 	}
 
-	return r.db.WithContext(ctx).
+	return unitofwork.DB(ctx, r.db).
 		Where("invoice_id = ?", invoiceID).
 		Delete(&entity.InvoiceItemEntity{}).Error
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // DeleteInvoiceTags removes all tag associations for an invoice
@@ -14,5 +16,8 @@ func (r *GORMInvoiceRepository) DeleteInvoiceTags(ctx context.Context, invoiceID
 	default:
 	}
 
-	return r.db.WithContext(ctx).Exec("DELETE FROM invoice_to_tags WHERE invoice_id = ?", invoiceID).Error
+	return unitofwork.DB(ctx, r.db).
+		Model(&entity.InvoiceEntity{ID: invoiceID}).
+		Association("Tags").
+		Clear()
 }
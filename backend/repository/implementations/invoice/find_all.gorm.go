@@ -2,13 +2,33 @@ package invoice
 
 import (
 	"context"
-	"strconv"
 
+	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/queryspec"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindAll finds all invoices with pagination and search
-func (r *GORMInvoiceRepository) FindAll(ctx context.Context, page, limit int, search string) ([]entity.InvoiceEntity, int64, error) {
+// queryColumns maps the fields invoices are filtered and sorted by to their
+// columns. Tags are kept in a join table and filtered separately.
+var queryColumns = queryspec.Columns{
+	"number":      "number",
+	"status":      "status",
+	"customer_id": "customer_id",
+	"currency":    "currency",
+	"grand_price": "grand_price",
+	"issued_at":   "issued_at",
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+}
+
+// defaultSort lists invoices in the order they were created
+var defaultSort = []query.Sort{{Field: "created_at"}}
+
+// FindAll finds the owner's invoices matching spec with pagination
+func (r *GORMInvoiceRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.InvoiceEntity, int64, error) {
 	select {
 	case <-ctx.Done():
 		return nil, 0, ctx.Err()
@@ -18,27 +38,58 @@ func (r *GORMInvoiceRepository) FindAll(ctx context.Context, page, limit int, se
 	var invoices []entity.InvoiceEntity
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.InvoiceEntity{}).
-		Preload("Tags").
-		Preload("Items")
-
-	// Apply search filter (search by ID)
-	if search != "" {
-		if id, err := strconv.ParseUint(search, 10, 32); err == nil {
-			query = query.Where("id = ?", id)
-		}
+	db, err := r.matching(ctx, ownerID, spec)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	db, err = queryspec.Order(db, queryspec.SortOf(spec, defaultSort), queryColumns)
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// Apply pagination
 	offset := (page - 1) * limit
-	if err := query.Offset(offset).Limit(limit).Find(&invoices).Error; err != nil {
+	if err := db.Offset(offset).Limit(limit).Find(&invoices).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return invoices, total, nil
 }
+
+// matching scopes a query to the owner's invoices matching the search and
+// filters of spec, with the relations listed invoices show
+func (r *GORMInvoiceRepository) matching(ctx context.Context, ownerID uuid.UUID, spec query.Spec) (*gorm.DB, error) {
+	db := unitofwork.DB(ctx, r.db).Model(&entity.InvoiceEntity{}).
+		Where("owner_id = ?", ownerID).
+		Preload("Customer").
+		Preload("Tags").
+		Preload("Items")
+
+	// Apply search filter (search by invoice number)
+	if spec.Search != "" {
+		db = db.Where("number LIKE ?", "%"+spec.Search+"%")
+	}
+
+	// Apply tag filters: invoices with any of the tags
+	filters := make([]query.Filter, 0, len(spec.Filters))
+	for _, filter := range spec.Filters {
+		if filter.Field != "tag_id" {
+			filters = append(filters, filter)
+			continue
+		}
+		tagged := unitofwork.DB(ctx, r.db).Table("invoice_to_tags").Select("invoice_entity_id")
+		tagged, err := queryspec.Where(tagged, []query.Filter{filter}, queryspec.Columns{"tag_id": "tag_entity_id"})
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("id IN (?)", tagged)
+	}
+
+	return queryspec.Where(db, filters, queryColumns)
+}
@@ -0,0 +1,47 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/queryspec"
+)
+
+// FindByCursor finds up to limit of the owner's invoices matching spec from
+// the page spec.Cursor points at
+func (r *GORMInvoiceRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.InvoiceEntity, query.Cursors, error) {
+	select {
+	case <-ctx.Done():
+		return nil, query.Cursors{}, ctx.Err()
+	default:
+	}
+
+	db, err := r.matching(ctx, ownerID, spec)
+	if err != nil {
+		return nil, query.Cursors{}, err
+	}
+
+	return queryspec.Page(db, limit, queryspec.SortOf(spec, defaultSort), queryColumns, spec.Cursor, invoiceKey)
+}
+
+// invoiceKey returns the value of a field invoices are sorted by. Number
+// and IssuedAt can be null, so cursors never page through lists sorted by
+// them.
+func invoiceKey(invoice entity.InvoiceEntity, field string) any {
+	switch field {
+	case "status":
+		return invoice.Status
+	case "currency":
+		return invoice.Currency
+	case "grand_price":
+		return invoice.GrandPrice
+	case "created_at":
+		return invoice.CreatedAt
+	case "updated_at":
+		return invoice.UpdatedAt
+	default:
+		return invoice.ID
+	}
+}
@@ -5,10 +5,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindByID finds an invoice by ID with all related data
-func (r *GORMInvoiceRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InvoiceEntity, error) {
+// FindByID finds an invoice by ID with all related data within the owner's scope.
+// It returns nil when no matching invoice exists.
+func (r *GORMInvoiceRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -16,10 +19,24 @@ func (r *GORMInvoiceRepository) FindByID(ctx context.Context, id uuid.UUID) (*en
 	}
 
 	var invoice entity.InvoiceEntity
-	if err := r.db.WithContext(ctx).
+	if err := unitofwork.DB(ctx, r.db).
+		Preload("Customer").
 		Preload("Items.Item").
 		Preload("Tags").
-		First(&invoice, id).Error; err != nil {
+		Preload("Payments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("paid_at, created_at")
+		}).
+		Preload("CreditNotes", func(db *gorm.DB) *gorm.DB {
+			return db.Order("issued_at, created_at")
+		}).
+		Preload("CreditNotes.Lines", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&invoice).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 
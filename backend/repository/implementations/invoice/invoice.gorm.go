@@ -15,11 +15,6 @@ type InvoiceModel = entity.InvoiceEntity
 
 // NewGORMInvoiceRepository creates a new GORM invoice repository
 func NewGORMInvoiceRepository(db *gorm.DB) (*GORMInvoiceRepository, error) {
-	// Auto-migrate the schema
-	if err := db.AutoMigrate(&InvoiceModel{}, &entity.InvoiceItemEntity{}); err != nil {
-		return nil, err
-	}
-
 	return &GORMInvoiceRepository{
 		db: db,
 	}, nil
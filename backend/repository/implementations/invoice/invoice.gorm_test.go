@@ -0,0 +1,602 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMInvoiceRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMInvoiceRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	invoiceID := uuid.New()
+	id, err := repo.Create(ctx, entity.InvoiceEntity{
+		ID:         invoiceID,
+		OwnerID:    ownerA,
+		GrandPrice: 100,
+		Items: []entity.InvoiceItemEntity{
+			{ID: uuid.New(), InvoiceID: invoiceID, ItemID: uuid.New(), Quantity: 1, UnitPrice: 100, TotalPrice: 100},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	t.Run("should find the invoice for its owner", func(t *testing.T) {
+		invoice, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+		}
+	})
+
+	t.Run("should hide the invoice from another user", func(t *testing.T) {
+		invoice, err := repo.FindByID(ctx, ownerB, *id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if invoice != nil {
+			t.Errorf("expected nil invoice, got %+v", invoice)
+		}
+
+		invoices, total, err := repo.FindAll(ctx, ownerB, 1, 10, query.Spec{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 0 || len(invoices) != 0 {
+			t.Errorf("expected no invoices, got %d (total %d)", len(invoices), total)
+		}
+	})
+
+	t.Run("should not update or delete another user's invoice", func(t *testing.T) {
+		if err := repo.Update(ctx, ownerB, *id, entity.InvoiceEntity{GrandPrice: 0}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.Delete(ctx, ownerB, *id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		invoice, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice to survive, got %v (err %v)", invoice, err)
+		}
+		if invoice.GrandPrice != 100 {
+			t.Errorf("expected grand price 100, got %v", invoice.GrandPrice)
+		}
+	})
+}
+
+func TestUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+
+	id, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID})
+	if err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	stored, err := repo.FindByID(ctx, ownerID, *id)
+	if err != nil || stored == nil {
+		t.Fatalf("expected invoice, got %v (err %v)", stored, err)
+	}
+	if stored.Status != entity.InvoiceStatusDraft {
+		t.Errorf("expected new invoice to default to draft, got %q", stored.Status)
+	}
+
+	issued := entity.InvoiceEntity{Status: entity.InvoiceStatusIssued}
+	ok, err := repo.UpdateStatus(ctx, ownerID, *id, entity.InvoiceStatusDraft, issued)
+	if err != nil || !ok {
+		t.Fatalf("expected first transition to succeed, got %v (err %v)", ok, err)
+	}
+
+	ok, err = repo.UpdateStatus(ctx, ownerID, *id, entity.InvoiceStatusDraft, issued)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Errorf("expected stale transition to be rejected")
+	}
+
+	invoices, total, err := repo.FindAll(ctx, ownerID, 1, 10, query.Spec{Filters: []query.Filter{{Field: "status", Op: query.OpEq, Value: entity.InvoiceStatusIssued}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || len(invoices) != 1 {
+		t.Errorf("expected 1 issued invoice, got %d (total %d)", len(invoices), total)
+	}
+}
+
+func TestIssueAllocatesSequentialNumbers(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+	format := func(seq int64) string { return fmt.Sprintf("INV-%d", seq) }
+	issuedAt := time.Now()
+
+	issue := func(ownerID uuid.UUID) (uuid.UUID, bool) {
+		t.Helper()
+		id, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID, Status: entity.InvoiceStatusDraft})
+		if err != nil {
+			t.Fatalf("failed to create invoice: %v", err)
+		}
+		issued, err := repo.Issue(ctx, ownerID, *id, entity.InvoiceEntity{IssuedAt: &issuedAt}, format)
+		if err != nil {
+			t.Fatalf("failed to issue invoice: %v", err)
+		}
+		return *id, issued
+	}
+
+	expectNumber := func(ownerID, id uuid.UUID, expected string) {
+		t.Helper()
+		invoice, err := repo.FindByID(ctx, ownerID, id)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+		}
+		if invoice.Number == nil || *invoice.Number != expected {
+			t.Errorf("expected number %q, got %v", expected, invoice.Number)
+		}
+	}
+
+	first, _ := issue(ownerA)
+	second, _ := issue(ownerA)
+	other, _ := issue(ownerB)
+
+	expectNumber(ownerA, first, "INV-1")
+	expectNumber(ownerA, second, "INV-2")
+	expectNumber(ownerB, other, "INV-1")
+
+	// Re-issuing must fail without consuming a number
+	if issued, err := repo.Issue(ctx, ownerA, first, entity.InvoiceEntity{IssuedAt: &issuedAt}, format); err != nil || issued {
+		t.Fatalf("expected re-issue to be rejected, got %v (err %v)", issued, err)
+	}
+	third, _ := issue(ownerA)
+	expectNumber(ownerA, third, "INV-3")
+
+	invoices, total, err := repo.FindAll(ctx, ownerA, 1, 10, query.Spec{Search: "INV-2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || len(invoices) != 1 || invoices[0].ID != second {
+		t.Errorf("expected search to find the second invoice, got %d (total %d)", len(invoices), total)
+	}
+}
+
+func TestIssueSnapshotsCustomer(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+	issuedAt := time.Now()
+
+	customers := []entity.CustomerEntity{
+		{ID: uuid.New(), OwnerID: ownerID, Name: "Acme Corp", BillingAddress: "1 Main St"},
+		{ID: uuid.New(), OwnerID: ownerID, Name: "Globex"},
+	}
+	if err := repo.db.Create(&customers).Error; err != nil {
+		t.Fatalf("failed to create customers: %v", err)
+	}
+
+	id, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID, Status: entity.InvoiceStatusDraft, CustomerID: &customers[0].ID})
+	if err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+	if _, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID, Status: entity.InvoiceStatusDraft, CustomerID: &customers[1].ID}); err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	snapshot := entity.CustomerSnapshot{Name: "Acme Corp", BillingAddress: "1 Main St"}
+	issued, err := repo.Issue(ctx, ownerID, *id, entity.InvoiceEntity{IssuedAt: &issuedAt, BillTo: snapshot}, func(seq int64) string { return fmt.Sprint(seq) })
+	if err != nil || !issued {
+		t.Fatalf("expected invoice to be issued, got %v (err %v)", issued, err)
+	}
+
+	t.Run("should keep the snapshot when the customer changes", func(t *testing.T) {
+		if err := repo.db.Model(&customers[0]).Update("billing_address", "2 New Rd").Error; err != nil {
+			t.Fatalf("failed to update customer: %v", err)
+		}
+
+		invoice, err := repo.FindByID(ctx, ownerID, *id)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+		}
+		if invoice.BillTo != snapshot {
+			t.Errorf("expected snapshot %+v, got %+v", snapshot, invoice.BillTo)
+		}
+		if invoice.Customer == nil || invoice.Customer.BillingAddress != "2 New Rd" {
+			t.Errorf("expected the current customer to be loaded, got %+v", invoice.Customer)
+		}
+	})
+
+	t.Run("should filter by customer", func(t *testing.T) {
+		invoices, total, err := repo.FindAll(ctx, ownerID, 1, 10, query.Spec{Filters: []query.Filter{{Field: "customer_id", Op: query.OpEq, Value: customers[0].ID}}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 1 || len(invoices) != 1 || invoices[0].ID != *id {
+			t.Errorf("expected only the first customer's invoice, got %d (total %d)", len(invoices), total)
+		}
+	})
+}
+
+func TestFindAllQuerySpec(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+
+	tags := []entity.TagEntity{{ID: uuid.New(), OwnerID: ownerID, Name: "urgent"}, {ID: uuid.New(), OwnerID: ownerID, Name: "retainer"}}
+	if err := repo.db.Create(&tags).Error; err != nil {
+		t.Fatalf("failed to create tags: %v", err)
+	}
+
+	create := func(grandPrice int64, createdAt time.Time, tags ...entity.TagEntity) uuid.UUID {
+		t.Helper()
+		id, err := repo.Create(ctx, entity.InvoiceEntity{
+			ID: uuid.New(), OwnerID: ownerID, Status: entity.InvoiceStatusDraft,
+			GrandPrice: grandPrice, CreatedAt: createdAt, Tags: tags,
+		})
+		if err != nil {
+			t.Fatalf("failed to create invoice: %v", err)
+		}
+		return *id
+	}
+
+	january := create(500, time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), tags[0])
+	february := create(1500, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), tags[1])
+	march := create(1500, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), tags...)
+
+	tests := []struct {
+		name     string
+		spec     query.Spec
+		expected []uuid.UUID
+	}{
+		{
+			name:     "should list in the order invoices were created by default",
+			expected: []uuid.UUID{january, february, march},
+		},
+		{
+			name: "should sort by several fields",
+			spec: query.Spec{Sort: []query.Sort{
+				{Field: "grand_price", Desc: true},
+				{Field: "created_at", Desc: true},
+			}},
+			expected: []uuid.UUID{march, february, january},
+		},
+		{
+			name: "should filter by a range",
+			spec: query.Spec{Filters: []query.Filter{
+				{Field: "created_at", Op: query.OpGte, Value: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+				{Field: "grand_price", Op: query.OpLt, Value: int64(2000)},
+			}},
+			expected: []uuid.UUID{february, march},
+		},
+		{
+			name: "should filter by any of the tags",
+			spec: query.Spec{Filters: []query.Filter{
+				{Field: "tag_id", Op: query.OpIn, Value: []any{tags[0].ID}},
+			}},
+			expected: []uuid.UUID{january, march},
+		},
+		{
+			name: "should combine tag filters with other filters",
+			spec: query.Spec{Filters: []query.Filter{
+				{Field: "tag_id", Op: query.OpEq, Value: tags[1].ID},
+				{Field: "grand_price", Op: query.OpGte, Value: int64(1000)},
+			}},
+			expected: []uuid.UUID{february, march},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoices, total, err := repo.FindAll(ctx, ownerID, 1, 10, tt.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if total != int64(len(tt.expected)) || len(invoices) != len(tt.expected) {
+				t.Fatalf("expected %d invoices, got %d (total %d)", len(tt.expected), len(invoices), total)
+			}
+			for i, id := range tt.expected {
+				if invoices[i].ID != id {
+					t.Errorf("expected invoice %d to be %v, got %v", i, id, invoices[i].ID)
+				}
+			}
+		})
+	}
+
+	t.Run("should reject a field it does not store", func(t *testing.T) {
+		spec := query.Spec{Sort: []query.Sort{{Field: "password"}}}
+		if _, _, err := repo.FindAll(ctx, ownerID, 1, 10, spec); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestPaymentsLedger(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+
+	id, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID, Status: entity.InvoiceStatusIssued, GrandPrice: 1000})
+	if err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	net, err := repo.SumPayments(ctx, *id)
+	if err != nil || net != 0 {
+		t.Fatalf("expected empty ledger to sum to 0, got %d (err %v)", net, err)
+	}
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	entries := []entity.PaymentEntity{
+		{Kind: entity.PaymentKindPayment, Amount: 700, PaidAt: day.AddDate(0, 0, 2)},
+		{Kind: entity.PaymentKindPayment, Amount: 500, PaidAt: day},
+		{Kind: entity.PaymentKindRefund, Amount: 200, PaidAt: day.AddDate(0, 0, 5)},
+	}
+	for _, entry := range entries {
+		entry.ID = uuid.New()
+		entry.InvoiceID = *id
+		entry.OwnerID = ownerID
+		entry.Method = entity.PaymentMethodCash
+		if err := repo.CreatePayment(ctx, entry); err != nil {
+			t.Fatalf("failed to create payment: %v", err)
+		}
+	}
+
+	net, err = repo.SumPayments(ctx, *id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if net != 1000 {
+		t.Errorf("expected net paid 1000, got %d", net)
+	}
+
+	invoice, err := repo.FindByID(ctx, ownerID, *id)
+	if err != nil || invoice == nil {
+		t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+	}
+	if len(invoice.Payments) != 3 {
+		t.Fatalf("expected 3 ledger entries, got %d", len(invoice.Payments))
+	}
+	if !invoice.Payments[0].PaidAt.Equal(day) || invoice.Payments[2].Kind != entity.PaymentKindRefund {
+		t.Errorf("expected ledger ordered by paid date, got %+v", invoice.Payments)
+	}
+
+	locked, err := repo.LockByID(ctx, uuid.New(), *id)
+	if err != nil || locked != nil {
+		t.Errorf("expected another user's lock to find nothing, got %v (err %v)", locked, err)
+	}
+}
+
+func TestCreateCreditNote(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+	issuedAt := time.Now()
+	invoiceFormat := func(seq int64) string { return fmt.Sprintf("INV-%d", seq) }
+	creditNoteFormat := func(seq int64) string { return fmt.Sprintf("CN-%d", seq) }
+
+	invoiceID := uuid.New()
+	lineID := uuid.New()
+	if _, err := repo.Create(ctx, entity.InvoiceEntity{
+		ID: invoiceID, OwnerID: ownerID, Status: entity.InvoiceStatusDraft, GrandPrice: 1000,
+		Items: []entity.InvoiceItemEntity{{ID: lineID, InvoiceID: invoiceID, ItemID: uuid.New(), Quantity: 4, UnitPrice: 250, Subtotal: 1000, TotalPrice: 1000}},
+	}); err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+	if _, err := repo.Issue(ctx, ownerID, invoiceID, entity.InvoiceEntity{IssuedAt: &issuedAt}, invoiceFormat); err != nil {
+		t.Fatalf("failed to issue invoice: %v", err)
+	}
+
+	for i, quantity := range []int{1, 2} {
+		amount := int64(quantity) * 250
+		if err := repo.CreateCreditNote(ctx, entity.CreditNoteEntity{
+			ID: uuid.New(), OwnerID: ownerID, InvoiceID: invoiceID, Reason: "returned", Subtotal: amount, Total: amount,
+			IssuedAt: issuedAt.Add(time.Duration(i) * time.Second),
+			Lines:    []entity.CreditNoteLineEntity{{ID: uuid.New(), InvoiceItemID: lineID, Quantity: quantity, Amount: amount, Total: amount}},
+		}, creditNoteFormat); err != nil {
+			t.Fatalf("failed to create credit note: %v", err)
+		}
+	}
+
+	t.Run("should number credit notes from their own sequence", func(t *testing.T) {
+		invoice, err := repo.FindByID(ctx, ownerID, invoiceID)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+		}
+		if invoice.Number == nil || *invoice.Number != "INV-1" {
+			t.Errorf("expected invoice number INV-1, got %v", invoice.Number)
+		}
+		if len(invoice.CreditNotes) != 2 || invoice.CreditNotes[0].Number != "CN-1" || invoice.CreditNotes[1].Number != "CN-2" {
+			t.Fatalf("expected credit notes CN-1 and CN-2 in order, got %+v", invoice.CreditNotes)
+		}
+		if len(invoice.CreditNotes[1].Lines) != 1 || invoice.CreditNotes[1].Lines[0].Quantity != 2 {
+			t.Errorf("expected the credit note's lines to be loaded, got %+v", invoice.CreditNotes[1].Lines)
+		}
+	})
+
+	t.Run("should add credit notes to the credited total", func(t *testing.T) {
+		invoice, err := repo.LockByID(ctx, ownerID, invoiceID)
+		if err != nil || invoice == nil {
+			t.Fatalf("expected invoice, got %v (err %v)", invoice, err)
+		}
+		if invoice.CreditedTotal != 750 {
+			t.Errorf("expected credited total 750, got %d", invoice.CreditedTotal)
+		}
+
+		totals, err := repo.Summarize(ctx, ownerID, interfaces.InvoiceFilter{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(totals) != 1 || totals[0].GrandPrice != 1000 || totals[0].OutstandingBalance != 250 {
+			t.Errorf("expected the credit notes to reduce the outstanding balance, got %+v", totals)
+		}
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+	base := "USD"
+	rate := "1.1"
+
+	invoices := []entity.InvoiceEntity{
+		{Status: entity.InvoiceStatusIssued, Currency: "USD", GrandPrice: 1000, AmountPaid: 0},
+		{Status: entity.InvoiceStatusPaid, Currency: "USD", GrandPrice: 500, AmountPaid: 700},
+		{Status: entity.InvoiceStatusIssued, Currency: "EUR", GrandPrice: 2000, AmountPaid: 500, BaseCurrency: &base, ExchangeRate: &rate},
+		{Status: entity.InvoiceStatusDraft, Currency: "EUR", GrandPrice: 9999},
+		{Status: entity.InvoiceStatusIssued, Currency: "USD", GrandPrice: 9999, OwnerID: uuid.New()},
+	}
+	for _, invoice := range invoices {
+		invoice.ID = uuid.New()
+		if invoice.OwnerID == uuid.Nil {
+			invoice.OwnerID = ownerID
+		}
+		if _, err := repo.Create(ctx, invoice); err != nil {
+			t.Fatalf("failed to create invoice: %v", err)
+		}
+	}
+
+	totals, err := repo.Summarize(ctx, ownerID, interfaces.InvoiceFilter{
+		Statuses: []entity.InvoiceStatus{entity.InvoiceStatusIssued, entity.InvoiceStatusPaid},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(totals) != 2 {
+		t.Fatalf("expected 2 currency groups, got %+v", totals)
+	}
+
+	eur, usd := totals[0], totals[1]
+	if eur.Currency != "EUR" || eur.Count != 1 || eur.OutstandingBalance != 1500 || eur.ExchangeRate == nil || *eur.ExchangeRate != rate {
+		t.Errorf("unexpected EUR totals %+v", eur)
+	}
+	// The overpaid invoice must not reduce the other's outstanding balance
+	if usd.Currency != "USD" || usd.Count != 2 || usd.GrandPrice != 1500 || usd.AmountPaid != 700 || usd.OutstandingBalance != 1000 {
+		t.Errorf("unexpected USD totals %+v", usd)
+	}
+}
+
+func TestFindByCursor(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Two invoices share a creation time, so pages must fall back to the id
+	// to split them
+	var expected []uuid.UUID
+	created := map[uuid.UUID]int{}
+	for _, offset := range []int{3, 0, 1, 1, 2} {
+		id := uuid.New()
+		if _, err := repo.Create(ctx, entity.InvoiceEntity{ID: id, OwnerID: ownerID, Status: entity.InvoiceStatusDraft, CreatedAt: start.Add(time.Duration(offset) * time.Minute)}); err != nil {
+			t.Fatalf("failed to create invoice: %v", err)
+		}
+		expected = append(expected, id)
+		created[id] = offset
+	}
+	slices.SortFunc(expected, func(a, b uuid.UUID) int {
+		if created[a] != created[b] {
+			return created[a] - created[b]
+		}
+		return strings.Compare(a.String(), b.String())
+	})
+	// Another owner's invoice never shows up
+	if _, err := repo.Create(ctx, entity.InvoiceEntity{ID: uuid.New(), OwnerID: uuid.New(), Status: entity.InvoiceStatusDraft, CreatedAt: start}); err != nil {
+		t.Fatalf("failed to create invoice: %v", err)
+	}
+
+	ids := func(rows []entity.InvoiceEntity) []uuid.UUID {
+		var ids []uuid.UUID
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		return ids
+	}
+
+	// Without a sort, pages follow the default sort by creation time
+	var pages [][]uuid.UUID
+	var last query.Cursors
+	spec := query.Spec{Cursor: &query.Cursor{}}
+	for {
+		rows, cursors, err := repo.FindByCursor(ctx, ownerID, 2, spec)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pages = append(pages, ids(rows))
+		last = cursors
+		if cursors.Next == nil {
+			break
+		}
+		spec.Cursor = cursors.Next
+	}
+
+	t.Run("should page forwards through every invoice once", func(t *testing.T) {
+		want := [][]uuid.UUID{expected[0:2], expected[2:4], expected[4:5]}
+		if !reflect.DeepEqual(pages, want) {
+			t.Errorf("expected pages %v, got %v", want, pages)
+		}
+	})
+
+	t.Run("should page backwards to the first page", func(t *testing.T) {
+		var backwards [][]uuid.UUID
+		cursor := last.Prev
+		for cursor != nil {
+			rows, cursors, err := repo.FindByCursor(ctx, ownerID, 2, query.Spec{Cursor: cursor})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			backwards = append([][]uuid.UUID{ids(rows)}, backwards...)
+			if cursors.Next == nil {
+				t.Errorf("expected a cursor to the next page")
+			}
+			cursor = cursors.Prev
+		}
+		if !reflect.DeepEqual(backwards, pages[:len(pages)-1]) {
+			t.Errorf("expected pages %v, got %v", pages[:len(pages)-1], backwards)
+		}
+	})
+}
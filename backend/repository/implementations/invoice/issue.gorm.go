@@ -0,0 +1,63 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// Issue moves a draft invoice to issued, records the issue date, exchange
+// rate and customer snapshots from issued and assigns it the next number of
+// the owner's invoice sequence. All happen in one transaction, so a number is only
+// consumed by an invoice that was actually issued.
+func (r *GORMInvoiceRepository) Issue(ctx context.Context, ownerID, id uuid.UUID, issued entity.InvoiceEntity, formatNumber func(seq int64) string) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	default:
+	}
+
+	ok := false
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.InvoiceEntity{}).
+			Where("id = ? AND owner_id = ? AND status = ?", id, ownerID, entity.InvoiceStatusDraft).
+			Updates(map[string]interface{}{
+				"status":                  entity.InvoiceStatusIssued,
+				"issued_at":               issued.IssuedAt,
+				"base_currency":           issued.BaseCurrency,
+				"exchange_rate":           issued.ExchangeRate,
+				"base_grand_price":        issued.BaseGrandPrice,
+				"bill_to_name":            issued.BillTo.Name,
+				"bill_to_email":           issued.BillTo.Email,
+				"bill_to_billing_address": issued.BillTo.BillingAddress,
+				"bill_to_tax_id":          issued.BillTo.TaxID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		seq, err := nextSequenceValue(tx, ownerID, invoiceSequence)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entity.InvoiceEntity{}).
+			Where("id = ?", id).
+			Update("number", formatNumber(seq)).Error; err != nil {
+			return err
+		}
+		ok = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,34 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LockByID loads an invoice without its relations and locks its row until the
+// surrounding unit of work ends. It returns nil when no matching invoice exists.
+func (r *GORMInvoiceRepository) LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var invoice entity.InvoiceEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&invoice).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &invoice, nil
+}
@@ -0,0 +1,39 @@
+package invoice
+
+import (
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// invoiceSequence is the sequence name used for invoice numbers
+	invoiceSequence = "invoice"
+	// creditNoteSequence is the sequence name used for credit note numbers
+	creditNoteSequence = "credit_note"
+)
+
+// nextSequenceValue allocates the next value of the owner's named sequence.
+// It must run inside a transaction: the increment locks the sequence row so
+// concurrent allocations are serialised, and a rollback returns the value.
+func nextSequenceValue(tx *gorm.DB, ownerID uuid.UUID, name string) (int64, error) {
+	seed := entity.SequenceEntity{OwnerID: ownerID, Name: name, NextValue: 1}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&seed).Error; err != nil {
+		return 0, err
+	}
+
+	if err := tx.Model(&entity.SequenceEntity{}).
+		Where("owner_id = ? AND name = ?", ownerID, name).
+		Update("next_value", gorm.Expr("next_value + 1")).Error; err != nil {
+		return 0, err
+	}
+
+	var sequence entity.SequenceEntity
+	if err := tx.Where("owner_id = ? AND name = ?", ownerID, name).
+		First(&sequence).Error; err != nil {
+		return 0, err
+	}
+
+	return sequence.NextValue - 1, nil
+}
@@ -0,0 +1,28 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// SumPayments returns the net amount paid on an invoice: its payments minus its refunds
+func (r *GORMInvoiceRepository) SumPayments(ctx context.Context, invoiceID uuid.UUID) (int64, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	default:
+	}
+
+	var net int64
+	if err := unitofwork.DB(ctx, r.db).Model(&entity.PaymentEntity{}).
+		Select("COALESCE(SUM(CASE WHEN kind = ? THEN -amount ELSE amount END), 0)", entity.PaymentKindRefund).
+		Where("invoice_id = ?", invoiceID).
+		Scan(&net).Error; err != nil {
+		return 0, err
+	}
+
+	return net, nil
+}
@@ -0,0 +1,55 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+// Summarize totals the owner's invoices matching filter, grouped by currency
+// and exchange rate snapshot. Credit notes reduce an invoice's outstanding
+// balance; overpayments count towards AmountPaid but never reduce another
+// invoice's outstanding balance.
+func (r *GORMInvoiceRepository) Summarize(ctx context.Context, ownerID uuid.UUID, filter interfaces.InvoiceFilter) ([]interfaces.InvoiceTotals, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var totals []interfaces.InvoiceTotals
+	query := applyFilter(unitofwork.DB(ctx, r.db).Model(&entity.InvoiceEntity{}), ownerID, filter)
+	if err := query.
+		Select(`currency, base_currency, exchange_rate,
+			COUNT(*) AS count,
+			COALESCE(SUM(grand_price), 0) AS grand_price,
+			COALESCE(SUM(amount_paid), 0) AS amount_paid,
+			COALESCE(SUM(CASE WHEN status <> ? AND grand_price - credited_total > amount_paid THEN grand_price - credited_total - amount_paid ELSE 0 END), 0) AS outstanding_balance`,
+			entity.InvoiceStatusVoid).
+		Group("currency, base_currency, exchange_rate").
+		Order("currency").
+		Scan(&totals).Error; err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// applyFilter scopes a query to the owner's invoices matching filter
+func applyFilter(query *gorm.DB, ownerID uuid.UUID, filter interfaces.InvoiceFilter) *gorm.DB {
+	query = query.Where("owner_id = ?", ownerID)
+
+	// Apply status filters
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	return query
+}
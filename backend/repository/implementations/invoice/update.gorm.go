@@ -5,17 +5,29 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
-// Update updates an invoice by ID
-func (r *GORMInvoiceRepository) Update(ctx context.Context, id uuid.UUID, invoice entity.InvoiceEntity) error {
+// Update writes an invoice's customer, currency, tax rate and totals by ID within the owner's scope
+func (r *GORMInvoiceRepository) Update(ctx context.Context, ownerID, id uuid.UUID, invoice entity.InvoiceEntity) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
-	return r.db.WithContext(ctx).Model(&entity.InvoiceEntity{}).
-		Where("id = ?", id).
-		Update("grand_price", invoice.GrandPrice).Error
+	err := unitofwork.DB(ctx, r.db).Model(&entity.InvoiceEntity{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Updates(map[string]interface{}{
+			"customer_id":    invoice.CustomerID,
+			"currency":       invoice.Currency,
+			"subtotal":       invoice.Subtotal,
+			"discount_total": invoice.DiscountTotal,
+			"tax_rate_id":    invoice.TaxRateID,
+			"tax_rate":       invoice.TaxRate,
+			"tax_total":      invoice.TaxTotal,
+			"grand_price":    invoice.GrandPrice,
+		}).Error
+	return dberror.Translate(r.db, err)
 }
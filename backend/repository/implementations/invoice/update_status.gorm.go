@@ -0,0 +1,33 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// UpdateStatus moves an invoice out of the from status, guarding against concurrent transitions
+func (r *GORMInvoiceRepository) UpdateStatus(ctx context.Context, ownerID, id uuid.UUID, from entity.InvoiceStatus, invoice entity.InvoiceEntity) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	default:
+	}
+
+	result := unitofwork.DB(ctx, r.db).Model(&entity.InvoiceEntity{}).
+		Where("id = ? AND owner_id = ? AND status = ?", id, ownerID, from).
+		Updates(map[string]interface{}{
+			"status":      invoice.Status,
+			"amount_paid": invoice.AmountPaid,
+			"issued_at":   invoice.IssuedAt,
+			"paid_at":     invoice.PaidAt,
+			"voided_at":   invoice.VoidedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,60 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Create creates a new invoice template with its lines and tag associations
+func (r *GORMInvoiceTemplateRepository) Create(ctx context.Context, template entity.InvoiceTemplateEntity) (*uuid.UUID, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&template).Error; err != nil {
+			return err
+		}
+		return replaceRelations(tx, template)
+	})
+	if err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &template.ID, nil
+}
+
+// replaceRelations stores the template's lines and tags in place of any
+// existing ones
+func replaceRelations(tx *gorm.DB, template entity.InvoiceTemplateEntity) error {
+	if err := tx.Where("template_id = ?", template.ID).Delete(&entity.InvoiceTemplateItemEntity{}).Error; err != nil {
+		return err
+	}
+	if len(template.Items) > 0 {
+		items := template.Items
+		for i := range items {
+			items[i].TemplateID = template.ID
+			items[i].Position = i
+		}
+		if err := tx.Omit("Item").Create(&items).Error; err != nil {
+			return err
+		}
+	}
+
+	tags := template.Tags
+	if tags == nil {
+		tags = []entity.TagEntity{}
+	}
+	return tx.Model(&entity.InvoiceTemplateEntity{ID: template.ID}).
+		Omit("Tags.*").
+		Association("Tags").
+		Replace(&tags)
+}
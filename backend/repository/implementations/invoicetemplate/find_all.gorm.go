@@ -0,0 +1,40 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindAll finds the owner's invoice templates with pagination, soonest run first
+func (r *GORMInvoiceTemplateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int) ([]entity.InvoiceTemplateEntity, int64, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	default:
+	}
+
+	var templates []entity.InvoiceTemplateEntity
+	var total int64
+
+	query := unitofwork.DB(ctx, r.db).Model(&entity.InvoiceTemplateEntity{}).
+		Where("owner_id = ?", ownerID)
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := preloadRelations(query).
+		Order("next_run_date, name").
+		Offset(offset).Limit(limit).
+		Find(&templates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
@@ -0,0 +1,31 @@
+package invoicetemplate
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindDue finds active templates of every owner that are due at the given
+// time, skipping those still backing off after a failed run
+func (r *GORMInvoiceTemplateRepository) FindDue(ctx context.Context, at time.Time, limit int) ([]entity.InvoiceTemplateEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var templates []entity.InvoiceTemplateEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("active = ? AND next_run_date <= ?", true, at.UTC()).
+		Where("next_attempt_at IS NULL OR next_attempt_at <= ?", at.UTC()).
+		Order("next_run_date, id").
+		Limit(limit).
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
@@ -0,0 +1,33 @@
+package invoicetemplate
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMInvoiceTemplateRepository is a GORM implementation of InvoiceTemplateRepository
+type GORMInvoiceTemplateRepository struct {
+	db *gorm.DB
+}
+
+// InvoiceTemplateModel represents the invoice_templates table schema
+type InvoiceTemplateModel = entity.InvoiceTemplateEntity
+
+// NewGORMInvoiceTemplateRepository creates a new GORM invoice template repository
+func NewGORMInvoiceTemplateRepository(db *gorm.DB) (*GORMInvoiceTemplateRepository, error) {
+	return &GORMInvoiceTemplateRepository{
+		db: db,
+	}, nil
+}
+
+// preloadRelations loads a template's customer, its lines in order, with
+// their items, and its tags
+func preloadRelations(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Customer").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
+		Preload("Items.Item").
+		Preload("Tags")
+}
@@ -0,0 +1,278 @@
+package invoicetemplate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) (*GORMInvoiceTemplateRepository, *gorm.DB) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMInvoiceTemplateRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo, db
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCreateAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo, db := newTestRepository(t)
+	ownerID := uuid.New()
+
+	items := []entity.ItemEntity{{ID: uuid.New(), Name: "Hosting"}, {ID: uuid.New(), Name: "Support"}}
+	tags := []entity.TagEntity{{ID: uuid.New(), Name: "monthly"}, {ID: uuid.New(), Name: "retainer"}}
+	if err := db.Create(&items).Error; err != nil {
+		t.Fatalf("failed to create items: %v", err)
+	}
+	if err := db.Create(&tags).Error; err != nil {
+		t.Fatalf("failed to create tags: %v", err)
+	}
+
+	id, err := repo.Create(ctx, entity.InvoiceTemplateEntity{
+		ID:          uuid.New(),
+		OwnerID:     ownerID,
+		Name:        "Hosting plan",
+		Cadence:     entity.TemplateCadenceMonthly,
+		NextRunDate: date(2024, 1, 31),
+		AnchorDay:   31,
+		Active:      true,
+		Items: []entity.InvoiceTemplateItemEntity{
+			{ID: uuid.New(), ItemID: items[1].ID, Quantity: 1, UnitPrice: 500},
+			{ID: uuid.New(), ItemID: items[0].ID, Quantity: 2, UnitPrice: 1000},
+		},
+		Tags: tags[:1],
+	})
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+
+	t.Run("should load lines in order with their items and tags", func(t *testing.T) {
+		template, err := repo.FindByID(ctx, ownerID, *id)
+		if err != nil || template == nil {
+			t.Fatalf("expected template, got %v (err %v)", template, err)
+		}
+		if len(template.Items) != 2 || template.Items[0].Item.Name != "Support" || template.Items[1].Item.Name != "Hosting" {
+			t.Errorf("unexpected lines %+v", template.Items)
+		}
+		if len(template.Tags) != 1 || template.Tags[0].Name != "monthly" {
+			t.Errorf("unexpected tags %+v", template.Tags)
+		}
+	})
+
+	t.Run("should hide the template from another user", func(t *testing.T) {
+		template, err := repo.FindByID(ctx, uuid.New(), *id)
+		if err != nil || template != nil {
+			t.Errorf("expected nil template, got %v (err %v)", template, err)
+		}
+	})
+
+	t.Run("should replace lines and tags on update", func(t *testing.T) {
+		err := repo.Update(ctx, ownerID, *id, entity.InvoiceTemplateEntity{
+			Name:        "Hosting plan v2",
+			Cadence:     entity.TemplateCadenceYearly,
+			NextRunDate: date(2025, 1, 1),
+			AnchorDay:   1,
+			Items:       []entity.InvoiceTemplateItemEntity{{ID: uuid.New(), ItemID: items[0].ID, Quantity: 12, UnitPrice: 900}},
+			Tags:        tags[1:],
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		template, err := repo.FindByID(ctx, ownerID, *id)
+		if err != nil || template == nil {
+			t.Fatalf("expected template, got %v (err %v)", template, err)
+		}
+		if template.Name != "Hosting plan v2" || template.Active || template.Cadence != entity.TemplateCadenceYearly {
+			t.Errorf("unexpected fields %+v", template)
+		}
+		if len(template.Items) != 1 || template.Items[0].Quantity != 12 {
+			t.Errorf("expected the new line only, got %+v", template.Items)
+		}
+		if len(template.Tags) != 1 || template.Tags[0].Name != "retainer" {
+			t.Errorf("expected the new tag only, got %+v", template.Tags)
+		}
+	})
+}
+
+func TestFindDueAndRecordRun(t *testing.T) {
+	ctx := context.Background()
+	repo, db := newTestRepository(t)
+	now := date(2024, 3, 1)
+
+	create := func(ownerID uuid.UUID, next time.Time, active bool) uuid.UUID {
+		t.Helper()
+		id, err := repo.Create(ctx, entity.InvoiceTemplateEntity{
+			ID: uuid.New(), OwnerID: ownerID, Name: "plan", Cadence: entity.TemplateCadenceMonthly,
+			NextRunDate: next, AnchorDay: next.Day(), Active: active,
+		})
+		if err != nil {
+			t.Fatalf("failed to create template: %v", err)
+		}
+		return *id
+	}
+
+	overdue := create(uuid.New(), date(2024, 2, 1), true)
+	today := create(uuid.New(), now, true)
+	create(uuid.New(), date(2024, 3, 2), true)
+	create(uuid.New(), date(2024, 1, 1), false)
+
+	due, err := repo.FindDue(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(due) != 2 || due[0].ID != overdue || due[1].ID != today {
+		t.Fatalf("expected the overdue and today's templates of any owner, got %+v", due)
+	}
+
+	run := entity.InvoiceTemplateRunEntity{ID: uuid.New(), TemplateID: overdue, RunDate: date(2024, 2, 1), InvoiceID: uuid.New(), CreatedAt: now}
+	recorded, err := repo.RecordRun(ctx, run, date(2024, 3, 1))
+	if err != nil || !recorded {
+		t.Fatalf("expected run to be recorded, got %v (err %v)", recorded, err)
+	}
+
+	t.Run("should ignore a run for a date the template has moved past", func(t *testing.T) {
+		again := run
+		again.ID = uuid.New()
+		recorded, err := repo.RecordRun(ctx, again, date(2024, 3, 1))
+		if err != nil || recorded {
+			t.Errorf("expected repeated run to be ignored, got %v (err %v)", recorded, err)
+		}
+	})
+
+	t.Run("should reject a second run for the same date", func(t *testing.T) {
+		// Even if the schedule is moved back, a date cannot run twice
+		if err := db.Model(&entity.InvoiceTemplateEntity{}).Where("id = ?", overdue).
+			Update("next_run_date", date(2024, 2, 1)).Error; err != nil {
+			t.Fatalf("failed to move schedule back: %v", err)
+		}
+		again := run
+		again.ID = uuid.New()
+		if _, err := repo.RecordRun(ctx, again, date(2024, 3, 1)); err == nil {
+			t.Fatalf("expected duplicate run to fail")
+		}
+
+		var template entity.InvoiceTemplateEntity
+		db.First(&template, "id = ?", overdue)
+		if !template.NextRunDate.Equal(date(2024, 2, 1)) {
+			t.Errorf("expected failed run to leave the schedule alone, got %v", template.NextRunDate)
+		}
+	})
+
+	t.Run("should store the failure and hold the template back", func(t *testing.T) {
+		retryAt := now.Add(time.Hour)
+		if err := repo.RecordFailure(ctx, today, "item not found", retryAt); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.RecordFailure(ctx, today, "item not found", retryAt); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var template entity.InvoiceTemplateEntity
+		db.First(&template, "id = ?", today)
+		if template.LastError == nil || *template.LastError != "item not found" {
+			t.Errorf("expected last error to be stored, got %v", template.LastError)
+		}
+		if template.FailureCount != 2 || template.NextAttemptAt == nil || !template.NextAttemptAt.Equal(retryAt) {
+			t.Errorf("expected 2 failures and a retry at %v, got %d and %v", retryAt, template.FailureCount, template.NextAttemptAt)
+		}
+
+		isDue := func(at time.Time) bool {
+			t.Helper()
+			due, err := repo.FindDue(ctx, at, 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, template := range due {
+				if template.ID == today {
+					return true
+				}
+			}
+			return false
+		}
+		if isDue(now) {
+			t.Errorf("expected the failed template to wait until %v", retryAt)
+		}
+		if !isDue(retryAt) {
+			t.Errorf("expected the failed template to be retried at %v", retryAt)
+		}
+	})
+
+	t.Run("should clear failures when a run is recorded", func(t *testing.T) {
+		run := entity.InvoiceTemplateRunEntity{ID: uuid.New(), TemplateID: today, RunDate: now, InvoiceID: uuid.New(), CreatedAt: now}
+		if recorded, err := repo.RecordRun(ctx, run, date(2024, 4, 1)); err != nil || !recorded {
+			t.Fatalf("expected run to be recorded, got %v (err %v)", recorded, err)
+		}
+		var template entity.InvoiceTemplateEntity
+		db.First(&template, "id = ?", today)
+		if template.LastError != nil || template.FailureCount != 0 || template.NextAttemptAt != nil {
+			t.Errorf("expected failures to be cleared, got %v, %d and %v", template.LastError, template.FailureCount, template.NextAttemptAt)
+		}
+	})
+}
+
+func TestFindDueSkipsFailingTemplates(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := newTestRepository(t)
+	now := date(2024, 3, 1)
+	// The scheduler asks for one batch of this size per check
+	const batchSize = 100
+
+	// More failing templates than fit in a batch, all due before the healthy one
+	for i := range batchSize + 1 {
+		id, err := repo.Create(ctx, entity.InvoiceTemplateEntity{
+			ID: uuid.New(), OwnerID: uuid.New(), Name: "broken", Cadence: entity.TemplateCadenceMonthly,
+			NextRunDate: date(2024, 1, 1).AddDate(0, 0, i%28), AnchorDay: 1, Active: true,
+		})
+		if err != nil {
+			t.Fatalf("failed to create template: %v", err)
+		}
+		if err := repo.RecordFailure(ctx, *id, "item not found", now.Add(5*time.Minute)); err != nil {
+			t.Fatalf("failed to record failure: %v", err)
+		}
+	}
+	healthy, err := repo.Create(ctx, entity.InvoiceTemplateEntity{
+		ID: uuid.New(), OwnerID: uuid.New(), Name: "plan", Cadence: entity.TemplateCadenceMonthly,
+		NextRunDate: now, AnchorDay: 1, Active: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create template: %v", err)
+	}
+
+	due, err := repo.FindDue(ctx, now, batchSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(due) != 1 || due[0].ID != *healthy {
+		t.Errorf("expected only the healthy template, got %d templates", len(due))
+	}
+}
@@ -0,0 +1,35 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LockByID loads an invoice template with its lines and tags and locks its
+// row until the surrounding unit of work ends. It returns nil when no
+// matching template exists.
+func (r *GORMInvoiceTemplateRepository) LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceTemplateEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var template entity.InvoiceTemplateEntity
+	if err := preloadRelations(unitofwork.DB(ctx, r.db)).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&template).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &template, nil
+}
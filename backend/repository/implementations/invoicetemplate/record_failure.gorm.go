@@ -0,0 +1,29 @@
+package invoicetemplate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// RecordFailure stores the reason the template's latest run failed, counts
+// the failure and holds the template back until nextAttemptAt
+func (r *GORMInvoiceTemplateRepository) RecordFailure(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	return unitofwork.DB(ctx, r.db).Model(&entity.InvoiceTemplateEntity{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_error":      message,
+			"failure_count":   gorm.Expr("failure_count + 1"),
+			"next_attempt_at": nextAttemptAt.UTC(),
+		}).Error
+}
@@ -0,0 +1,49 @@
+package invoicetemplate
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// RecordRun advances a template past run.RunDate and stores the run. The
+// template only moves if it is still scheduled for run.RunDate, and the run
+// itself is unique per template and date, so a repeated run changes nothing.
+func (r *GORMInvoiceTemplateRepository) RecordRun(ctx context.Context, run entity.InvoiceTemplateRunEntity, nextRunDate time.Time) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	default:
+	}
+
+	recorded := false
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.InvoiceTemplateEntity{}).
+			Where("id = ? AND next_run_date = ?", run.TemplateID, run.RunDate).
+			Updates(map[string]interface{}{
+				"next_run_date":   nextRunDate,
+				"last_run_at":     run.CreatedAt,
+				"last_error":      nil,
+				"failure_count":   0,
+				"next_attempt_at": nil,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		if err := tx.Create(&run).Error; err != nil {
+			return err
+		}
+
+		recorded = true
+		return nil
+	})
+	if err != nil {
+		return false, dberror.Translate(r.db, err)
+	}
+
+	return recorded, nil
+}
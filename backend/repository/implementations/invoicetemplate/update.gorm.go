@@ -0,0 +1,47 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// Update writes an invoice template's fields and replaces its lines and tags
+// by ID within the owner's scope
+func (r *GORMInvoiceTemplateRepository) Update(ctx context.Context, ownerID, id uuid.UUID, template entity.InvoiceTemplateEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.InvoiceTemplateEntity{}).
+			Where("id = ? AND owner_id = ?", id, ownerID).
+			Updates(map[string]interface{}{
+				"name":            template.Name,
+				"customer_id":     template.CustomerID,
+				"currency":        template.Currency,
+				"tax_rate_id":     template.TaxRateID,
+				"cadence":         template.Cadence,
+				"next_run_date":   template.NextRunDate,
+				"anchor_day":      template.AnchorDay,
+				"auto_issue":      template.AutoIssue,
+				"active":          template.Active,
+				"last_error":      template.LastError,
+				"failure_count":   template.FailureCount,
+				"next_attempt_at": template.NextAttemptAt,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		template.ID = id
+		return replaceRelations(tx, template)
+	})
+	return dberror.Translate(r.db, err)
+}
@@ -0,0 +1,41 @@
+package item
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/queryspec"
+)
+
+// FindByCursor finds up to limit of the owner's items matching spec from
+// the page spec.Cursor points at
+func (r *GORMItemRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.ItemEntity, query.Cursors, error) {
+	select {
+	case <-ctx.Done():
+		return nil, query.Cursors{}, ctx.Err()
+	default:
+	}
+
+	db, err := r.matching(ctx, ownerID, spec)
+	if err != nil {
+		return nil, query.Cursors{}, err
+	}
+
+	return queryspec.Page(db, limit, queryspec.SortOf(spec, defaultSort), queryColumns, spec.Cursor, itemKey)
+}
+
+// itemKey returns the value of a field items are sorted by
+func itemKey(item entity.ItemEntity, field string) any {
+	switch field {
+	case "name":
+		return item.Name
+	case "created_at":
+		return item.CreatedAt
+	case "updated_at":
+		return item.UpdatedAt
+	default:
+		return item.ID
+	}
+}
@@ -5,10 +5,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindByID finds an item by ID
-func (r *GORMItemRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ItemEntity, error) {
+// FindByID finds an item by ID within the owner's scope.
+// It returns nil when no matching item exists.
+func (r *GORMItemRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ItemEntity, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -16,7 +19,12 @@ func (r *GORMItemRepository) FindByID(ctx context.Context, id uuid.UUID) (*entit
 	}
 
 	var item entity.ItemEntity
-	if err := r.db.WithContext(ctx).First(&item, id).Error; err != nil {
+	if err := unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&item).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 
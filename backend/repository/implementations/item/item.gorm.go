@@ -15,11 +15,6 @@ type ItemModel = entity.ItemEntity
 
 // NewGORMItemRepository creates a new GORM item repository
 func NewGORMItemRepository(db *gorm.DB) (*GORMItemRepository, error) {
-	// Auto-migrate the schema
-	if err := db.AutoMigrate(&ItemModel{}); err != nil {
-		return nil, err
-	}
-
 	return &GORMItemRepository{
 		db: db,
 	}, nil
@@ -0,0 +1,161 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMItemRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMItemRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	id, err := repo.Create(ctx, entity.ItemEntity{ID: uuid.New(), OwnerID: ownerA, Name: "Widget"})
+	if err != nil {
+		t.Fatalf("failed to create item: %v", err)
+	}
+
+	t.Run("should find the item for its owner", func(t *testing.T) {
+		item, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || item == nil {
+			t.Fatalf("expected item, got %v (err %v)", item, err)
+		}
+	})
+
+	t.Run("should hide the item from another user", func(t *testing.T) {
+		item, err := repo.FindByID(ctx, ownerB, *id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if item != nil {
+			t.Errorf("expected nil item, got %+v", item)
+		}
+
+		items, total, err := repo.FindAll(ctx, ownerB, 1, 10, query.Spec{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 0 || len(items) != 0 {
+			t.Errorf("expected no items, got %d (total %d)", len(items), total)
+		}
+	})
+
+	t.Run("should not update or delete another user's item", func(t *testing.T) {
+		if err := repo.Update(ctx, ownerB, *id, entity.ItemEntity{Name: "Hijacked"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.Delete(ctx, ownerB, *id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		item, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || item == nil {
+			t.Fatalf("expected item to survive, got %v (err %v)", item, err)
+		}
+		if item.Name != "Widget" {
+			t.Errorf("expected name Widget, got %s", item.Name)
+		}
+	})
+}
+
+func TestFindByCursor(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+
+	// Two items share a name, so pages must fall back to the id to split them
+	for _, name := range []string{"delta", "bravo", "alpha", "bravo", "charlie"} {
+		if _, err := repo.Create(ctx, entity.ItemEntity{ID: uuid.New(), OwnerID: ownerID, Name: name}); err != nil {
+			t.Fatalf("failed to create item: %v", err)
+		}
+	}
+	sorts := []query.Sort{{Field: "name", Desc: true}}
+
+	var pages [][]string
+	var last query.Cursors
+	spec := query.Spec{Sort: sorts, Cursor: &query.Cursor{Sort: sorts}}
+	for {
+		items, cursors, err := repo.FindByCursor(ctx, ownerID, 2, spec)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var names []string
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+		pages = append(pages, names)
+		last = cursors
+		if cursors.Next == nil {
+			break
+		}
+		spec.Cursor = cursors.Next
+	}
+
+	t.Run("should page forwards through every item once", func(t *testing.T) {
+		expected := [][]string{{"delta", "charlie"}, {"bravo", "bravo"}, {"alpha"}}
+		if !reflect.DeepEqual(pages, expected) {
+			t.Errorf("expected pages %v, got %v", expected, pages)
+		}
+	})
+
+	t.Run("should page backwards from the last page", func(t *testing.T) {
+		if last.Prev == nil {
+			t.Fatalf("expected a cursor to the previous page")
+		}
+		items, cursors, err := repo.FindByCursor(ctx, ownerID, 2, query.Spec{Sort: sorts, Cursor: last.Prev})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(items) != 2 || items[0].Name != "bravo" || items[1].Name != "bravo" {
+			t.Errorf("expected the two bravo items, got %+v", items)
+		}
+		if cursors.Prev == nil || cursors.Next == nil {
+			t.Errorf("expected cursors both ways, got %+v", cursors)
+		}
+	})
+
+	t.Run("should reject a cursor taken in another sort", func(t *testing.T) {
+		_, _, err := repo.FindByCursor(ctx, ownerID, 2, query.Spec{Cursor: last.Prev})
+		if !errors.Is(err, query.ErrInvalid) {
+			t.Errorf("expected ErrInvalid, got %v", err)
+		}
+	})
+}
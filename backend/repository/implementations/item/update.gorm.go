@@ -5,20 +5,23 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
-// Update updates an item by ID
-func (r *GORMItemRepository) Update(ctx context.Context, id uuid.UUID, item entity.ItemEntity) error {
+// Update updates an item by ID within the owner's scope
+func (r *GORMItemRepository) Update(ctx context.Context, ownerID, id uuid.UUID, item entity.ItemEntity) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
-	return r.db.WithContext(ctx).Model(&entity.ItemEntity{}).
-		Where("id = ?", id).
+	err := unitofwork.DB(ctx, r.db).Model(&entity.ItemEntity{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
 		Updates(map[string]interface{}{
 			"name": item.Name,
 			"desc": item.Desc,
 		}).Error
+	return dberror.Translate(r.db, err)
 }
@@ -0,0 +1,30 @@
+package loginattempt
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// Find finds the failed sign-ins of key, returning nil when there are none
+func (r *GORMLoginAttemptRepository) Find(ctx context.Context, key string) (*entity.LoginAttemptEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var attempt entity.LoginAttemptEntity
+	if err := unitofwork.DB(ctx, r.db).Where("key = ?", key).First(&attempt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &attempt, nil
+}
@@ -0,0 +1,21 @@
+package loginattempt
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMLoginAttemptRepository is a GORM implementation of LoginAttemptRepository
+type GORMLoginAttemptRepository struct {
+	db *gorm.DB
+}
+
+// LoginAttemptModel represents the login_attempts table schema
+type LoginAttemptModel = entity.LoginAttemptEntity
+
+// NewGORMLoginAttemptRepository creates a new GORM login attempt repository
+func NewGORMLoginAttemptRepository(db *gorm.DB) (*GORMLoginAttemptRepository, error) {
+	return &GORMLoginAttemptRepository{
+		db: db,
+	}, nil
+}
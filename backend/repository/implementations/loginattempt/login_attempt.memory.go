@@ -0,0 +1,93 @@
+package loginattempt
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// MemoryLoginAttemptRepository is an in-memory implementation of
+// LoginAttemptRepository. Its counts are lost on restart and not shared
+// between instances, so it only suits a single server. Keys whose failures
+// have been forgotten are pruned as new failures are recorded.
+type MemoryLoginAttemptRepository struct {
+	mu       sync.Mutex
+	attempts map[string]entity.LoginAttemptEntity
+	prunedAt time.Time
+}
+
+// NewMemoryLoginAttemptRepository creates a new in-memory login attempt repository
+func NewMemoryLoginAttemptRepository() *MemoryLoginAttemptRepository {
+	return &MemoryLoginAttemptRepository{
+		attempts: make(map[string]entity.LoginAttemptEntity),
+	}
+}
+
+// Find finds the failed sign-ins of key, returning nil when there are none
+func (r *MemoryLoginAttemptRepository) Find(ctx context.Context, key string) (*entity.LoginAttemptEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempt, ok := r.attempts[key]
+	if !ok {
+		return nil, nil
+	}
+	return &attempt, nil
+}
+
+// RecordFailure counts a failed sign-in of key, dropping the keys whose
+// last failure was before resetBefore
+func (r *MemoryLoginAttemptRepository) RecordFailure(ctx context.Context, key string, at, resetBefore time.Time) (*entity.LoginAttemptEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Sweeping at most once per reset window keeps each write cheap while
+	// no forgotten key outlives two windows
+	if at.Sub(r.prunedAt) >= at.Sub(resetBefore) {
+		for k, attempt := range r.attempts {
+			if attempt.LastFailedAt.Before(resetBefore) {
+				delete(r.attempts, k)
+			}
+		}
+		r.prunedAt = at
+	}
+
+	attempt, ok := r.attempts[key]
+	if !ok || attempt.LastFailedAt.Before(resetBefore) {
+		attempt = entity.LoginAttemptEntity{Key: key}
+	}
+	attempt.Failures++
+	attempt.LastFailedAt = at
+	r.attempts[key] = attempt
+
+	return &attempt, nil
+}
+
+// Reset forgets every failed sign-in of key
+func (r *MemoryLoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+	return nil
+}
@@ -0,0 +1,155 @@
+package loginattempt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"gorm.io/gorm"
+)
+
+func newTestGORMRepository(t *testing.T) *GORMLoginAttemptRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMLoginAttemptRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestLoginAttempts(t *testing.T) {
+	repositories := map[string]func(t *testing.T) interfaces.LoginAttemptRepository{
+		"gorm":   func(t *testing.T) interfaces.LoginAttemptRepository { return newTestGORMRepository(t) },
+		"memory": func(t *testing.T) interfaces.LoginAttemptRepository { return NewMemoryLoginAttemptRepository() },
+	}
+
+	for name, newRepository := range repositories {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := newRepository(t)
+			start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			never := time.Time{}
+
+			t.Run("should find nothing before a failure", func(t *testing.T) {
+				attempt, err := repo.Find(ctx, "email:a@example.com")
+				if err != nil || attempt != nil {
+					t.Fatalf("expected nothing, got %v (err %v)", attempt, err)
+				}
+			})
+
+			t.Run("should count failures per key", func(t *testing.T) {
+				for i := 1; i <= 3; i++ {
+					attempt, err := repo.RecordFailure(ctx, "email:a@example.com", start.Add(time.Duration(i)*time.Second), never)
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					if attempt.Failures != i {
+						t.Errorf("expected %d failures, got %d", i, attempt.Failures)
+					}
+				}
+				if _, err := repo.RecordFailure(ctx, "ip:203.0.113.7", start.Add(time.Hour), never); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				attempt, err := repo.Find(ctx, "email:a@example.com")
+				if err != nil || attempt == nil {
+					t.Fatalf("expected the attempt, got %v (err %v)", attempt, err)
+				}
+				if attempt.Failures != 3 || !attempt.LastFailedAt.Equal(start.Add(3*time.Second)) {
+					t.Errorf("expected 3 failures, the last at %v, got %+v", start.Add(3*time.Second), attempt)
+				}
+			})
+
+			t.Run("should forget failures older than resetBefore", func(t *testing.T) {
+				at := start.Add(time.Hour)
+				attempt, err := repo.RecordFailure(ctx, "email:a@example.com", at, at.Add(-15*time.Minute))
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if attempt.Failures != 1 || !attempt.LastFailedAt.Equal(at) {
+					t.Errorf("expected the count to restart at %v, got %+v", at, attempt)
+				}
+			})
+
+			t.Run("should reset a key and leave the others", func(t *testing.T) {
+				if err := repo.Reset(ctx, "email:a@example.com"); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if attempt, _ := repo.Find(ctx, "email:a@example.com"); attempt != nil {
+					t.Errorf("expected the key to be reset, got %+v", attempt)
+				}
+				if attempt, _ := repo.Find(ctx, "ip:203.0.113.7"); attempt == nil || attempt.Failures != 1 {
+					t.Errorf("expected the other key to keep its failure, got %+v", attempt)
+				}
+			})
+
+			t.Run("should respect context cancellation", func(t *testing.T) {
+				cancelled, cancel := context.WithCancel(ctx)
+				cancel()
+				if _, err := repo.RecordFailure(cancelled, "email:a@example.com", start, never); err == nil {
+					t.Error("expected an error")
+				}
+			})
+		})
+	}
+}
+
+func TestMemoryLoginAttemptsPrune(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryLoginAttemptRepository()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	window := 15 * time.Minute
+
+	record := func(key string, at time.Time) {
+		t.Helper()
+		if _, err := repo.RecordFailure(ctx, key, at, at.Add(-window)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for _, key := range []string{"ip:203.0.113.1", "ip:203.0.113.2", "ip:203.0.113.3"} {
+		record(key, start)
+	}
+	record("ip:203.0.113.4", start.Add(10*time.Minute))
+
+	t.Run("should keep keys within the window", func(t *testing.T) {
+		record("ip:203.0.113.5", start.Add(14*time.Minute))
+		if len(repo.attempts) != 5 {
+			t.Errorf("expected 5 keys, got %d", len(repo.attempts))
+		}
+	})
+
+	t.Run("should prune keys whose failures are forgotten", func(t *testing.T) {
+		record("ip:203.0.113.6", start.Add(20*time.Minute))
+		if len(repo.attempts) != 3 {
+			t.Errorf("expected 3 keys, got %d", len(repo.attempts))
+		}
+		if attempt, _ := repo.Find(ctx, "ip:203.0.113.1"); attempt != nil {
+			t.Errorf("expected the stale key to be pruned, got %+v", attempt)
+		}
+		if attempt, _ := repo.Find(ctx, "ip:203.0.113.4"); attempt == nil {
+			t.Errorf("expected the recent key to be kept")
+		}
+	})
+}
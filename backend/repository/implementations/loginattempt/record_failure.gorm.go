@@ -0,0 +1,46 @@
+package loginattempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecordFailure counts a failed sign-in of key. The count is incremented in
+// the database, so concurrent failures are never lost.
+func (r *GORMLoginAttemptRepository) RecordFailure(ctx context.Context, key string, at, resetBefore time.Time) (*entity.LoginAttemptEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var attempt entity.LoginAttemptEntity
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		seed := LoginAttemptModel{Key: key, LastFailedAt: at}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&seed).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&LoginAttemptModel{}).
+			Where("key = ?", key).
+			Updates(map[string]interface{}{
+				"failures":       gorm.Expr("CASE WHEN last_failed_at < ? THEN 1 ELSE failures + 1 END", resetBefore),
+				"last_failed_at": at,
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("key = ?", key).First(&attempt).Error
+	})
+	if err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &attempt, nil
+}
@@ -0,0 +1,23 @@
+package loginattempt
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Reset forgets every failed sign-in of key
+func (r *GORMLoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Where("key = ?", key).Delete(&LoginAttemptModel{}).Error; err != nil {
+		return dberror.Translate(r.db, err)
+	}
+
+	return nil
+}
@@ -2,7 +2,9 @@ package message
 
 import (
 	"context"
-	"fmt"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // GetMessage returns a stored message from GORM
@@ -14,8 +16,8 @@ func (r *GORMMessageRepository) GetMessage(ctx context.Context, key string) (*st
 	}
 
 	var message MessageModel
-	if err := r.db.WithContext(ctx).Where("key = ?", key).First(&message).Error; err != nil {
-		return nil, fmt.Errorf("message not found")
+	if err := unitofwork.DB(ctx, r.db).Where("key = ?", key).First(&message).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
 	}
 	resp := message.Value
 
@@ -0,0 +1,45 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Down reverts the last steps applied migrations, newest first, and returns
+// those reverted. Each runs in a transaction with the removal of its
+// schema_migrations row, and the first to fail stops the rest.
+func (m *GORMMigrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := run(tx, migration.down); err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigrationModel{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("failed to revert migration %s: %w", migration, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
@@ -0,0 +1,183 @@
+// Package migration applies the versioned SQL migrations that define the
+// database schema. Each migration is a NNNN_name.up.sql and
+// NNNN_name.down.sql pair under sql/<dialect>, with one directory per
+// supported database, and the versions applied are recorded in the
+// schema_migrations table.
+package migration
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql
+var files embed.FS
+
+// filePattern matches a migration file name, e.g. 0001_create_users.up.sql
+var filePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// statementEnd ends a statement: a semicolon at the end of a line
+var statementEnd = regexp.MustCompile(`;[ \t]*(\r?\n|$)`)
+
+// createSchemaMigrations creates the table applied versions are kept in
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`
+
+// Migration is one versioned change to the schema
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+// String returns the file name of m without its direction, e.g. 0001_create_users
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Status is a migration with the time it was applied, nil while pending
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// SchemaMigrationModel represents the schema_migrations table schema
+type SchemaMigrationModel struct {
+	Version   int64 `gorm:"primaryKey"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// TableName specifies the table name for SchemaMigrationModel
+func (SchemaMigrationModel) TableName() string {
+	return "schema_migrations"
+}
+
+// GORMMigrator applies the migrations of the dialect of a GORM database
+type GORMMigrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewGORMMigrator creates a migrator with the migrations of the dialect of
+// db, e.g. sqlite or postgres
+func NewGORMMigrator(db *gorm.DB) (*GORMMigrator, error) {
+	migrations, err := load(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GORMMigrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// load reads the migrations of dialect in version order, checking that
+// each has both an up and a down file
+func load(dialect string) ([]Migration, error) {
+	dir := path.Join("sql", dialect)
+	entries, err := fs.ReadDir(files, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s databases", dialect)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := filePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %s is not named NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration file %s: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(files, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %04d is named both %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.up = string(content)
+		} else {
+			migration.down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %s needs both an up and a down file", migration)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// applied returns the rows of schema_migrations by version, none when the
+// table does not exist yet
+func (m *GORMMigrator) applied(ctx context.Context) (map[int64]SchemaMigrationModel, error) {
+	db := m.db.WithContext(ctx)
+	if !db.Migrator().HasTable(&SchemaMigrationModel{}) {
+		return map[int64]SchemaMigrationModel{}, nil
+	}
+
+	var rows []SchemaMigrationModel
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	applied := make(map[int64]SchemaMigrationModel, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// run executes each statement of sql in tx
+func run(tx *gorm.DB, sql string) error {
+	for _, statement := range statementEnd.Split(sql, -1) {
+		if !hasSQL(statement) {
+			continue
+		}
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasSQL reports whether statement has a line that is neither blank nor a
+// comment
+func hasSQL(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestMigrator(t *testing.T) (*GORMMigrator, *gorm.DB) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+	return migrator, db
+}
+
+func TestDialectsHaveTheSameMigrations(t *testing.T) {
+	sqliteMigrations, err := load("sqlite")
+	if err != nil {
+		t.Fatalf("failed to load sqlite migrations: %v", err)
+	}
+	postgresMigrations, err := load("postgres")
+	if err != nil {
+		t.Fatalf("failed to load postgres migrations: %v", err)
+	}
+
+	if len(sqliteMigrations) != len(postgresMigrations) {
+		t.Fatalf("expected %d postgres migrations, got %d", len(sqliteMigrations), len(postgresMigrations))
+	}
+	for i := range sqliteMigrations {
+		if sqliteMigrations[i].String() != postgresMigrations[i].String() {
+			t.Errorf("expected migration %s, got %s", sqliteMigrations[i], postgresMigrations[i])
+		}
+		if i > 0 && sqliteMigrations[i].Version <= sqliteMigrations[i-1].Version {
+			t.Errorf("expected %s after %s", sqliteMigrations[i], sqliteMigrations[i-1])
+		}
+	}
+}
+
+func TestUpAndDown(t *testing.T) {
+	ctx := context.Background()
+	migrator, db := newTestMigrator(t)
+	total := len(migrator.migrations)
+	last := migrator.migrations[total-1]
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(applied) != total {
+		t.Fatalf("expected %d migrations applied, got %d", total, len(applied))
+	}
+	for _, table := range []string{"user_entities", "refresh_tokens", "invoices", "invoice_to_tags", "invoice_template_runs", "user_roles", "api_keys", "login_attempts", "user_tokens"} {
+		if !db.Migrator().HasTable(table) {
+			t.Errorf("expected table %s", table)
+		}
+	}
+	var counters int64
+	db.Table("counters").Count(&counters)
+	if counters != 1 {
+		t.Errorf("expected the counter to be seeded, got %d rows", counters)
+	}
+
+	t.Run("should apply nothing when up to date", func(t *testing.T) {
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(applied) != 0 {
+			t.Errorf("expected no migrations applied, got %v", applied)
+		}
+	})
+
+	t.Run("should revert the newest migration", func(t *testing.T) {
+		reverted, err := migrator.Down(ctx, 1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(reverted) != 1 || reverted[0].Version != last.Version {
+			t.Fatalf("expected %s reverted, got %v", last, reverted)
+		}
+		if db.Migrator().HasColumn("invoice_templates", "failure_count") || db.Migrator().HasColumn("invoice_templates", "next_attempt_at") {
+			t.Errorf("expected the invoice template backoff columns to be dropped")
+		}
+
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pending) != 1 || pending[0].Version != last.Version {
+			t.Errorf("expected %s pending, got %v", last, pending)
+		}
+	})
+
+	t.Run("should report which migrations are applied", func(t *testing.T) {
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(statuses) != total {
+			t.Fatalf("expected %d statuses, got %d", total, len(statuses))
+		}
+		for i, status := range statuses {
+			if wantApplied := i < total-1; (status.AppliedAt != nil) != wantApplied {
+				t.Errorf("expected %s applied to be %v", status.Migration, wantApplied)
+			}
+		}
+	})
+
+	t.Run("should revert every migration", func(t *testing.T) {
+		reverted, err := migrator.Down(ctx, total)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(reverted) != total-1 {
+			t.Fatalf("expected %d migrations reverted, got %d", total-1, len(reverted))
+		}
+		tables, err := db.Migrator().GetTables()
+		if err != nil {
+			t.Fatalf("failed to list tables: %v", err)
+		}
+		if len(tables) != 1 || tables[0] != "schema_migrations" {
+			t.Errorf("expected only schema_migrations left, got %v", tables)
+		}
+	})
+
+	t.Run("should apply every migration again", func(t *testing.T) {
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(applied) != total {
+			t.Errorf("expected %d migrations applied, got %d", total, len(applied))
+		}
+	})
+}
+
+func TestHasSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		expected  bool
+	}{
+		{name: "should find a statement", statement: "\nCREATE TABLE t (id text)", expected: true},
+		{name: "should find a statement after a comment", statement: "-- t\nDROP TABLE t", expected: true},
+		{name: "should skip blank lines", statement: "\n  \n"},
+		{name: "should skip comments", statement: "-- nothing here\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasSQL(tt.statement); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
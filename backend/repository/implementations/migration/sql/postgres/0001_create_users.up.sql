@@ -0,0 +1,31 @@
+-- Users and the refresh tokens of their sessions
+
+CREATE TABLE IF NOT EXISTS "user_entities" (
+    "id" text,
+    "email" text,
+    "password" bytea,
+    "name" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_entities_deleted_at" ON "user_entities" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "refresh_tokens" (
+    "id" text,
+    "user_id" text,
+    "family_id" text,
+    "token_hash" text,
+    "expires_at" timestamptz,
+    "revoked_at" timestamptz,
+    "replaced_by_id" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_deleted_at" ON "refresh_tokens" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_family_id" ON "refresh_tokens" ("family_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_refresh_tokens_token_hash" ON "refresh_tokens" ("token_hash");
+CREATE INDEX IF NOT EXISTS "idx_refresh_tokens_user_id" ON "refresh_tokens" ("user_id");
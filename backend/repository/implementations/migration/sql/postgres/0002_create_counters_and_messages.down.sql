@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "message_entities";
+DROP TABLE IF EXISTS "counters";
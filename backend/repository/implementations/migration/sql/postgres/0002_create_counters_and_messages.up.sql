@@ -0,0 +1,25 @@
+-- Counter and message of the demo endpoints, each seeded with one row
+
+CREATE TABLE IF NOT EXISTS "counters" (
+    "id" text,
+    "value" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "message_entities" (
+    "id" text,
+    "key" text,
+    "value" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+INSERT INTO "counters" ("id", "value", "created_at", "updated_at")
+SELECT '6c2b0e8e-4f1a-4c55-9a3e-0d1f6f0b7a11', 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM "counters");
+INSERT INTO "message_entities" ("id", "key", "value", "created_at", "updated_at")
+SELECT 'b1f0c7d2-3e5a-4b8c-8d2f-5a6e7c9b0d12', 'default', 'Welcome to Clean Go Vite React!', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+WHERE NOT EXISTS (SELECT 1 FROM "message_entities");
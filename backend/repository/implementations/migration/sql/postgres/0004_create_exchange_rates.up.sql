@@ -0,0 +1,15 @@
+-- Per-user exchange rates between currency pairs
+
+CREATE TABLE IF NOT EXISTS "exchange_rates" (
+    "id" text,
+    "owner_id" text,
+    "base_currency" varchar(3) NOT NULL,
+    "quote_currency" varchar(3) NOT NULL,
+    "rate" varchar(32) NOT NULL,
+    "effective_date" timestamptz NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_exchange_rates_owner_id" ON "exchange_rates" ("owner_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_exchange_rates_pair_date" ON "exchange_rates" ("owner_id", "base_currency", "quote_currency", "effective_date");
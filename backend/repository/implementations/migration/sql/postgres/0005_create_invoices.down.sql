@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "credit_note_lines";
+DROP TABLE IF EXISTS "credit_notes";
+DROP TABLE IF EXISTS "payments";
+DROP TABLE IF EXISTS "sequences";
+DROP TABLE IF EXISTS "invoice_items";
+DROP TABLE IF EXISTS "invoice_to_tags";
+DROP TABLE IF EXISTS "invoices";
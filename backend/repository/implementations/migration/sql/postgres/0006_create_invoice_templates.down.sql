@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "invoice_template_runs";
+DROP TABLE IF EXISTS "invoice_template_items";
+DROP TABLE IF EXISTS "invoice_template_to_tags";
+DROP TABLE IF EXISTS "invoice_templates";
@@ -0,0 +1,18 @@
+-- Personal API keys, stored as hashes and limited to a set of permissions
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" text,
+    "user_id" text,
+    "name" varchar(255) NOT NULL,
+    "prefix" varchar(20) NOT NULL,
+    "key_hash" text NOT NULL,
+    "scopes" text NOT NULL,
+    "expires_at" timestamptz,
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_api_keys_key_hash" ON "api_keys" ("key_hash");
+CREATE INDEX IF NOT EXISTS "idx_api_keys_user_id" ON "api_keys" ("user_id");
@@ -0,0 +1,8 @@
+-- Recent failed sign-ins per email and per IP address, for throttling
+
+CREATE TABLE IF NOT EXISTS "login_attempts" (
+    "key" varchar(320),
+    "failures" bigint NOT NULL,
+    "last_failed_at" timestamptz NOT NULL,
+    PRIMARY KEY ("key")
+);
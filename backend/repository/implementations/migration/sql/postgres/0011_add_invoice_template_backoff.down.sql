@@ -0,0 +1,2 @@
+ALTER TABLE "invoice_templates" DROP COLUMN IF EXISTS "next_attempt_at";
+ALTER TABLE "invoice_templates" DROP COLUMN IF EXISTS "failure_count";
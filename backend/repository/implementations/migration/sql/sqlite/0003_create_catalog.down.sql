@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `customers`;
+DROP TABLE IF EXISTS `tax_rates`;
+DROP TABLE IF EXISTS `tags`;
+DROP TABLE IF EXISTS `items`;
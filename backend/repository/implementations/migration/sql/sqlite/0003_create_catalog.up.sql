@@ -0,0 +1,55 @@
+-- Items, tags, tax rates and customers invoices are built from
+
+CREATE TABLE IF NOT EXISTS `items` (
+    `id` text,
+    `owner_id` text,
+    `name` text DEFAULT "",
+    `desc` text DEFAULT "",
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_items_deleted_at` ON `items` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_items_owner_id` ON `items` (`owner_id`);
+
+CREATE TABLE IF NOT EXISTS `tags` (
+    `id` text,
+    `owner_id` text,
+    `name` text DEFAULT "",
+    `color_hex` text DEFAULT "#000000",
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_tags_deleted_at` ON `tags` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_tags_owner_id` ON `tags` (`owner_id`);
+
+CREATE TABLE IF NOT EXISTS `tax_rates` (
+    `id` text,
+    `owner_id` text,
+    `name` text DEFAULT "",
+    `rate` integer NOT NULL DEFAULT 0,
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_tax_rates_deleted_at` ON `tax_rates` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_tax_rates_owner_id` ON `tax_rates` (`owner_id`);
+
+CREATE TABLE IF NOT EXISTS `customers` (
+    `id` text,
+    `owner_id` text,
+    `name` varchar(255) NOT NULL,
+    `email` varchar(255) DEFAULT "",
+    `billing_address` text DEFAULT "",
+    `tax_id` varchar(64) DEFAULT "",
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_customers_deleted_at` ON `customers` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_customers_owner_id` ON `customers` (`owner_id`);
@@ -0,0 +1,132 @@
+-- Invoices with their lines, tags, payments and credit notes, and the
+-- per-user sequences that number them
+
+CREATE TABLE IF NOT EXISTS `invoices` (
+    `id` text,
+    `owner_id` text,
+    `number` varchar(64),
+    `status` varchar(20) DEFAULT "draft",
+    `customer_id` text,
+    `currency` varchar(3) NOT NULL DEFAULT "USD",
+    `subtotal` integer DEFAULT 0,
+    `discount_total` integer DEFAULT 0,
+    `tax_rate_id` text,
+    `tax_rate` integer DEFAULT 0,
+    `tax_total` integer DEFAULT 0,
+    `grand_price` integer DEFAULT 0,
+    `amount_paid` integer DEFAULT 0,
+    `credited_total` integer DEFAULT 0,
+    `base_currency` varchar(3),
+    `exchange_rate` varchar(32),
+    `base_grand_price` integer,
+    `bill_to_name` varchar(255),
+    `bill_to_email` varchar(255),
+    `bill_to_billing_address` text,
+    `bill_to_tax_id` varchar(64),
+    `issued_at` datetime,
+    `paid_at` datetime,
+    `voided_at` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoices_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers`(`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_invoices_currency` ON `invoices` (`currency`);
+CREATE INDEX IF NOT EXISTS `idx_invoices_customer_id` ON `invoices` (`customer_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoices_deleted_at` ON `invoices` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_invoices_owner_id` ON `invoices` (`owner_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_invoices_owner_number` ON `invoices` (`owner_id`, `number`);
+CREATE INDEX IF NOT EXISTS `idx_invoices_status` ON `invoices` (`status`);
+CREATE INDEX IF NOT EXISTS `idx_invoices_tax_rate_id` ON `invoices` (`tax_rate_id`);
+
+CREATE TABLE IF NOT EXISTS `invoice_to_tags` (
+    `invoice_entity_id` text,
+    `tag_entity_id` text,
+    PRIMARY KEY (`invoice_entity_id`, `tag_entity_id`),
+    CONSTRAINT `fk_invoice_to_tags_invoice_entity` FOREIGN KEY (`invoice_entity_id`) REFERENCES `invoices`(`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_invoice_to_tags_tag_entity` FOREIGN KEY (`tag_entity_id`) REFERENCES `tags`(`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `invoice_items` (
+    `id` text,
+    `invoice_id` text NOT NULL,
+    `item_id` text NOT NULL,
+    `quantity` integer DEFAULT 0,
+    `unit_price` integer DEFAULT 0,
+    `subtotal` integer DEFAULT 0,
+    `discount_type` varchar(10) DEFAULT "",
+    `discount_value` integer DEFAULT 0,
+    `discount_amount` integer DEFAULT 0,
+    `tax_rate_id` text,
+    `tax_rate` integer DEFAULT 0,
+    `tax_amount` integer DEFAULT 0,
+    `total_price` integer DEFAULT 0,
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoice_items_item` FOREIGN KEY (`item_id`) REFERENCES `items`(`id`),
+    CONSTRAINT `fk_invoices_items` FOREIGN KEY (`invoice_id`) REFERENCES `invoices`(`id`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_invoice_items_deleted_at` ON `invoice_items` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_items_invoice_id` ON `invoice_items` (`invoice_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_items_item_id` ON `invoice_items` (`item_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_items_tax_rate_id` ON `invoice_items` (`tax_rate_id`);
+
+CREATE TABLE IF NOT EXISTS `sequences` (
+    `owner_id` text,
+    `name` varchar(50),
+    `next_value` integer NOT NULL DEFAULT 1,
+    `updated_at` datetime,
+    PRIMARY KEY (`owner_id`, `name`)
+);
+
+CREATE TABLE IF NOT EXISTS `payments` (
+    `id` text,
+    `invoice_id` text,
+    `owner_id` text,
+    `kind` varchar(10) NOT NULL,
+    `amount` integer NOT NULL,
+    `method` varchar(20) NOT NULL,
+    `paid_at` datetime NOT NULL,
+    `reference` varchar(255) DEFAULT "",
+    `created_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoices_payments` FOREIGN KEY (`invoice_id`) REFERENCES `invoices`(`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_payments_invoice_id` ON `payments` (`invoice_id`);
+CREATE INDEX IF NOT EXISTS `idx_payments_owner_id` ON `payments` (`owner_id`);
+
+CREATE TABLE IF NOT EXISTS `credit_notes` (
+    `id` text,
+    `owner_id` text,
+    `invoice_id` text,
+    `number` varchar(64),
+    `reason` text NOT NULL,
+    `subtotal` integer DEFAULT 0,
+    `tax_total` integer DEFAULT 0,
+    `total` integer DEFAULT 0,
+    `issued_at` datetime NOT NULL,
+    `created_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoices_credit_notes` FOREIGN KEY (`invoice_id`) REFERENCES `invoices`(`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_credit_notes_invoice_id` ON `credit_notes` (`invoice_id`);
+CREATE INDEX IF NOT EXISTS `idx_credit_notes_owner_id` ON `credit_notes` (`owner_id`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_credit_notes_owner_number` ON `credit_notes` (`owner_id`, `number`);
+
+CREATE TABLE IF NOT EXISTS `credit_note_lines` (
+    `id` text,
+    `credit_note_id` text,
+    `invoice_item_id` text,
+    `quantity` integer DEFAULT 0,
+    `amount` integer DEFAULT 0,
+    `tax_amount` integer DEFAULT 0,
+    `total` integer DEFAULT 0,
+    `position` integer DEFAULT 0,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_credit_notes_lines` FOREIGN KEY (`credit_note_id`) REFERENCES `credit_notes`(`id`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_credit_note_lines_credit_note_id` ON `credit_note_lines` (`credit_note_id`);
+CREATE INDEX IF NOT EXISTS `idx_credit_note_lines_invoice_item_id` ON `credit_note_lines` (`invoice_item_id`);
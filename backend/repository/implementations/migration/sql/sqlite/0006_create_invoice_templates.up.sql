@@ -0,0 +1,66 @@
+-- Recurring invoice templates and the runs that created invoices from them
+
+CREATE TABLE IF NOT EXISTS `invoice_templates` (
+    `id` text,
+    `owner_id` text,
+    `name` varchar(255) NOT NULL,
+    `customer_id` text,
+    `currency` varchar(3) NOT NULL DEFAULT "USD",
+    `tax_rate_id` text,
+    `cadence` varchar(20) NOT NULL,
+    `next_run_date` datetime,
+    `anchor_day` integer DEFAULT 1,
+    `auto_issue` numeric DEFAULT false,
+    `active` numeric,
+    `last_run_at` datetime,
+    `last_error` text,
+    `created_at` datetime,
+    `updated_at` datetime,
+    `deleted_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoice_templates_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers`(`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_invoice_templates_customer_id` ON `invoice_templates` (`customer_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_templates_deleted_at` ON `invoice_templates` (`deleted_at`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_templates_due` ON `invoice_templates` (`active`, `next_run_date`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_templates_owner_id` ON `invoice_templates` (`owner_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_templates_tax_rate_id` ON `invoice_templates` (`tax_rate_id`);
+
+CREATE TABLE IF NOT EXISTS `invoice_template_to_tags` (
+    `invoice_template_entity_id` text,
+    `tag_entity_id` text,
+    PRIMARY KEY (`invoice_template_entity_id`, `tag_entity_id`),
+    CONSTRAINT `fk_invoice_template_to_tags_invoice_template_entity` FOREIGN KEY (`invoice_template_entity_id`) REFERENCES `invoice_templates`(`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_invoice_template_to_tags_tag_entity` FOREIGN KEY (`tag_entity_id`) REFERENCES `tags`(`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `invoice_template_items` (
+    `id` text,
+    `template_id` text,
+    `item_id` text,
+    `quantity` integer DEFAULT 0,
+    `unit_price` integer DEFAULT 0,
+    `discount_type` varchar(10) DEFAULT "",
+    `discount_value` integer DEFAULT 0,
+    `tax_rate_id` text,
+    `position` integer DEFAULT 0,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_invoice_templates_items` FOREIGN KEY (`template_id`) REFERENCES `invoice_templates`(`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_invoice_template_items_item` FOREIGN KEY (`item_id`) REFERENCES `items`(`id`)
+);
+CREATE INDEX IF NOT EXISTS `idx_invoice_template_items_item_id` ON `invoice_template_items` (`item_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_template_items_tax_rate_id` ON `invoice_template_items` (`tax_rate_id`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_template_items_template_id` ON `invoice_template_items` (`template_id`);
+
+CREATE TABLE IF NOT EXISTS `invoice_template_runs` (
+    `id` text,
+    `template_id` text,
+    `run_date` datetime,
+    `invoice_id` text,
+    `created_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_invoice_template_runs_date` ON `invoice_template_runs` (`template_id`, `run_date`);
+CREATE INDEX IF NOT EXISTS `idx_invoice_template_runs_invoice_id` ON `invoice_template_runs` (`invoice_id`);
@@ -0,0 +1,21 @@
+-- Roles granted to each user. Users registered before roles existed keep
+-- their access: the earliest becomes an admin and the rest accountants.
+
+CREATE TABLE IF NOT EXISTS `user_roles` (
+    `user_id` text,
+    `role` varchar(20),
+    `created_at` datetime,
+    PRIMARY KEY (`user_id`, `role`),
+    CONSTRAINT `fk_user_entities_roles` FOREIGN KEY (`user_id`) REFERENCES `user_entities` (`id`) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS `idx_user_roles_role` ON `user_roles` (`role`);
+
+INSERT INTO `user_roles` (`user_id`, `role`, `created_at`)
+SELECT u.`id`,
+    CASE WHEN u.`id` = (
+        SELECT `id` FROM `user_entities` WHERE `deleted_at` IS NULL ORDER BY `created_at`, `id` LIMIT 1
+    ) THEN 'admin' ELSE 'accountant' END,
+    CURRENT_TIMESTAMP
+FROM `user_entities` u
+WHERE u.`deleted_at` IS NULL
+    AND NOT EXISTS (SELECT 1 FROM `user_roles` WHERE `user_roles`.`user_id` = u.`id`);
@@ -0,0 +1,18 @@
+-- Personal API keys, stored as hashes and limited to a set of permissions
+
+CREATE TABLE IF NOT EXISTS `api_keys` (
+    `id` text,
+    `user_id` text,
+    `name` varchar(255) NOT NULL,
+    `prefix` varchar(20) NOT NULL,
+    `key_hash` text NOT NULL,
+    `scopes` text NOT NULL,
+    `expires_at` datetime,
+    `last_used_at` datetime,
+    `revoked_at` datetime,
+    `created_at` datetime,
+    `updated_at` datetime,
+    PRIMARY KEY (`id`)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_api_keys_key_hash` ON `api_keys` (`key_hash`);
+CREATE INDEX IF NOT EXISTS `idx_api_keys_user_id` ON `api_keys` (`user_id`);
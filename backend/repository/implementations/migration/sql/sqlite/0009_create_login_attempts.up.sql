@@ -0,0 +1,8 @@
+-- Recent failed sign-ins per email and per IP address, for throttling
+
+CREATE TABLE IF NOT EXISTS `login_attempts` (
+    `key` varchar(320),
+    `failures` integer NOT NULL,
+    `last_failed_at` datetime NOT NULL,
+    PRIMARY KEY (`key`)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `user_tokens`;
+ALTER TABLE `user_entities` DROP COLUMN `verified_at`;
@@ -0,0 +1,18 @@
+-- Single-use tokens for password resets and email verification, stored as
+-- hashes, and when each user verified their email
+
+ALTER TABLE `user_entities` ADD COLUMN `verified_at` datetime;
+
+CREATE TABLE IF NOT EXISTS `user_tokens` (
+    `id` text,
+    `user_id` text,
+    `purpose` varchar(32) NOT NULL,
+    `token_hash` text NOT NULL,
+    `expires_at` datetime NOT NULL,
+    `used_at` datetime,
+    `created_at` datetime,
+    PRIMARY KEY (`id`),
+    CONSTRAINT `fk_user_entities_tokens` FOREIGN KEY (`user_id`) REFERENCES `user_entities` (`id`) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_user_tokens_token_hash` ON `user_tokens` (`token_hash`);
+CREATE INDEX IF NOT EXISTS `idx_user_tokens_user_id` ON `user_tokens` (`user_id`);
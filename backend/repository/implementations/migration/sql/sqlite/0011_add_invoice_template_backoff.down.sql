@@ -0,0 +1,2 @@
+ALTER TABLE `invoice_templates` DROP COLUMN `next_attempt_at`;
+ALTER TABLE `invoice_templates` DROP COLUMN `failure_count`;
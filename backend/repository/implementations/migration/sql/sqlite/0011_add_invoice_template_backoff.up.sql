@@ -0,0 +1,5 @@
+-- Consecutive failed runs of an invoice template and when the scheduler may
+-- retry it, so failing templates back off instead of filling every batch
+
+ALTER TABLE `invoice_templates` ADD COLUMN `failure_count` integer DEFAULT 0;
+ALTER TABLE `invoice_templates` ADD COLUMN `next_attempt_at` datetime;
@@ -0,0 +1,70 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Up applies every pending migration in version order and returns those
+// applied. Each runs in a transaction with its schema_migrations row, and
+// the first to fail stops the rest.
+func (m *GORMMigrator) Up(ctx context.Context) ([]Migration, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if err := m.db.WithContext(ctx).Exec(createSchemaMigrations).Error; err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range pending {
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := run(tx, migration.up); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigrationModel{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("failed to apply migration %s: %w", migration, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Pending returns the migrations not applied yet in version order
+func (m *GORMMigrator) Pending(ctx context.Context) ([]Migration, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
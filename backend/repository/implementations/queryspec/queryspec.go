@@ -0,0 +1,176 @@
+// Package queryspec translates a query.Spec to GORM clauses for the
+// repositories that accept one
+package queryspec
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Columns maps the fields of a spec to the columns that store them
+type Columns map[string]string
+
+// Where narrows db by filters. A field without a column is an error, so a
+// field whitelisted for parsing but not stored is never silently ignored.
+func Where(db *gorm.DB, filters []query.Filter, columns Columns) (*gorm.DB, error) {
+	for _, filter := range filters {
+		name, ok := columns[filter.Field]
+		if !ok {
+			return nil, fmt.Errorf("cannot filter by %q", filter.Field)
+		}
+		column := clause.Column{Name: name}
+
+		var expr clause.Expression
+		switch filter.Op {
+		case query.OpEq:
+			expr = clause.Eq{Column: column, Value: filter.Value}
+		case query.OpIn:
+			values, ok := filter.Value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("filter %s in takes a list", filter.Field)
+			}
+			expr = clause.IN{Column: column, Values: values}
+		case query.OpGt:
+			expr = clause.Gt{Column: column, Value: filter.Value}
+		case query.OpGte:
+			expr = clause.Gte{Column: column, Value: filter.Value}
+		case query.OpLt:
+			expr = clause.Lt{Column: column, Value: filter.Value}
+		case query.OpLte:
+			expr = clause.Lte{Column: column, Value: filter.Value}
+		default:
+			return nil, fmt.Errorf("unknown operator %q", filter.Op)
+		}
+		db = db.Where(expr)
+	}
+
+	return db, nil
+}
+
+// Order orders db by sorts, then by primary key so that rows which tie
+// keep the same order from one page to the next
+func Order(db *gorm.DB, sorts []query.Sort, columns Columns) (*gorm.DB, error) {
+	for _, s := range sorts {
+		name, ok := columns[s.Field]
+		if !ok {
+			return nil, fmt.Errorf("cannot sort by %q", s.Field)
+		}
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: name}, Desc: s.Desc})
+	}
+
+	return db.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}}), nil
+}
+
+// SortOf returns the sort of spec, or defaults when it has none
+func SortOf(spec query.Spec, defaults []query.Sort) []query.Sort {
+	if len(spec.Sort) == 0 {
+		return defaults
+	}
+	return spec.Sort
+}
+
+// Page fetches up to limit rows of db from the page cursor points at, in
+// the order of sorts and then id, and the cursors of the pages either side.
+// key returns the value of a sorted field, or of "id", of a row. Rows are
+// found by comparing sort keys rather than by offset, so a page is as fast
+// to load as the first and rows added meanwhile do not shift it. A nil
+// cursor is the first page.
+func Page[T any](db *gorm.DB, limit int, sorts []query.Sort, columns Columns, at *query.Cursor, key func(row T, field string) any) ([]T, query.Cursors, error) {
+	var cursors query.Cursors
+	var cursor query.Cursor
+	if at != nil {
+		cursor = *at
+	}
+
+	keys := append(slices.Clone(sorts), query.Sort{Field: "id"})
+	names := make([]string, len(keys))
+	for i, k := range keys {
+		name, ok := columns[k.Field]
+		if k.Field == "id" {
+			name, ok = "id", true
+		}
+		if !ok {
+			return nil, cursors, fmt.Errorf("cannot sort by %q", k.Field)
+		}
+		names[i] = name
+	}
+
+	if len(cursor.Key) > 0 {
+		if len(cursor.Key) != len(keys) {
+			return nil, cursors, fmt.Errorf("%w: cursor does not match the sort", query.ErrInvalid)
+		}
+
+		// Rows past the cursor's row: ahead on the first field, or tied on
+		// the fields before one and ahead on it
+		var past []clause.Expression
+		for i, k := range keys {
+			if cursor.Key[i].Field != k.Field {
+				return nil, cursors, fmt.Errorf("%w: cursor does not match the sort", query.ErrInvalid)
+			}
+			var conditions []clause.Expression
+			for j := 0; j < i; j++ {
+				conditions = append(conditions, clause.Eq{Column: clause.Column{Name: names[j]}, Value: cursor.Key[j].Value})
+			}
+			column := clause.Column{Name: names[i]}
+			if k.Desc != cursor.Before {
+				conditions = append(conditions, clause.Lt{Column: column, Value: cursor.Key[i].Value})
+			} else {
+				conditions = append(conditions, clause.Gt{Column: column, Value: cursor.Key[i].Value})
+			}
+			past = append(past, clause.And(conditions...))
+		}
+		if len(past) == 1 {
+			db = db.Where(past[0])
+		} else {
+			db = db.Where(clause.Or(past...))
+		}
+	}
+
+	// Paging backwards reads the rows before the cursor nearest first
+	for i, k := range keys {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: names[i]}, Desc: k.Desc != cursor.Before})
+	}
+
+	var rows []T
+	if err := db.Limit(limit + 1).Find(&rows).Error; err != nil {
+		return nil, cursors, err
+	}
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+	if cursor.Before {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, cursors, nil
+	}
+
+	position := func(row T, before bool) *query.Cursor {
+		position := &query.Cursor{Sort: cursor.Sort, Before: before}
+		for _, k := range keys {
+			position.Key = append(position.Key, query.Key{Field: k.Field, Value: key(row, k.Field)})
+		}
+		return position
+	}
+	first, last := rows[0], rows[len(rows)-1]
+	if cursor.Before {
+		if more {
+			cursors.Prev = position(first, true)
+		}
+		cursors.Next = position(last, false)
+	} else {
+		if more {
+			cursors.Next = position(last, false)
+		}
+		if len(cursor.Key) > 0 {
+			cursors.Prev = position(first, true)
+		}
+	}
+
+	return rows, cursors, nil
+}
@@ -0,0 +1,21 @@
+package refreshtoken
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMRefreshTokenRepository is a GORM implementation of RefreshTokenRepository
+type GORMRefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// RefreshTokenModel represents the refresh_tokens table schema
+type RefreshTokenModel = entity.RefreshTokenEntity
+
+// NewGORMRefreshTokenRepository creates a new GORM refresh token repository
+func NewGORMRefreshTokenRepository(db *gorm.DB) (*GORMRefreshTokenRepository, error) {
+	return &GORMRefreshTokenRepository{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package refreshtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// RevokeAllForUser revokes every active token that belongs to the given user
+func (r *GORMRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Model(&entity.RefreshTokenEntity{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return dberror.Translate(r.db, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package refreshtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// RevokeFamily revokes every active token that belongs to the given family
+func (r *GORMRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	return unitofwork.DB(ctx, r.db).Model(&entity.RefreshTokenEntity{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
@@ -0,0 +1,54 @@
+package refreshtoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// Rotate atomically revokes the current token and stores its replacement.
+// The revoke only succeeds while the current token is still active, so two
+// concurrent refreshes with the same token cannot both rotate it.
+func (r *GORMRefreshTokenRepository) Rotate(ctx context.Context, currentID uuid.UUID, next entity.RefreshTokenEntity) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	default:
+	}
+
+	if next.ID == uuid.Nil {
+		next.ID = uuid.New()
+	}
+
+	rotated := false
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.RefreshTokenEntity{}).
+			Where("id = ? AND revoked_at IS NULL", currentID).
+			Updates(map[string]interface{}{
+				"revoked_at":     time.Now(),
+				"replaced_by_id": next.ID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Create(&next).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, dberror.Translate(r.db, err)
+	}
+
+	return rotated, nil
+}
@@ -5,15 +5,18 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
-// Delete soft deletes a tag by ID
-func (r *GORMTagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+// Delete soft deletes a tag by ID within the owner's scope
+func (r *GORMTagRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
-	return r.db.WithContext(ctx).Delete(&entity.TagEntity{}, id).Error
+	return unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Delete(&entity.TagEntity{}).Error
 }
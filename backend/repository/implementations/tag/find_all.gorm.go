@@ -3,11 +3,26 @@ package tag
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/queryspec"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindAll finds all tags with pagination and search
-func (r *GORMTagRepository) FindAll(ctx context.Context, page, limit int, search string) ([]entity.TagEntity, int64, error) {
+// queryColumns maps the fields tags are filtered and sorted by to their columns
+var queryColumns = queryspec.Columns{
+	"name":       "name",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// defaultSort lists tags in the order they were created
+var defaultSort = []query.Sort{{Field: "created_at"}}
+
+// FindAll finds the owner's tags matching spec with pagination
+func (r *GORMTagRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.TagEntity, int64, error) {
 	select {
 	case <-ctx.Done():
 		return nil, 0, ctx.Err()
@@ -17,23 +32,40 @@ func (r *GORMTagRepository) FindAll(ctx context.Context, page, limit int, search
 	var tags []entity.TagEntity
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.TagEntity{})
-
-	// Apply search filter
-	if search != "" {
-		query = query.Where("name LIKE ?", "%"+search+"%")
+	db, err := r.matching(ctx, ownerID, spec)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// Get total count
-	if err := query.Count(&total).Error; err != nil {
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	db, err = queryspec.Order(db, queryspec.SortOf(spec, defaultSort), queryColumns)
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// Apply pagination
 	offset := (page - 1) * limit
-	if err := query.Offset(offset).Limit(limit).Find(&tags).Error; err != nil {
+	if err := db.Offset(offset).Limit(limit).Find(&tags).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return tags, total, nil
 }
+
+// matching scopes a query to the owner's tags matching the search and
+// filters of spec
+func (r *GORMTagRepository) matching(ctx context.Context, ownerID uuid.UUID, spec query.Spec) (*gorm.DB, error) {
+	db := unitofwork.DB(ctx, r.db).Model(&entity.TagEntity{}).
+		Where("owner_id = ?", ownerID)
+
+	// Apply search filter
+	if spec.Search != "" {
+		db = db.Where("name LIKE ?", "%"+spec.Search+"%")
+	}
+
+	return queryspec.Where(db, spec.Filters, queryColumns)
+}
@@ -0,0 +1,41 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/queryspec"
+)
+
+// FindByCursor finds up to limit of the owner's tags matching spec from
+// the page spec.Cursor points at
+func (r *GORMTagRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.TagEntity, query.Cursors, error) {
+	select {
+	case <-ctx.Done():
+		return nil, query.Cursors{}, ctx.Err()
+	default:
+	}
+
+	db, err := r.matching(ctx, ownerID, spec)
+	if err != nil {
+		return nil, query.Cursors{}, err
+	}
+
+	return queryspec.Page(db, limit, queryspec.SortOf(spec, defaultSort), queryColumns, spec.Cursor, tagKey)
+}
+
+// tagKey returns the value of a field tags are sorted by
+func tagKey(tag entity.TagEntity, field string) any {
+	switch field {
+	case "name":
+		return tag.Name
+	case "created_at":
+		return tag.CreatedAt
+	case "updated_at":
+		return tag.UpdatedAt
+	default:
+		return tag.ID
+	}
+}
@@ -5,10 +5,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindByID finds a tag by ID
-func (r *GORMTagRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TagEntity, error) {
+// FindByID finds a tag by ID within the owner's scope.
+// It returns nil when no matching tag exists.
+func (r *GORMTagRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TagEntity, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
@@ -16,7 +19,12 @@ func (r *GORMTagRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 	}
 
 	var tag entity.TagEntity
-	if err := r.db.WithContext(ctx).First(&tag, id).Error; err != nil {
+	if err := unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&tag).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -15,11 +15,6 @@ type TagModel = entity.TagEntity
 
 // NewGORMTagRepository creates a new GORM tag repository
 func NewGORMTagRepository(db *gorm.DB) (*GORMTagRepository, error) {
-	// Auto-migrate the schema
-	if err := db.AutoMigrate(&TagModel{}); err != nil {
-		return nil, err
-	}
-
 	return &GORMTagRepository{
 		db: db,
 	}, nil
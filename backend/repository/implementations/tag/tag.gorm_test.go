@@ -0,0 +1,180 @@
+package tag
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMTagRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMTagRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	id, err := repo.Create(ctx, entity.TagEntity{ID: uuid.New(), OwnerID: ownerA, Name: "Urgent", ColorHex: "#ff0000"})
+	if err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	t.Run("should find the tag for its owner", func(t *testing.T) {
+		tag, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || tag == nil {
+			t.Fatalf("expected tag, got %v (err %v)", tag, err)
+		}
+	})
+
+	t.Run("should hide the tag from another user", func(t *testing.T) {
+		tag, err := repo.FindByID(ctx, ownerB, *id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tag != nil {
+			t.Errorf("expected nil tag, got %+v", tag)
+		}
+
+		tags, total, err := repo.FindAll(ctx, ownerB, 1, 10, query.Spec{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 0 || len(tags) != 0 {
+			t.Errorf("expected no tags, got %d (total %d)", len(tags), total)
+		}
+	})
+
+	t.Run("should not update or delete another user's tag", func(t *testing.T) {
+		if err := repo.Update(ctx, ownerB, *id, entity.TagEntity{Name: "Hijacked", ColorHex: "#000000"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.Delete(ctx, ownerB, *id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		tag, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || tag == nil {
+			t.Fatalf("expected tag to survive, got %v (err %v)", tag, err)
+		}
+		if tag.Name != "Urgent" {
+			t.Errorf("expected name Urgent, got %s", tag.Name)
+		}
+	})
+}
+
+func TestFindByCursor(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerID := uuid.New()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Two tags share a creation time, so pages must fall back to the id
+	// to split them
+	var expected []uuid.UUID
+	created := map[uuid.UUID]int{}
+	for _, offset := range []int{3, 0, 1, 1, 2} {
+		id := uuid.New()
+		if _, err := repo.Create(ctx, entity.TagEntity{ID: id, OwnerID: ownerID, Name: id.String(), CreatedAt: start.Add(time.Duration(offset) * time.Minute)}); err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+		expected = append(expected, id)
+		created[id] = offset
+	}
+	slices.SortFunc(expected, func(a, b uuid.UUID) int {
+		if created[a] != created[b] {
+			return created[a] - created[b]
+		}
+		return strings.Compare(a.String(), b.String())
+	})
+	// Another owner's tag never shows up
+	if _, err := repo.Create(ctx, entity.TagEntity{ID: uuid.New(), OwnerID: uuid.New(), Name: "other", CreatedAt: start}); err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	ids := func(rows []entity.TagEntity) []uuid.UUID {
+		var ids []uuid.UUID
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		return ids
+	}
+
+	// Without a sort, pages follow the default sort by creation time
+	var pages [][]uuid.UUID
+	var last query.Cursors
+	spec := query.Spec{Cursor: &query.Cursor{}}
+	for {
+		rows, cursors, err := repo.FindByCursor(ctx, ownerID, 2, spec)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pages = append(pages, ids(rows))
+		last = cursors
+		if cursors.Next == nil {
+			break
+		}
+		spec.Cursor = cursors.Next
+	}
+
+	t.Run("should page forwards through every tag once", func(t *testing.T) {
+		want := [][]uuid.UUID{expected[0:2], expected[2:4], expected[4:5]}
+		if !reflect.DeepEqual(pages, want) {
+			t.Errorf("expected pages %v, got %v", want, pages)
+		}
+	})
+
+	t.Run("should page backwards to the first page", func(t *testing.T) {
+		var backwards [][]uuid.UUID
+		cursor := last.Prev
+		for cursor != nil {
+			rows, cursors, err := repo.FindByCursor(ctx, ownerID, 2, query.Spec{Cursor: cursor})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			backwards = append([][]uuid.UUID{ids(rows)}, backwards...)
+			if cursors.Next == nil {
+				t.Errorf("expected a cursor to the next page")
+			}
+			cursor = cursors.Prev
+		}
+		if !reflect.DeepEqual(backwards, pages[:len(pages)-1]) {
+			t.Errorf("expected pages %v, got %v", pages[:len(pages)-1], backwards)
+		}
+	})
+}
@@ -5,20 +5,23 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
-// Update updates a tag by ID
-func (r *GORMTagRepository) Update(ctx context.Context, id uuid.UUID, tag entity.TagEntity) error {
+// Update updates a tag by ID within the owner's scope
+func (r *GORMTagRepository) Update(ctx context.Context, ownerID, id uuid.UUID, tag entity.TagEntity) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
 	}
 
-	return r.db.WithContext(ctx).Model(&entity.TagEntity{}).
-		Where("id = ?", id).
+	err := unitofwork.DB(ctx, r.db).Model(&entity.TagEntity{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
 		Updates(map[string]interface{}{
 			"name":      tag.Name,
 			"color_hex": tag.ColorHex,
 		}).Error
+	return dberror.Translate(r.db, err)
 }
@@ -0,0 +1,25 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Create creates a new tax rate in GORM
+func (r *GORMTaxRateRepository) Create(ctx context.Context, taxRate entity.TaxRateEntity) (*uuid.UUID, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Create(&taxRate).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &taxRate.ID, nil
+}
@@ -0,0 +1,42 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindAll finds the owner's tax rates with pagination and search
+func (r *GORMTaxRateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) ([]entity.TaxRateEntity, int64, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	default:
+	}
+
+	var taxRates []entity.TaxRateEntity
+	var total int64
+
+	query := unitofwork.DB(ctx, r.db).Model(&entity.TaxRateEntity{}).
+		Where("owner_id = ?", ownerID)
+
+	// Apply search filter
+	if search != "" {
+		query = query.Where("name LIKE ?", "%"+search+"%")
+	}
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := query.Offset(offset).Limit(limit).Find(&taxRates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return taxRates, total, nil
+}
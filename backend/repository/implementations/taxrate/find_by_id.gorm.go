@@ -0,0 +1,32 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// FindByID finds a tax rate by ID within the owner's scope.
+// It returns nil when no matching tax rate exists.
+func (r *GORMTaxRateRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TaxRateEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var taxRate entity.TaxRateEntity
+	if err := unitofwork.DB(ctx, r.db).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		First(&taxRate).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &taxRate, nil
+}
@@ -0,0 +1,21 @@
+package taxrate
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMTaxRateRepository is a GORM implementation of TaxRateRepository
+type GORMTaxRateRepository struct {
+	db *gorm.DB
+}
+
+// TaxRateModel represents the tax_rates table schema
+type TaxRateModel = entity.TaxRateEntity
+
+// NewGORMTaxRateRepository creates a new GORM tax rate repository
+func NewGORMTaxRateRepository(db *gorm.DB) (*GORMTaxRateRepository, error) {
+	return &GORMTaxRateRepository{
+		db: db,
+	}, nil
+}
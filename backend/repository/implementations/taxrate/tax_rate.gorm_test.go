@@ -0,0 +1,94 @@
+package taxrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMTaxRateRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMTaxRateRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestOwnerIsolation(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	ownerA := uuid.New()
+	ownerB := uuid.New()
+
+	id, err := repo.Create(ctx, entity.TaxRateEntity{ID: uuid.New(), OwnerID: ownerA, Name: "VAT", Rate: 2000})
+	if err != nil {
+		t.Fatalf("failed to create tax rate: %v", err)
+	}
+
+	t.Run("should find the tax rate for its owner", func(t *testing.T) {
+		taxRate, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || taxRate == nil {
+			t.Fatalf("expected tax rate, got %v (err %v)", taxRate, err)
+		}
+	})
+
+	t.Run("should hide the tax rate from another user", func(t *testing.T) {
+		taxRate, err := repo.FindByID(ctx, ownerB, *id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if taxRate != nil {
+			t.Errorf("expected nil tax rate, got %+v", taxRate)
+		}
+
+		taxRates, total, err := repo.FindAll(ctx, ownerB, 1, 10, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 0 || len(taxRates) != 0 {
+			t.Errorf("expected no tax rates, got %d (total %d)", len(taxRates), total)
+		}
+	})
+
+	t.Run("should not update or delete another user's tax rate", func(t *testing.T) {
+		if err := repo.Update(ctx, ownerB, *id, entity.TaxRateEntity{Name: "Hijacked", Rate: 0}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := repo.Delete(ctx, ownerB, *id); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		taxRate, err := repo.FindByID(ctx, ownerA, *id)
+		if err != nil || taxRate == nil {
+			t.Fatalf("expected tax rate to survive, got %v (err %v)", taxRate, err)
+		}
+		if taxRate.Rate != 2000 {
+			t.Errorf("expected rate 2000, got %d", taxRate.Rate)
+		}
+	})
+}
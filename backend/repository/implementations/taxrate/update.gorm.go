@@ -0,0 +1,27 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Update updates a tax rate by ID within the owner's scope
+func (r *GORMTaxRateRepository) Update(ctx context.Context, ownerID, id uuid.UUID, taxRate entity.TaxRateEntity) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Model(&entity.TaxRateEntity{}).
+		Where("id = ? AND owner_id = ?", id, ownerID).
+		Updates(map[string]interface{}{
+			"name": taxRate.Name,
+			"rate": taxRate.Rate,
+		}).Error
+	return dberror.Translate(r.db, err)
+}
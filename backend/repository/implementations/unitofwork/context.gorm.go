@@ -0,0 +1,20 @@
+package unitofwork
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key holding the active transaction
+type txKey struct{}
+
+// DB returns the transaction carried by ctx, or db bound to ctx when no unit
+// of work is active. GORM repositories use it for every query so they take
+// part in a surrounding unit of work.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
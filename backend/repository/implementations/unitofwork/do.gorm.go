@@ -0,0 +1,22 @@
+package unitofwork
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Do runs fn inside a database transaction. The context passed to fn carries
+// the transaction, so GORM repositories called with it join the transaction.
+// A nested Do runs in a savepoint of the outer transaction.
+func (u *GORMUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	return DB(ctx, u.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
@@ -0,0 +1,17 @@
+package unitofwork
+
+import (
+	"gorm.io/gorm"
+)
+
+// GORMUnitOfWork is a GORM implementation of UnitOfWork
+type GORMUnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewGORMUnitOfWork creates a new GORM unit of work
+func NewGORMUnitOfWork(db *gorm.DB) (*GORMUnitOfWork, error) {
+	return &GORMUnitOfWork{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package unitofwork
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+func newTestUnitOfWork(t *testing.T) (*GORMUnitOfWork, *gorm.DB) {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := db.AutoMigrate(&entity.TagEntity{}); err != nil {
+		t.Fatalf("failed to migrate tables: %v", err)
+	}
+	unitOfWork, err := NewGORMUnitOfWork(db)
+	if err != nil {
+		t.Fatalf("failed to create unit of work: %v", err)
+	}
+	return unitOfWork, db
+}
+
+func createTag(ctx context.Context, db *gorm.DB, name string) error {
+	return DB(ctx, db).Create(&entity.TagEntity{
+		ID:      uuid.New(),
+		OwnerID: uuid.New(),
+		Name:    name,
+	}).Error
+}
+
+func countTags(t *testing.T, db *gorm.DB) int64 {
+	t.Helper()
+
+	var count int64
+	if err := db.Model(&entity.TagEntity{}).Count(&count).Error; err != nil {
+		t.Fatalf("failed to count tags: %v", err)
+	}
+	return count
+}
+
+func TestDo(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context, db *gorm.DB) error
+		wantErr   bool
+		wantCount int64
+	}{
+		{
+			name: "should commit all writes when fn succeeds",
+			fn: func(ctx context.Context, db *gorm.DB) error {
+				if err := createTag(ctx, db, "first"); err != nil {
+					return err
+				}
+				return createTag(ctx, db, "second")
+			},
+			wantErr:   false,
+			wantCount: 2,
+		},
+		{
+			name: "should roll back all writes when fn fails",
+			fn: func(ctx context.Context, db *gorm.DB) error {
+				if err := createTag(ctx, db, "first"); err != nil {
+					return err
+				}
+				return errors.New("boom")
+			},
+			wantErr:   true,
+			wantCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unitOfWork, db := newTestUnitOfWork(t)
+
+			err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+				return tt.fn(ctx, db)
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := countTags(t, db); got != tt.wantCount {
+				t.Errorf("tag count = %d, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestDoRejectsCancelledContext(t *testing.T) {
+	unitOfWork, _ := newTestUnitOfWork(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want context.Canceled", err)
+	}
+	if called {
+		t.Error("fn should not run with a cancelled context")
+	}
+}
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// CountByRole counts the users granted a role in GORM
+func (r *GORMUserRepository) CountByRole(ctx context.Context, role entity.Role) (int64, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	default:
+	}
+
+	var count int64
+	if err := unitofwork.DB(ctx, r.db).Model(&UserModel{}).
+		Joins("JOIN user_roles ON user_roles.user_id = user_entities.id").
+		Where("user_roles.role = ?", role).
+		Count(&count).Error; err != nil {
+		return 0, dberror.Translate(r.db, err)
+	}
+
+	return count, nil
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // Create creates a new user in GORM
@@ -15,8 +17,8 @@ func (r *GORMUserRepository) Create(ctx context.Context, user entity.UserEntity)
 	default:
 	}
 
-	if err := r.db.WithContext(ctx).Create(&user).Error; err != nil {
-		return nil, err
+	if err := unitofwork.DB(ctx, r.db).Create(&user).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
 	}
 
 	return &user.ID, nil
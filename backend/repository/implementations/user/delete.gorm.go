@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // Delete deletes a user in GORM
@@ -14,7 +15,7 @@ func (r *GORMUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	default:
 	}
 
-	if err := r.db.WithContext(ctx).Delete(&UserModel{}, id).Error; err != nil {
+	if err := unitofwork.DB(ctx, r.db).Delete(&UserModel{}, id).Error; err != nil {
 		return err
 	}
 
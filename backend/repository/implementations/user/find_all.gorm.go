@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// FindAll finds users and their roles with pagination, oldest first
+func (r *GORMUserRepository) FindAll(ctx context.Context, page, limit int) ([]entity.UserEntity, int64, error) {
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	default:
+	}
+
+	var users []entity.UserEntity
+	var total int64
+
+	query := unitofwork.DB(ctx, r.db).Model(&UserModel{})
+
+	// Get total count
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, dberror.Translate(r.db, err)
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	if err := query.Preload("Roles").Order("created_at, id").Offset(offset).Limit(limit).Find(&users).Error; err != nil {
+		return nil, 0, dberror.Translate(r.db, err)
+	}
+
+	return users, total, nil
+}
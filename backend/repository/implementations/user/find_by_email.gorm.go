@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 	"gorm.io/gorm"
 )
 
@@ -16,7 +17,7 @@ func (r *GORMUserRepository) FindByEmail(ctx context.Context, email string) (*en
 	}
 
 	var user entity.UserEntity
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+	if err := unitofwork.DB(ctx, r.db).Preload("Roles").Where("email = ?", email).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
@@ -2,13 +2,17 @@ package user
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
 )
 
-// FindByID finds a user by ID in GORM
+// FindByID finds a user by ID in GORM.
+// It returns nil when no matching user exists.
 func (r *GORMUserRepository) FindByID(ctx context.Context, id uuid.UUID) (user *entity.UserEntity, err error) {
 	select {
 	case <-ctx.Done():
@@ -16,8 +20,11 @@ func (r *GORMUserRepository) FindByID(ctx context.Context, id uuid.UUID) (user *
 	default:
 	}
 
-	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
-		return nil, fmt.Errorf("user not found: %s", id)
+	if err := unitofwork.DB(ctx, r.db).Preload("Roles").First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, dberror.Translate(r.db, err)
 	}
 
 	return user, nil
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// SetRoles replaces the roles granted to a user in GORM
+func (r *GORMUserRepository) SetRoles(ctx context.Context, id uuid.UUID, roles []entity.Role) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", id).Delete(&entity.UserRoleEntity{}).Error; err != nil {
+			return err
+		}
+		if len(roles) == 0 {
+			return nil
+		}
+
+		userRoles := make([]entity.UserRoleEntity, len(roles))
+		for i, role := range roles {
+			userRoles[i] = entity.UserRoleEntity{UserID: id, Role: role}
+		}
+		return tx.Create(&userRoles).Error
+	})
+	return dberror.Translate(r.db, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
 )
 
 // Update updates a user in GORM
@@ -15,7 +16,7 @@ func (r *GORMUserRepository) Update(ctx context.Context, id uuid.UUID, user enti
 	default:
 	}
 
-	if err := r.db.WithContext(ctx).
+	if err := unitofwork.DB(ctx, r.db).
 		Model(&UserModel{}).Where("id = ?", id).
 		Updates(&user).
 		Error; err != nil {
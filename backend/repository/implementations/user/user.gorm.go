@@ -15,11 +15,6 @@ type UserModel = entity.UserEntity
 
 // NewGORMUserRepository creates a new GORM user repository
 func NewGORMUserRepository(db *gorm.DB) (*GORMUserRepository, error) {
-	// Auto-migrate the schema
-	if err := db.AutoMigrate(&UserModel{}); err != nil {
-		return nil, err
-	}
-
 	return &GORMUserRepository{
 		db: db,
 	}, nil
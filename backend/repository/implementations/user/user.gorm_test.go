@@ -0,0 +1,117 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMUserRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMUserRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestRoles(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+
+	adminID := uuid.New()
+	if _, err := repo.Create(ctx, entity.UserEntity{
+		ID:    adminID,
+		Email: "admin@example.com",
+		Roles: []entity.UserRoleEntity{{UserID: adminID, Role: entity.RoleAdmin}},
+	}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	userID := uuid.New()
+	if _, err := repo.Create(ctx, entity.UserEntity{
+		ID:    userID,
+		Email: "user@example.com",
+		Roles: []entity.UserRoleEntity{{UserID: userID, Role: entity.RoleAccountant}},
+	}); err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	t.Run("should load roles with the user", func(t *testing.T) {
+		user, err := repo.FindByEmail(ctx, "admin@example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(user.Roles) != 1 || user.Roles[0].Role != entity.RoleAdmin {
+			t.Errorf("expected the admin role, got %v", user.Roles)
+		}
+	})
+
+	t.Run("should replace roles", func(t *testing.T) {
+		if err := repo.SetRoles(ctx, userID, []entity.Role{entity.RoleAdmin, entity.RoleViewer}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		user, err := repo.FindByID(ctx, userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(user.Roles) != 2 {
+			t.Errorf("expected 2 roles, got %v", user.Roles)
+		}
+	})
+
+	t.Run("should count the users granted a role", func(t *testing.T) {
+		count, err := repo.CountByRole(ctx, entity.RoleAdmin)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count != 2 {
+			t.Errorf("expected 2 admins, got %d", count)
+		}
+
+		// Deleted users no longer hold their roles
+		if err := repo.Delete(ctx, adminID); err != nil {
+			t.Fatalf("failed to delete user: %v", err)
+		}
+		count, err = repo.CountByRole(ctx, entity.RoleAdmin)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 admin, got %d", count)
+		}
+	})
+
+	t.Run("should list users with their roles", func(t *testing.T) {
+		users, total, err := repo.FindAll(ctx, 1, 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 1 || len(users) != 1 || users[0].ID != userID || len(users[0].Roles) != 2 {
+			t.Errorf("expected the remaining user and their roles, got %d %v", total, users)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package usertoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+	"gorm.io/gorm"
+)
+
+// Consume atomically marks a token as used and returns it, or nil when it
+// is unknown, expired, already used or for another purpose. The update
+// only matches an unused token, so two concurrent requests with the same
+// token cannot both redeem it.
+func (r *GORMUserTokenRepository) Consume(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, at time.Time) (*entity.UserTokenEntity, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var token *entity.UserTokenEntity
+	err := unitofwork.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&UserTokenModel{}).
+			Where("purpose = ? AND token_hash = ? AND used_at IS NULL AND expires_at > ?", purpose, tokenHash, at).
+			Update("used_at", at)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		var consumed entity.UserTokenEntity
+		if err := tx.Where("token_hash = ?", tokenHash).First(&consumed).Error; err != nil {
+			return err
+		}
+		token = &consumed
+		return nil
+	})
+	if err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return token, nil
+}
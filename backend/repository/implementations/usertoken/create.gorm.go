@@ -0,0 +1,28 @@
+package usertoken
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// Create stores a new user token in GORM
+func (r *GORMUserTokenRepository) Create(ctx context.Context, token entity.UserTokenEntity) (*uuid.UUID, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	if err := unitofwork.DB(ctx, r.db).Create(&token).Error; err != nil {
+		return nil, dberror.Translate(r.db, err)
+	}
+
+	return &token.ID, nil
+}
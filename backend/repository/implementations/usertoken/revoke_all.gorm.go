@@ -0,0 +1,29 @@
+package usertoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/dberror"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/unitofwork"
+)
+
+// RevokeAll marks every unused token of the user with the given purpose as
+// used, so only the newest one emailed can be redeemed
+func (r *GORMUserTokenRepository) RevokeAll(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose, at time.Time) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if err := unitofwork.DB(ctx, r.db).Model(&UserTokenModel{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", at).Error; err != nil {
+		return dberror.Translate(r.db, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package usertoken
+
+import (
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"gorm.io/gorm"
+)
+
+// GORMUserTokenRepository is a GORM implementation of UserTokenRepository
+type GORMUserTokenRepository struct {
+	db *gorm.DB
+}
+
+// UserTokenModel represents the user_tokens table schema
+type UserTokenModel = entity.UserTokenEntity
+
+// NewGORMUserTokenRepository creates a new GORM user token repository
+func NewGORMUserTokenRepository(db *gorm.DB) (*GORMUserTokenRepository, error) {
+	return &GORMUserTokenRepository{
+		db: db,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package usertoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+	"gorm.io/gorm"
+)
+
+func newTestRepository(t *testing.T) *GORMUserTokenRepository {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := migration.NewGORMMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	repo, err := NewGORMUserTokenRepository(db)
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	return repo
+}
+
+func TestUserTokens(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	userID := uuid.New()
+	if err := repo.db.Create(&entity.UserEntity{ID: userID, Email: "alice@example.com"}).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	create := func(t *testing.T, purpose entity.TokenPurpose, hash string, expiresAt time.Time) {
+		t.Helper()
+		_, err := repo.Create(ctx, entity.UserTokenEntity{UserID: userID, Purpose: purpose, TokenHash: hash, ExpiresAt: expiresAt, CreatedAt: now})
+		if err != nil {
+			t.Fatalf("failed to create token: %v", err)
+		}
+	}
+
+	t.Run("should consume a token once", func(t *testing.T) {
+		create(t, entity.TokenPurposePasswordReset, "reset", now.Add(time.Hour))
+
+		token, err := repo.Consume(ctx, entity.TokenPurposePasswordReset, "reset", now)
+		if err != nil || token == nil {
+			t.Fatalf("expected the token, got %v (err %v)", token, err)
+		}
+		if token.UserID != userID || token.UsedAt == nil || !token.UsedAt.Equal(now) {
+			t.Errorf("expected the user's token used at %v, got %+v", now, token)
+		}
+
+		token, err = repo.Consume(ctx, entity.TokenPurposePasswordReset, "reset", now)
+		if err != nil || token != nil {
+			t.Errorf("expected nil token on reuse, got %v (err %v)", token, err)
+		}
+	})
+
+	t.Run("should not consume an expired token", func(t *testing.T) {
+		create(t, entity.TokenPurposePasswordReset, "expired", now)
+
+		token, err := repo.Consume(ctx, entity.TokenPurposePasswordReset, "expired", now)
+		if err != nil || token != nil {
+			t.Errorf("expected nil token, got %v (err %v)", token, err)
+		}
+	})
+
+	t.Run("should not consume a token for another purpose", func(t *testing.T) {
+		create(t, entity.TokenPurposeEmailVerification, "verify", now.Add(time.Hour))
+
+		token, err := repo.Consume(ctx, entity.TokenPurposePasswordReset, "verify", now)
+		if err != nil || token != nil {
+			t.Errorf("expected nil token, got %v (err %v)", token, err)
+		}
+	})
+
+	t.Run("should not consume an unknown token", func(t *testing.T) {
+		token, err := repo.Consume(ctx, entity.TokenPurposePasswordReset, "unknown", now)
+		if err != nil || token != nil {
+			t.Errorf("expected nil token, got %v (err %v)", token, err)
+		}
+	})
+
+	t.Run("should revoke only the user's tokens with the purpose", func(t *testing.T) {
+		create(t, entity.TokenPurposePasswordReset, "old-reset", now.Add(time.Hour))
+
+		if err := repo.RevokeAll(ctx, userID, entity.TokenPurposePasswordReset, now); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		token, err := repo.Consume(ctx, entity.TokenPurposePasswordReset, "old-reset", now)
+		if err != nil || token != nil {
+			t.Errorf("expected the reset token revoked, got %v (err %v)", token, err)
+		}
+		token, err = repo.Consume(ctx, entity.TokenPurposeEmailVerification, "verify", now)
+		if err != nil || token == nil {
+			t.Errorf("expected the verification token kept, got %v (err %v)", token, err)
+		}
+	})
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// APIKeyRepository defines the interface for personal API key data access
+type APIKeyRepository interface {
+	Create(ctx context.Context, key entity.APIKeyEntity) (*uuid.UUID, error)
+	FindByKeyHash(ctx context.Context, keyHash string) (*entity.APIKeyEntity, error)
+	FindAll(ctx context.Context, userID uuid.UUID) ([]entity.APIKeyEntity, error)
+	// Revoke reports false when the user has no such unrevoked key
+	Revoke(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	// Touch records when a key was last used
+	Touch(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// CustomerRepository defines the interface for customer data access.
+type CustomerRepository interface {
+	Create(ctx context.Context, customer entity.CustomerEntity) (*uuid.UUID, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.CustomerEntity, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, customer entity.CustomerEntity) error
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	// FindAll searches the name and email of the owner's customers
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) ([]entity.CustomerEntity, int64, error)
+}
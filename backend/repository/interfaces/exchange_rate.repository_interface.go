@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// ExchangeRateFilter narrows the exchange rates returned by FindAll
+type ExchangeRateFilter struct {
+	BaseCurrency  string
+	QuoteCurrency string
+}
+
+// ExchangeRateRepository defines the interface for exchange rate data access.
+type ExchangeRateRepository interface {
+	// Upsert stores rates, replacing any with the same pair and effective date
+	Upsert(ctx context.Context, rates []entity.ExchangeRateEntity) error
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ExchangeRateEntity, error)
+	// FindLatest returns the newest rate for a pair effective at the given time
+	FindLatest(ctx context.Context, ownerID uuid.UUID, base, quote string, at time.Time) (*entity.ExchangeRateEntity, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, filter ExchangeRateFilter) ([]entity.ExchangeRateEntity, int64, error)
+}
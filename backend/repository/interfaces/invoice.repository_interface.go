@@ -5,15 +5,60 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 )
 
-// InvoiceRepository defines the interface for invoice data access
+// InvoiceFilter narrows the invoices totalled by Summarize
+type InvoiceFilter struct {
+	Status   entity.InvoiceStatus
+	Statuses []entity.InvoiceStatus // any of these, when set
+}
+
+// InvoiceTotals sums a group of invoices that share a currency and, once
+// issued, the same exchange rate snapshot
+type InvoiceTotals struct {
+	Currency           string
+	BaseCurrency       *string
+	ExchangeRate       *string
+	Count              int64
+	GrandPrice         int64
+	AmountPaid         int64
+	OutstandingBalance int64
+}
+
+// InvoiceRepository defines the interface for invoice data access.
 type InvoiceRepository interface {
 	Create(ctx context.Context, invoice entity.InvoiceEntity) (*uuid.UUID, error)
-	FindByID(ctx context.Context, id uuid.UUID) (*entity.InvoiceEntity, error)
-	Update(ctx context.Context, id uuid.UUID, invoice entity.InvoiceEntity) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int, search string) ([]entity.InvoiceEntity, int64, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error)
+	// LockByID loads an invoice without relations and locks it for the rest
+	// of the surrounding unit of work
+	LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, invoice entity.InvoiceEntity) error
+	// UpdateStatus writes the lifecycle fields of invoice only if the stored
+	// status is still from. It returns false when another request won the race.
+	UpdateStatus(ctx context.Context, ownerID, id uuid.UUID, from entity.InvoiceStatus, invoice entity.InvoiceEntity) (bool, error)
+	// Issue moves a draft invoice to issued, storing the IssuedAt, exchange
+	// rate and BillTo snapshot fields of issued, and assigns it a number built
+	// by formatNumber from the owner's next gap-free sequence value. It returns
+	// false when the invoice is no longer a draft.
+	Issue(ctx context.Context, ownerID, id uuid.UUID, issued entity.InvoiceEntity, formatNumber func(seq int64) string) (bool, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.InvoiceEntity, int64, error)
+	// FindByCursor finds up to limit invoices from the page spec.Cursor points
+	// at, without counting them
+	FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.InvoiceEntity, query.Cursors, error)
+	// Summarize totals the owner's invoices matching filter, grouped by
+	// currency and exchange rate snapshot
+	Summarize(ctx context.Context, ownerID uuid.UUID, filter InvoiceFilter) ([]InvoiceTotals, error)
+	CreateInvoiceItems(ctx context.Context, items []entity.InvoiceItemEntity) error
 	DeleteInvoiceItems(ctx context.Context, invoiceID uuid.UUID) error
+	AddInvoiceTags(ctx context.Context, invoiceID uuid.UUID, tags []entity.TagEntity) error
 	DeleteInvoiceTags(ctx context.Context, invoiceID uuid.UUID) error
+	CreatePayment(ctx context.Context, payment entity.PaymentEntity) error
+	// SumPayments returns the invoice's payments minus its refunds
+	SumPayments(ctx context.Context, invoiceID uuid.UUID) (int64, error)
+	// CreateCreditNote stores note with its lines, numbered by formatNumber
+	// from the owner's next credit note sequence value, and adds its total to
+	// the invoice's CreditedTotal
+	CreateCreditNote(ctx context.Context, note entity.CreditNoteEntity, formatNumber func(seq int64) string) error
 }
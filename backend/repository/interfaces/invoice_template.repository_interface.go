@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// InvoiceTemplateRepository defines the interface for recurring invoice
+// template data access. Reads and writes are scoped to the owning user,
+// except for FindDue and the run bookkeeping used by the scheduler.
+type InvoiceTemplateRepository interface {
+	Create(ctx context.Context, template entity.InvoiceTemplateEntity) (*uuid.UUID, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceTemplateEntity, error)
+	// LockByID loads a template with its lines and tags and locks it for the
+	// rest of the surrounding unit of work
+	LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceTemplateEntity, error)
+	// Update writes the template's own fields and replaces its lines and tags
+	Update(ctx context.Context, ownerID, id uuid.UUID, template entity.InvoiceTemplateEntity) error
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int) ([]entity.InvoiceTemplateEntity, int64, error)
+	// FindDue returns up to limit active templates of any owner whose next
+	// run date is not after at and that are not backing off after a failure,
+	// earliest first, without their relations
+	FindDue(ctx context.Context, at time.Time, limit int) ([]entity.InvoiceTemplateEntity, error)
+	// RecordRun stores run and moves the template to nextRunDate, clearing
+	// its last error and failures. It returns false without changes when the template has
+	// already moved past run.RunDate.
+	RecordRun(ctx context.Context, run entity.InvoiceTemplateRunEntity, nextRunDate time.Time) (bool, error)
+	// RecordFailure stores why the template's latest run failed, counts the
+	// failure and keeps FindDue from returning it before nextAttemptAt
+	RecordFailure(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error
+}
@@ -5,13 +5,17 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 )
 
-// ItemRepository defines the interface for item data access
+// ItemRepository defines the interface for item data access.
 type ItemRepository interface {
 	Create(ctx context.Context, item entity.ItemEntity) (*uuid.UUID, error)
-	FindByID(ctx context.Context, id uuid.UUID) (*entity.ItemEntity, error)
-	Update(ctx context.Context, id uuid.UUID, item entity.ItemEntity) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int, search string) ([]entity.ItemEntity, int64, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ItemEntity, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, item entity.ItemEntity) error
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.ItemEntity, int64, error)
+	// FindByCursor finds up to limit items from the page spec.Cursor points
+	// at, without counting them
+	FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.ItemEntity, query.Cursors, error)
 }
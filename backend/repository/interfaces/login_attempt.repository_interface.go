@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// LoginAttemptRepository defines the interface for tracking failed sign-ins
+type LoginAttemptRepository interface {
+	Find(ctx context.Context, key string) (*entity.LoginAttemptEntity, error)
+	// RecordFailure counts a failed sign-in at at, first forgetting the
+	// earlier ones if the last was before resetBefore, and returns the count
+	RecordFailure(ctx context.Context, key string, at, resetBefore time.Time) (*entity.LoginAttemptEntity, error)
+	// Reset forgets every failed sign-in of key
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// RefreshTokenRepository defines the interface for refresh token data access
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token entity.RefreshTokenEntity) (*uuid.UUID, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshTokenEntity, error)
+	// Rotate revokes the current token and stores next as its replacement.
+	// It reports false when the current token had already been revoked.
+	Rotate(ctx context.Context, currentID uuid.UUID, next entity.RefreshTokenEntity) (bool, error)
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	// RevokeAllForUser revokes every active token of the user, signing
+	// them out everywhere
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
+}
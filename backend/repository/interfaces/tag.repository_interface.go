@@ -5,13 +5,17 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 )
 
-// TagRepository defines the interface for tag data access
+// TagRepository defines the interface for tag data access.
 type TagRepository interface {
 	Create(ctx context.Context, tag entity.TagEntity) (*uuid.UUID, error)
-	FindByID(ctx context.Context, id uuid.UUID) (*entity.TagEntity, error)
-	Update(ctx context.Context, id uuid.UUID, tag entity.TagEntity) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	FindAll(ctx context.Context, page, limit int, search string) ([]entity.TagEntity, int64, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TagEntity, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, tag entity.TagEntity) error
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.TagEntity, int64, error)
+	// FindByCursor finds up to limit tags from the page spec.Cursor points
+	// at, without counting them
+	FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.TagEntity, query.Cursors, error)
 }
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// TaxRateRepository defines the interface for tax rate data access.
+type TaxRateRepository interface {
+	Create(ctx context.Context, taxRate entity.TaxRateEntity) (*uuid.UUID, error)
+	FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TaxRateEntity, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, taxRate entity.TaxRateEntity) error
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) ([]entity.TaxRateEntity, int64, error)
+}
@@ -0,0 +1,13 @@
+// Package interfaces defines the data access the services depend on.
+// Methods taking an ownerID only read and write that user's records, and
+// treat other users' records as missing.
+package interfaces
+
+import "context"
+
+// UnitOfWork runs operations spanning several repositories atomically.
+// Repository calls made with the context passed to fn join the same
+// transaction; returning an error from fn rolls all of them back.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
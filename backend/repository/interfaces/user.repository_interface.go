@@ -14,4 +14,9 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*entity.UserEntity, error)
 	Update(ctx context.Context, id uuid.UUID, user entity.UserEntity) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// FindAll lists users with their roles, oldest first
+	FindAll(ctx context.Context, page, limit int) ([]entity.UserEntity, int64, error)
+	// SetRoles replaces the roles granted to a user
+	SetRoles(ctx context.Context, id uuid.UUID, roles []entity.Role) error
+	CountByRole(ctx context.Context, role entity.Role) (int64, error)
 }
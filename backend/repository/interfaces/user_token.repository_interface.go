@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// UserTokenRepository defines the interface for single-use user token data access
+type UserTokenRepository interface {
+	Create(ctx context.Context, token entity.UserTokenEntity) (*uuid.UUID, error)
+	// Consume marks the unused, unexpired token with the given purpose and
+	// hash as used at the given time and returns it. It returns nil when
+	// there is no such token, so each token is redeemed at most once.
+	Consume(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, at time.Time) (*entity.UserTokenEntity, error)
+	// RevokeAll marks every unused token of the user with the given purpose as used
+	RevokeAll(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose, at time.Time) error
+}
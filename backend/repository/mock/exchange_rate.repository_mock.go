@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backend/repository/interfaces/exchange_rate.repository_interface.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	interfaces "github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+)
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockExchangeRateRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExchangeRateRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExchangeRateRepository)(nil).Delete), ctx, ownerID, id)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, filter interfaces.ExchangeRateFilter) ([]entity.ExchangeRateEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit, filter)
+	ret0, _ := ret[0].([]entity.ExchangeRateEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindAll), ctx, ownerID, page, limit, filter)
+}
+
+// FindByID mocks base method.
+func (m *MockExchangeRateRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ExchangeRateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
+	ret0, _ := ret[0].(*entity.ExchangeRateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindByID), ctx, ownerID, id)
+}
+
+// FindLatest mocks base method.
+func (m *MockExchangeRateRepository) FindLatest(ctx context.Context, ownerID uuid.UUID, base, quote string, at time.Time) (*entity.ExchangeRateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatest", ctx, ownerID, base, quote, at)
+	ret0, _ := ret[0].(*entity.ExchangeRateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatest indicates an expected call of FindLatest.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindLatest(ctx, ownerID, base, quote, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatest", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindLatest), ctx, ownerID, base, quote, at)
+}
+
+// Upsert mocks base method.
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rates []entity.ExchangeRateEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExchangeRateRepositoryMockRecorder) Upsert(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExchangeRateRepository)(nil).Upsert), ctx, rates)
+}
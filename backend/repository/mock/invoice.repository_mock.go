@@ -11,6 +11,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	query "github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	interfaces "github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 )
 
 // MockInvoiceRepository is a mock of InvoiceRepository interface.
@@ -36,6 +38,20 @@ func (m *MockInvoiceRepository) EXPECT() *MockInvoiceRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddInvoiceTags mocks base method.
+func (m *MockInvoiceRepository) AddInvoiceTags(ctx context.Context, invoiceID uuid.UUID, tags []entity.TagEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInvoiceTags", ctx, invoiceID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddInvoiceTags indicates an expected call of AddInvoiceTags.
+func (mr *MockInvoiceRepositoryMockRecorder) AddInvoiceTags(ctx, invoiceID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInvoiceTags", reflect.TypeOf((*MockInvoiceRepository)(nil).AddInvoiceTags), ctx, invoiceID, tags)
+}
+
 // Create mocks base method.
 func (m *MockInvoiceRepository) Create(ctx context.Context, invoice entity.InvoiceEntity) (*uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -51,18 +67,60 @@ func (mr *MockInvoiceRepositoryMockRecorder) Create(ctx, invoice interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoiceRepository)(nil).Create), ctx, invoice)
 }
 
+// CreateCreditNote mocks base method.
+func (m *MockInvoiceRepository) CreateCreditNote(ctx context.Context, note entity.CreditNoteEntity, formatNumber func(int64) string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCreditNote", ctx, note, formatNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCreditNote indicates an expected call of CreateCreditNote.
+func (mr *MockInvoiceRepositoryMockRecorder) CreateCreditNote(ctx, note, formatNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCreditNote", reflect.TypeOf((*MockInvoiceRepository)(nil).CreateCreditNote), ctx, note, formatNumber)
+}
+
+// CreateInvoiceItems mocks base method.
+func (m *MockInvoiceRepository) CreateInvoiceItems(ctx context.Context, items []entity.InvoiceItemEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoiceItems", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInvoiceItems indicates an expected call of CreateInvoiceItems.
+func (mr *MockInvoiceRepositoryMockRecorder) CreateInvoiceItems(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoiceItems", reflect.TypeOf((*MockInvoiceRepository)(nil).CreateInvoiceItems), ctx, items)
+}
+
+// CreatePayment mocks base method.
+func (m *MockInvoiceRepository) CreatePayment(ctx context.Context, payment entity.PaymentEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockInvoiceRepositoryMockRecorder) CreatePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockInvoiceRepository)(nil).CreatePayment), ctx, payment)
+}
+
 // Delete mocks base method.
-func (m *MockInvoiceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockInvoiceRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockInvoiceRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockInvoiceRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvoiceRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvoiceRepository)(nil).Delete), ctx, ownerID, id)
 }
 
 // DeleteInvoiceItems mocks base method.
@@ -94,9 +152,9 @@ func (mr *MockInvoiceRepositoryMockRecorder) DeleteInvoiceTags(ctx, invoiceID in
 }
 
 // FindAll mocks base method.
-func (m *MockInvoiceRepository) FindAll(ctx context.Context, page, limit int, search string) ([]entity.InvoiceEntity, int64, error) {
+func (m *MockInvoiceRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.InvoiceEntity, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, page, limit, search)
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit, spec)
 	ret0, _ := ret[0].([]entity.InvoiceEntity)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -104,36 +162,127 @@ func (m *MockInvoiceRepository) FindAll(ctx context.Context, page, limit int, se
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockInvoiceRepositoryMockRecorder) FindAll(ctx, page, limit, search interface{}) *gomock.Call {
+func (mr *MockInvoiceRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInvoiceRepository)(nil).FindAll), ctx, ownerID, page, limit, spec)
+}
+
+// FindByCursor mocks base method.
+func (m *MockInvoiceRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.InvoiceEntity, query.Cursors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, ownerID, limit, spec)
+	ret0, _ := ret[0].([]entity.InvoiceEntity)
+	ret1, _ := ret[1].(query.Cursors)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockInvoiceRepositoryMockRecorder) FindByCursor(ctx, ownerID, limit, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInvoiceRepository)(nil).FindAll), ctx, page, limit, search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockInvoiceRepository)(nil).FindByCursor), ctx, ownerID, limit, spec)
 }
 
 // FindByID mocks base method.
-func (m *MockInvoiceRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InvoiceEntity, error) {
+func (m *MockInvoiceRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
 	ret0, _ := ret[0].(*entity.InvoiceEntity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockInvoiceRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+func (mr *MockInvoiceRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockInvoiceRepository)(nil).FindByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockInvoiceRepository)(nil).FindByID), ctx, ownerID, id)
+}
+
+// Issue mocks base method.
+func (m *MockInvoiceRepository) Issue(ctx context.Context, ownerID, id uuid.UUID, issued entity.InvoiceEntity, formatNumber func(int64) string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, ownerID, id, issued, formatNumber)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockInvoiceRepositoryMockRecorder) Issue(ctx, ownerID, id, issued, formatNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockInvoiceRepository)(nil).Issue), ctx, ownerID, id, issued, formatNumber)
+}
+
+// LockByID mocks base method.
+func (m *MockInvoiceRepository) LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByID", ctx, ownerID, id)
+	ret0, _ := ret[0].(*entity.InvoiceEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByID indicates an expected call of LockByID.
+func (mr *MockInvoiceRepositoryMockRecorder) LockByID(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByID", reflect.TypeOf((*MockInvoiceRepository)(nil).LockByID), ctx, ownerID, id)
+}
+
+// SumPayments mocks base method.
+func (m *MockInvoiceRepository) SumPayments(ctx context.Context, invoiceID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumPayments", ctx, invoiceID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumPayments indicates an expected call of SumPayments.
+func (mr *MockInvoiceRepositoryMockRecorder) SumPayments(ctx, invoiceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumPayments", reflect.TypeOf((*MockInvoiceRepository)(nil).SumPayments), ctx, invoiceID)
+}
+
+// Summarize mocks base method.
+func (m *MockInvoiceRepository) Summarize(ctx context.Context, ownerID uuid.UUID, filter interfaces.InvoiceFilter) ([]interfaces.InvoiceTotals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", ctx, ownerID, filter)
+	ret0, _ := ret[0].([]interfaces.InvoiceTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize.
+func (mr *MockInvoiceRepositoryMockRecorder) Summarize(ctx, ownerID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockInvoiceRepository)(nil).Summarize), ctx, ownerID, filter)
 }
 
 // Update mocks base method.
-func (m *MockInvoiceRepository) Update(ctx context.Context, id uuid.UUID, invoice entity.InvoiceEntity) error {
+func (m *MockInvoiceRepository) Update(ctx context.Context, ownerID, id uuid.UUID, invoice entity.InvoiceEntity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, invoice)
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, invoice)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockInvoiceRepositoryMockRecorder) Update(ctx, id, invoice interface{}) *gomock.Call {
+func (mr *MockInvoiceRepositoryMockRecorder) Update(ctx, ownerID, id, invoice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInvoiceRepository)(nil).Update), ctx, ownerID, id, invoice)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockInvoiceRepository) UpdateStatus(ctx context.Context, ownerID, id uuid.UUID, from entity.InvoiceStatus, invoice entity.InvoiceEntity) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, ownerID, id, from, invoice)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockInvoiceRepositoryMockRecorder) UpdateStatus(ctx, ownerID, id, from, invoice interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInvoiceRepository)(nil).Update), ctx, id, invoice)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockInvoiceRepository)(nil).UpdateStatus), ctx, ownerID, id, from, invoice)
 }
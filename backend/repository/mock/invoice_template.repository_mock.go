@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backend/repository/interfaces/invoice_template.repository_interface.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// MockInvoiceTemplateRepository is a mock of InvoiceTemplateRepository interface.
+type MockInvoiceTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceTemplateRepositoryMockRecorder
+}
+
+// MockInvoiceTemplateRepositoryMockRecorder is the mock recorder for MockInvoiceTemplateRepository.
+type MockInvoiceTemplateRepositoryMockRecorder struct {
+	mock *MockInvoiceTemplateRepository
+}
+
+// NewMockInvoiceTemplateRepository creates a new mock instance.
+func NewMockInvoiceTemplateRepository(ctrl *gomock.Controller) *MockInvoiceTemplateRepository {
+	mock := &MockInvoiceTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockInvoiceTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceTemplateRepository) EXPECT() *MockInvoiceTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvoiceTemplateRepository) Create(ctx context.Context, template entity.InvoiceTemplateEntity) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, template)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) Create(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).Create), ctx, template)
+}
+
+// Delete mocks base method.
+func (m *MockInvoiceTemplateRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).Delete), ctx, ownerID, id)
+}
+
+// FindAll mocks base method.
+func (m *MockInvoiceTemplateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int) ([]entity.InvoiceTemplateEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit)
+	ret0, _ := ret[0].([]entity.InvoiceTemplateEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).FindAll), ctx, ownerID, page, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockInvoiceTemplateRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceTemplateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
+	ret0, _ := ret[0].(*entity.InvoiceTemplateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).FindByID), ctx, ownerID, id)
+}
+
+// FindDue mocks base method.
+func (m *MockInvoiceTemplateRepository) FindDue(ctx context.Context, at time.Time, limit int) ([]entity.InvoiceTemplateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", ctx, at, limit)
+	ret0, _ := ret[0].([]entity.InvoiceTemplateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) FindDue(ctx, at, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).FindDue), ctx, at, limit)
+}
+
+// LockByID mocks base method.
+func (m *MockInvoiceTemplateRepository) LockByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceTemplateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByID", ctx, ownerID, id)
+	ret0, _ := ret[0].(*entity.InvoiceTemplateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByID indicates an expected call of LockByID.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) LockByID(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByID", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).LockByID), ctx, ownerID, id)
+}
+
+// RecordFailure mocks base method.
+func (m *MockInvoiceTemplateRepository) RecordFailure(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, id, message, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) RecordFailure(ctx, id, message, nextAttemptAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).RecordFailure), ctx, id, message, nextAttemptAt)
+}
+
+// RecordRun mocks base method.
+func (m *MockInvoiceTemplateRepository) RecordRun(ctx context.Context, run entity.InvoiceTemplateRunEntity, nextRunDate time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRun", ctx, run, nextRunDate)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordRun indicates an expected call of RecordRun.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) RecordRun(ctx, run, nextRunDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRun", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).RecordRun), ctx, run, nextRunDate)
+}
+
+// Update mocks base method.
+func (m *MockInvoiceTemplateRepository) Update(ctx context.Context, ownerID, id uuid.UUID, template entity.InvoiceTemplateEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInvoiceTemplateRepositoryMockRecorder) Update(ctx, ownerID, id, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInvoiceTemplateRepository)(nil).Update), ctx, ownerID, id, template)
+}
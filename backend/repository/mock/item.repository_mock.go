@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	query "github.com/kamil5b/clean-go-vite-react/backend/model/query"
 )
 
 // MockItemRepository is a mock of ItemRepository interface.
@@ -52,23 +53,23 @@ func (mr *MockItemRepositoryMockRecorder) Create(ctx, item interface{}) *gomock.
 }
 
 // Delete mocks base method.
-func (m *MockItemRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockItemRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockItemRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockItemRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockItemRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockItemRepository)(nil).Delete), ctx, ownerID, id)
 }
 
 // FindAll mocks base method.
-func (m *MockItemRepository) FindAll(ctx context.Context, page, limit int, search string) ([]entity.ItemEntity, int64, error) {
+func (m *MockItemRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.ItemEntity, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, page, limit, search)
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit, spec)
 	ret0, _ := ret[0].([]entity.ItemEntity)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -76,36 +77,52 @@ func (m *MockItemRepository) FindAll(ctx context.Context, page, limit int, searc
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockItemRepositoryMockRecorder) FindAll(ctx, page, limit, search interface{}) *gomock.Call {
+func (mr *MockItemRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockItemRepository)(nil).FindAll), ctx, page, limit, search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockItemRepository)(nil).FindAll), ctx, ownerID, page, limit, spec)
+}
+
+// FindByCursor mocks base method.
+func (m *MockItemRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.ItemEntity, query.Cursors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, ownerID, limit, spec)
+	ret0, _ := ret[0].([]entity.ItemEntity)
+	ret1, _ := ret[1].(query.Cursors)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockItemRepositoryMockRecorder) FindByCursor(ctx, ownerID, limit, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockItemRepository)(nil).FindByCursor), ctx, ownerID, limit, spec)
 }
 
 // FindByID mocks base method.
-func (m *MockItemRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ItemEntity, error) {
+func (m *MockItemRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.ItemEntity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
 	ret0, _ := ret[0].(*entity.ItemEntity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockItemRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+func (mr *MockItemRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockItemRepository)(nil).FindByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockItemRepository)(nil).FindByID), ctx, ownerID, id)
 }
 
 // Update mocks base method.
-func (m *MockItemRepository) Update(ctx context.Context, id uuid.UUID, item entity.ItemEntity) error {
+func (m *MockItemRepository) Update(ctx context.Context, ownerID, id uuid.UUID, item entity.ItemEntity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, item)
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, item)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockItemRepositoryMockRecorder) Update(ctx, id, item interface{}) *gomock.Call {
+func (mr *MockItemRepositoryMockRecorder) Update(ctx, ownerID, id, item interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemRepository)(nil).Update), ctx, id, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockItemRepository)(nil).Update), ctx, ownerID, id, item)
 }
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	query "github.com/kamil5b/clean-go-vite-react/backend/model/query"
 )
 
 // MockTagRepository is a mock of TagRepository interface.
@@ -52,23 +53,23 @@ func (mr *MockTagRepositoryMockRecorder) Create(ctx, tag interface{}) *gomock.Ca
 }
 
 // Delete mocks base method.
-func (m *MockTagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockTagRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTagRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+func (mr *MockTagRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagRepository)(nil).Delete), ctx, ownerID, id)
 }
 
 // FindAll mocks base method.
-func (m *MockTagRepository) FindAll(ctx context.Context, page, limit int, search string) ([]entity.TagEntity, int64, error) {
+func (m *MockTagRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) ([]entity.TagEntity, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, page, limit, search)
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit, spec)
 	ret0, _ := ret[0].([]entity.TagEntity)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -76,36 +77,52 @@ func (m *MockTagRepository) FindAll(ctx context.Context, page, limit int, search
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockTagRepositoryMockRecorder) FindAll(ctx, page, limit, search interface{}) *gomock.Call {
+func (mr *MockTagRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTagRepository)(nil).FindAll), ctx, page, limit, search)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTagRepository)(nil).FindAll), ctx, ownerID, page, limit, spec)
+}
+
+// FindByCursor mocks base method.
+func (m *MockTagRepository) FindByCursor(ctx context.Context, ownerID uuid.UUID, limit int, spec query.Spec) ([]entity.TagEntity, query.Cursors, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCursor", ctx, ownerID, limit, spec)
+	ret0, _ := ret[0].([]entity.TagEntity)
+	ret1, _ := ret[1].(query.Cursors)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCursor indicates an expected call of FindByCursor.
+func (mr *MockTagRepositoryMockRecorder) FindByCursor(ctx, ownerID, limit, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCursor", reflect.TypeOf((*MockTagRepository)(nil).FindByCursor), ctx, ownerID, limit, spec)
 }
 
 // FindByID mocks base method.
-func (m *MockTagRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TagEntity, error) {
+func (m *MockTagRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TagEntity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
 	ret0, _ := ret[0].(*entity.TagEntity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockTagRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.Call {
+func (mr *MockTagRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTagRepository)(nil).FindByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTagRepository)(nil).FindByID), ctx, ownerID, id)
 }
 
 // Update mocks base method.
-func (m *MockTagRepository) Update(ctx context.Context, id uuid.UUID, tag entity.TagEntity) error {
+func (m *MockTagRepository) Update(ctx context.Context, ownerID, id uuid.UUID, tag entity.TagEntity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, tag)
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, tag)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTagRepositoryMockRecorder) Update(ctx, id, tag interface{}) *gomock.Call {
+func (mr *MockTagRepositoryMockRecorder) Update(ctx, ownerID, id, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagRepository)(nil).Update), ctx, id, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTagRepository)(nil).Update), ctx, ownerID, id, tag)
 }
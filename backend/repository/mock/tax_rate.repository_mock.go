@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backend/repository/interfaces/tax_rate.repository_interface.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// MockTaxRateRepository is a mock of TaxRateRepository interface.
+type MockTaxRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRateRepositoryMockRecorder
+}
+
+// MockTaxRateRepositoryMockRecorder is the mock recorder for MockTaxRateRepository.
+type MockTaxRateRepositoryMockRecorder struct {
+	mock *MockTaxRateRepository
+}
+
+// NewMockTaxRateRepository creates a new mock instance.
+func NewMockTaxRateRepository(ctrl *gomock.Controller) *MockTaxRateRepository {
+	mock := &MockTaxRateRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRateRepository) EXPECT() *MockTaxRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaxRateRepository) Create(ctx context.Context, taxRate entity.TaxRateEntity) (*uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, taxRate)
+	ret0, _ := ret[0].(*uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaxRateRepositoryMockRecorder) Create(ctx, taxRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaxRateRepository)(nil).Create), ctx, taxRate)
+}
+
+// Delete mocks base method.
+func (m *MockTaxRateRepository) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ownerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaxRateRepositoryMockRecorder) Delete(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaxRateRepository)(nil).Delete), ctx, ownerID, id)
+}
+
+// FindAll mocks base method.
+func (m *MockTaxRateRepository) FindAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) ([]entity.TaxRateEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, ownerID, page, limit, search)
+	ret0, _ := ret[0].([]entity.TaxRateEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTaxRateRepositoryMockRecorder) FindAll(ctx, ownerID, page, limit, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTaxRateRepository)(nil).FindAll), ctx, ownerID, page, limit, search)
+}
+
+// FindByID mocks base method.
+func (m *MockTaxRateRepository) FindByID(ctx context.Context, ownerID, id uuid.UUID) (*entity.TaxRateEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, ownerID, id)
+	ret0, _ := ret[0].(*entity.TaxRateEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTaxRateRepositoryMockRecorder) FindByID(ctx, ownerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTaxRateRepository)(nil).FindByID), ctx, ownerID, id)
+}
+
+// Update mocks base method.
+func (m *MockTaxRateRepository) Update(ctx context.Context, ownerID, id uuid.UUID, taxRate entity.TaxRateEntity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ownerID, id, taxRate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaxRateRepositoryMockRecorder) Update(ctx, ownerID, id, taxRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaxRateRepository)(nil).Update), ctx, ownerID, id, taxRate)
+}
@@ -36,6 +36,21 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountByRole mocks base method.
+func (m *MockUserRepository) CountByRole(ctx context.Context, role entity.Role) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByRole", ctx, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByRole indicates an expected call of CountByRole.
+func (mr *MockUserRepositoryMockRecorder) CountByRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByRole", reflect.TypeOf((*MockUserRepository)(nil).CountByRole), ctx, role)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, user entity.UserEntity) (*uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +80,22 @@ func (mr *MockUserRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserRepository)(nil).Delete), ctx, id)
 }
 
+// FindAll mocks base method.
+func (m *MockUserRepository) FindAll(ctx context.Context, page, limit int) ([]entity.UserEntity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, page, limit)
+	ret0, _ := ret[0].([]entity.UserEntity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockUserRepositoryMockRecorder) FindAll(ctx, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockUserRepository)(nil).FindAll), ctx, page, limit)
+}
+
 // FindByEmail mocks base method.
 func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entity.UserEntity, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +126,20 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// SetRoles mocks base method.
+func (m *MockUserRepository) SetRoles(ctx context.Context, id uuid.UUID, roles []entity.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoles", ctx, id, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoles indicates an expected call of SetRoles.
+func (mr *MockUserRepositoryMockRecorder) SetRoles(ctx, id, roles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoles", reflect.TypeOf((*MockUserRepository)(nil).SetRoles), ctx, id, roles)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, id uuid.UUID, user entity.UserEntity) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,127 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/mail"
+)
+
+// ErrInvalidToken is returned for a password reset or email verification
+// token that is unknown, expired or already used
+var ErrInvalidToken = apperror.New(apperror.ErrBadRequest, "invalid_token", "invalid, expired or already used token")
+
+// ErrAlreadyVerified is returned when asking to verify an email that
+// already is
+var ErrAlreadyVerified = apperror.New(apperror.ErrConflict, "already_verified", "email is already verified")
+
+// AccountConfig holds where emailed links point and how long they work
+type AccountConfig struct {
+	// AppURL is where users reach the app, e.g. "https://app.example.com"
+	AppURL                  string
+	PasswordResetExpiry     time.Duration
+	EmailVerificationExpiry time.Duration
+}
+
+// AccountService recovers accounts and verifies emails with single-use
+// tokens sent by email
+type AccountService interface {
+	// ForgotPassword emails a password reset link in the background. It
+	// returns before looking the email up, so neither its result nor its
+	// timing tells which emails have accounts.
+	ForgotPassword(ctx context.Context, req *request.ForgotPasswordRequest) error
+	// ResetPassword sets a new password and signs the user out everywhere
+	ResetPassword(ctx context.Context, req *request.ResetPasswordRequest) error
+	VerifyEmail(ctx context.Context, req *request.VerifyEmailRequest) error
+	// SendVerification emails a link confirming the user's email
+	SendVerification(ctx context.Context, userID uuid.UUID) error
+}
+
+// accountService is the concrete implementation of AccountService
+type accountService struct {
+	userRepository         interfaces.UserRepository
+	userTokenRepository    interfaces.UserTokenRepository
+	refreshTokenRepository interfaces.RefreshTokenRepository
+	unitOfWork             interfaces.UnitOfWork
+	mailer                 mail.Mailer
+	config                 AccountConfig
+	now                    func() time.Time
+	background             func(func()) // runs work that outlives the request
+}
+
+// NewAccountService creates a new instance of AccountService
+func NewAccountService(userRepository interfaces.UserRepository, userTokenRepository interfaces.UserTokenRepository, refreshTokenRepository interfaces.RefreshTokenRepository, unitOfWork interfaces.UnitOfWork, mailer mail.Mailer, config AccountConfig) AccountService {
+	return &accountService{
+		userRepository:         userRepository,
+		userTokenRepository:    userTokenRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		unitOfWork:             unitOfWork,
+		mailer:                 mailer,
+		config:                 config,
+		now:                    time.Now,
+		background:             func(work func()) { go work() },
+	}
+}
+
+// hashToken returns the SHA-256 digest stored in place of the raw token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueToken stores a new token for the user, revoking the ones issued
+// before it for the same purpose, and returns the raw token
+func (s *accountService) issueToken(ctx context.Context, userID uuid.UUID, purpose entity.TokenPurpose, expiresAt time.Time) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	now := s.now().UTC()
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := s.userTokenRepository.RevokeAll(ctx, userID, purpose, now); err != nil {
+			return err
+		}
+		_, err := s.userTokenRepository.Create(ctx, entity.UserTokenEntity{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: hashToken(token),
+			ExpiresAt: expiresAt,
+			CreatedAt: now,
+		})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// link returns the app page at path that redeems token
+func (s *accountService) link(path, token string) string {
+	return strings.TrimRight(s.config.AppURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// recipient returns the user's name and email as a To address
+func recipient(user *entity.UserEntity) string {
+	return (&netmail.Address{Name: user.Name, Address: user.Email}).String()
+}
+
+// formatExpiry shows when an emailed link stops working
+func formatExpiry(expiresAt time.Time) string {
+	return expiresAt.UTC().Format("Mon, 02 Jan 2006 15:04 MST")
+}
@@ -0,0 +1,118 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/mail"
+)
+
+var testNow = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// recordingMailer keeps the messages sent instead of delivering them
+type recordingMailer struct {
+	sent []mail.Message
+	err  error
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg mail.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// testMocks are the repositories an account service under test uses
+type testMocks struct {
+	users         *mock.MockUserRepository
+	userTokens    *mock.MockUserTokenRepository
+	refreshTokens *mock.MockRefreshTokenRepository
+	mailer        *recordingMailer
+}
+
+func newTestService(ctrl *gomock.Controller) (*accountService, testMocks) {
+	mocks := testMocks{
+		users:         mock.NewMockUserRepository(ctrl),
+		userTokens:    mock.NewMockUserTokenRepository(ctrl),
+		refreshTokens: mock.NewMockRefreshTokenRepository(ctrl),
+		mailer:        &recordingMailer{},
+	}
+	unitOfWork := mock.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	svc := NewAccountService(mocks.users, mocks.userTokens, mocks.refreshTokens, unitOfWork, mocks.mailer, AccountConfig{
+		AppURL:                  "https://app.example.com/",
+		PasswordResetExpiry:     time.Hour,
+		EmailVerificationExpiry: 24 * time.Hour,
+	}).(*accountService)
+	svc.now = func() time.Time { return testNow }
+	svc.background = func(work func()) { work() }
+	return svc, mocks
+}
+
+var linkPattern = regexp.MustCompile(`https://\S+`)
+
+// emailedToken returns the token in the link of the last message sent,
+// checking the link points at path
+func emailedToken(t *testing.T, mailer *recordingMailer, path string) string {
+	t.Helper()
+
+	if len(mailer.sent) == 0 {
+		t.Fatalf("expected a message to be sent")
+	}
+	link, err := url.Parse(linkPattern.FindString(mailer.sent[len(mailer.sent)-1].Body))
+	if err != nil {
+		t.Fatalf("failed to parse link: %v", err)
+	}
+	if got := link.Scheme + "://" + link.Host + link.Path; got != "https://app.example.com"+path {
+		t.Errorf("expected a link to https://app.example.com%s, got %s", path, got)
+	}
+	return link.Query().Get("token")
+}
+
+func TestHashToken(t *testing.T) {
+	if hashToken("a") == hashToken("b") {
+		t.Errorf("expected different tokens to hash differently")
+	}
+	if hashToken("a") != hashToken("a") {
+		t.Errorf("expected the same token to hash the same")
+	}
+	if len(hashToken("a")) != 64 {
+		t.Errorf("expected a hex SHA-256 digest, got %q", hashToken("a"))
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, _ := newTestService(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := svc.ForgotPassword(ctx, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled from ForgotPassword, got %v", err)
+	}
+	if err := svc.ResetPassword(ctx, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled from ResetPassword, got %v", err)
+	}
+	if err := svc.VerifyEmail(ctx, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled from VerifyEmail, got %v", err)
+	}
+	if err := svc.SendVerification(ctx, uuid.Nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled from SendVerification, got %v", err)
+	}
+}
@@ -0,0 +1,70 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/mail"
+)
+
+// forgotPasswordTimeout bounds the background lookup and email of one
+// password reset request
+const forgotPasswordTimeout = time.Minute
+
+// ForgotPassword emails a single-use link to /reset-password, replacing
+// any link sent before it. The lookup, token and email happen after it
+// returns, and their failures are only logged, so every request is answered
+// alike after the same work.
+func (s *accountService) ForgotPassword(ctx context.Context, req *request.ForgotPasswordRequest) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	email := req.Email
+	s.background(func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), forgotPasswordTimeout)
+		defer cancel()
+
+		if err := s.sendPasswordReset(ctx, email); err != nil {
+			log.Printf("password reset: %v", err)
+		}
+	})
+	return nil
+}
+
+// sendPasswordReset emails a reset link to the account with the given
+// email, if there is one
+func (s *accountService) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepository.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return nil
+	}
+
+	expiresAt := s.now().UTC().Add(s.config.PasswordResetExpiry)
+	token, err := s.issueToken(ctx, user.ID, entity.TokenPurposePasswordReset, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      recipient(user),
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(`Hi %s,
+
+Someone asked to reset the password of your account. Open this link to choose a new one:
+
+%s
+
+The link works once and expires %s. If you did not ask for it, ignore this email and your password stays the same.
+`, user.Name, s.link("/reset-password", token), formatExpiry(expiresAt)),
+	})
+}
@@ -0,0 +1,105 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+)
+
+func TestForgotPassword(t *testing.T) {
+	user := &entity.UserEntity{ID: uuid.New(), Email: "alice@example.com", Name: "Alice"}
+
+	tests := []struct {
+		name         string
+		user         *entity.UserEntity
+		findErr      error
+		mailErr      error
+		expectedSent bool
+	}{
+		{
+			name:         "should email a reset link",
+			user:         user,
+			expectedSent: true,
+		},
+		{
+			name: "should succeed without sending for an unknown email",
+		},
+		{
+			name:    "should succeed when the email cannot be sent",
+			user:    user,
+			mailErr: errors.New("smtp down"),
+		},
+		{
+			name:    "should succeed when the lookup fails",
+			findErr: errors.New("database down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, mocks := newTestService(ctrl)
+			mocks.mailer.err = tt.mailErr
+			mocks.users.EXPECT().FindByEmail(gomock.Any(), "alice@example.com").Return(tt.user, tt.findErr)
+			var pending []func()
+			svc.background = func(work func()) { pending = append(pending, work) }
+
+			var stored entity.UserTokenEntity
+			if tt.user != nil {
+				mocks.userTokens.EXPECT().
+					RevokeAll(gomock.Any(), user.ID, entity.TokenPurposePasswordReset, testNow).
+					Return(nil)
+				mocks.userTokens.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, token entity.UserTokenEntity) (*uuid.UUID, error) {
+						stored = token
+						return &token.ID, nil
+					})
+			}
+
+			// The request is answered before the email is looked up, and
+			// the work goes on after the request's context is done
+			ctx, cancel := context.WithCancel(context.Background())
+			err := svc.ForgotPassword(ctx, &request.ForgotPasswordRequest{Email: "alice@example.com"})
+			cancel()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(pending) != 1 {
+				t.Fatalf("expected the work to be left to the background, got %d jobs", len(pending))
+			}
+			pending[0]()
+
+			if !tt.expectedSent {
+				if len(mocks.mailer.sent) != 0 {
+					t.Errorf("expected nothing sent, got %v", mocks.mailer.sent)
+				}
+				return
+			}
+
+			msg := mocks.mailer.sent[0]
+			if msg.To != `"Alice" <alice@example.com>` {
+				t.Errorf("expected the message sent to Alice, got %q", msg.To)
+			}
+			token := emailedToken(t, mocks.mailer, "/reset-password")
+			if stored.TokenHash != hashToken(token) || strings.Contains(stored.TokenHash, token) {
+				t.Errorf("expected only the hash of the emailed token stored, got %q", stored.TokenHash)
+			}
+			if stored.UserID != user.ID || stored.Purpose != entity.TokenPurposePasswordReset {
+				t.Errorf("expected a password reset token for the user, got %+v", stored)
+			}
+			if !stored.ExpiresAt.Equal(testNow.Add(time.Hour)) {
+				t.Errorf("expected the token to expire in an hour, got %v", stored.ExpiresAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ResetPassword redeems a password reset token. Since the link reached
+// the user's inbox it also verifies their email, and every session is
+// signed out in case the old password was stolen.
+func (s *accountService) ResetPassword(ctx context.Context, req *request.ResetPasswordRequest) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	// Hash before the transaction so it is not held open meanwhile
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	now := s.now().UTC()
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		token, err := s.userTokenRepository.Consume(ctx, entity.TokenPurposePasswordReset, hashToken(req.Token), now)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return ErrInvalidToken
+		}
+
+		user, err := s.userRepository.FindByID(ctx, token.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return ErrInvalidToken
+		}
+
+		update := entity.UserEntity{Password: hashedPassword}
+		if user.VerifiedAt == nil {
+			update.VerifiedAt = &now
+		}
+		if err := s.userRepository.Update(ctx, user.ID, update); err != nil {
+			return err
+		}
+
+		if err := s.refreshTokenRepository.RevokeAllForUser(ctx, user.ID); err != nil {
+			return err
+		}
+		return s.userTokenRepository.RevokeAll(ctx, user.ID, entity.TokenPurposePasswordReset, now)
+	})
+}
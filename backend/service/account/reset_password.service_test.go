@@ -0,0 +1,94 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestResetPassword(t *testing.T) {
+	userID := uuid.New()
+	verifiedAt := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name               string
+		token              *entity.UserTokenEntity
+		user               *entity.UserEntity
+		expectedVerifiedAt *time.Time
+		expectedError      error
+	}{
+		{
+			name:               "should set the password and verify the email",
+			token:              &entity.UserTokenEntity{UserID: userID},
+			user:               &entity.UserEntity{ID: userID},
+			expectedVerifiedAt: &testNow,
+		},
+		{
+			name:  "should keep an earlier verification",
+			token: &entity.UserTokenEntity{UserID: userID},
+			user:  &entity.UserEntity{ID: userID, VerifiedAt: &verifiedAt},
+		},
+		{
+			name:          "should reject an unusable token",
+			expectedError: ErrInvalidToken,
+		},
+		{
+			name:          "should reject a token of a deleted user",
+			token:         &entity.UserTokenEntity{UserID: userID},
+			expectedError: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, mocks := newTestService(ctrl)
+			mocks.userTokens.EXPECT().
+				Consume(gomock.Any(), entity.TokenPurposePasswordReset, hashToken("raw-token"), testNow).
+				Return(tt.token, nil)
+			if tt.token != nil {
+				mocks.users.EXPECT().FindByID(gomock.Any(), userID).Return(tt.user, nil)
+			}
+
+			var updated entity.UserEntity
+			if tt.user != nil {
+				mocks.users.EXPECT().
+					Update(gomock.Any(), userID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id uuid.UUID, user entity.UserEntity) error {
+						updated = user
+						return nil
+					})
+				mocks.refreshTokens.EXPECT().RevokeAllForUser(gomock.Any(), userID).Return(nil)
+				mocks.userTokens.EXPECT().RevokeAll(gomock.Any(), userID, entity.TokenPurposePasswordReset, testNow).Return(nil)
+			}
+
+			err := svc.ResetPassword(context.Background(), &request.ResetPasswordRequest{Token: "raw-token", Password: "new-password"})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bcrypt.CompareHashAndPassword(updated.Password, []byte("new-password")) != nil {
+				t.Errorf("expected the new password to be stored hashed")
+			}
+			if (updated.VerifiedAt == nil) != (tt.expectedVerifiedAt == nil) ||
+				(updated.VerifiedAt != nil && !updated.VerifiedAt.Equal(*tt.expectedVerifiedAt)) {
+				t.Errorf("expected verified_at %v, got %v", tt.expectedVerifiedAt, updated.VerifiedAt)
+			}
+		})
+	}
+}
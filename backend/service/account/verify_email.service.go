@@ -0,0 +1,86 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/mail"
+	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
+)
+
+// VerifyEmail redeems an email verification token, marking the user's
+// email as verified. Verifying an already verified email is a no-op.
+func (s *accountService) VerifyEmail(ctx context.Context, req *request.VerifyEmailRequest) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	now := s.now().UTC()
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		token, err := s.userTokenRepository.Consume(ctx, entity.TokenPurposeEmailVerification, hashToken(req.Token), now)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return ErrInvalidToken
+		}
+
+		user, err := s.userRepository.FindByID(ctx, token.UserID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return ErrInvalidToken
+		}
+		if user.VerifiedAt != nil {
+			return nil
+		}
+
+		return s.userRepository.Update(ctx, user.ID, entity.UserEntity{VerifiedAt: &now})
+	})
+}
+
+// SendVerification emails a single-use link to /verify-email, replacing
+// any link sent before it
+func (s *accountService) SendVerification(ctx context.Context, userID uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return userSvc.ErrUserNotFound
+	}
+	if user.VerifiedAt != nil {
+		return ErrAlreadyVerified
+	}
+
+	expiresAt := s.now().UTC().Add(s.config.EmailVerificationExpiry)
+	token, err := s.issueToken(ctx, user.ID, entity.TokenPurposeEmailVerification, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      recipient(user),
+		Subject: "Verify your email",
+		Body: fmt.Sprintf(`Hi %s,
+
+Open this link to confirm %s is your email:
+
+%s
+
+The link works once and expires %s.
+`, user.Name, user.Email, s.link("/verify-email", token), formatExpiry(expiresAt)),
+	})
+}
@@ -0,0 +1,155 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	userID := uuid.New()
+	verifiedAt := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		token          *entity.UserTokenEntity
+		user           *entity.UserEntity
+		expectedUpdate bool
+		expectedError  error
+	}{
+		{
+			name:           "should verify the email",
+			token:          &entity.UserTokenEntity{UserID: userID},
+			user:           &entity.UserEntity{ID: userID},
+			expectedUpdate: true,
+		},
+		{
+			name:  "should leave a verified email alone",
+			token: &entity.UserTokenEntity{UserID: userID},
+			user:  &entity.UserEntity{ID: userID, VerifiedAt: &verifiedAt},
+		},
+		{
+			name:          "should reject an unusable token",
+			expectedError: ErrInvalidToken,
+		},
+		{
+			name:          "should reject a token of a deleted user",
+			token:         &entity.UserTokenEntity{UserID: userID},
+			expectedError: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, mocks := newTestService(ctrl)
+			mocks.userTokens.EXPECT().
+				Consume(gomock.Any(), entity.TokenPurposeEmailVerification, hashToken("raw-token"), testNow).
+				Return(tt.token, nil)
+			if tt.token != nil {
+				mocks.users.EXPECT().FindByID(gomock.Any(), userID).Return(tt.user, nil)
+			}
+			if tt.expectedUpdate {
+				mocks.users.EXPECT().
+					Update(gomock.Any(), userID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id uuid.UUID, user entity.UserEntity) error {
+						if user.VerifiedAt == nil || !user.VerifiedAt.Equal(testNow) {
+							t.Errorf("expected verified_at %v, got %v", testNow, user.VerifiedAt)
+						}
+						return nil
+					})
+			}
+
+			err := svc.VerifyEmail(context.Background(), &request.VerifyEmailRequest{Token: "raw-token"})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSendVerification(t *testing.T) {
+	userID := uuid.New()
+	verifiedAt := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		user          *entity.UserEntity
+		expectedError error
+	}{
+		{
+			name: "should email a verification link",
+			user: &entity.UserEntity{ID: userID, Email: "alice@example.com", Name: "Alice"},
+		},
+		{
+			name:          "should refuse a verified email",
+			user:          &entity.UserEntity{ID: userID, Email: "alice@example.com", VerifiedAt: &verifiedAt},
+			expectedError: ErrAlreadyVerified,
+		},
+		{
+			name:          "should return not found for an unknown user",
+			expectedError: userSvc.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, mocks := newTestService(ctrl)
+			mocks.users.EXPECT().FindByID(gomock.Any(), userID).Return(tt.user, nil)
+
+			var stored entity.UserTokenEntity
+			if tt.expectedError == nil {
+				mocks.userTokens.EXPECT().
+					RevokeAll(gomock.Any(), userID, entity.TokenPurposeEmailVerification, testNow).
+					Return(nil)
+				mocks.userTokens.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, token entity.UserTokenEntity) (*uuid.UUID, error) {
+						stored = token
+						return &token.ID, nil
+					})
+			}
+
+			err := svc.SendVerification(context.Background(), userID)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				if len(mocks.mailer.sent) != 0 {
+					t.Errorf("expected nothing sent, got %v", mocks.mailer.sent)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			token := emailedToken(t, mocks.mailer, "/verify-email")
+			if stored.TokenHash != hashToken(token) || stored.Purpose != entity.TokenPurposeEmailVerification {
+				t.Errorf("expected the emailed verification token stored hashed, got %+v", stored)
+			}
+			if !stored.ExpiresAt.Equal(testNow.Add(24 * time.Hour)) {
+				t.Errorf("expected the token to expire in a day, got %v", stored.ExpiresAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+)
+
+// KeyPrefix starts every API key, telling keys apart from access tokens
+// sent in the same Authorization header
+const KeyPrefix = "gvr_"
+
+// displayPrefixLength is how much of a key is kept to identify it
+const displayPrefixLength = 12
+
+// touchInterval is how stale last_used_at may get before a request
+// using the key updates it
+const touchInterval = time.Minute
+
+// ErrInvalidAPIKey is returned for an API key that is unknown, expired or revoked
+var ErrInvalidAPIKey = apperror.New(apperror.ErrUnauthorized, "invalid_api_key", "invalid, expired or revoked api key")
+
+// ErrAPIKeyNotFound is returned when revoking a key the user does not have
+var ErrAPIKeyNotFound = apperror.New(apperror.ErrNotFound, "api_key_not_found", "api key not found")
+
+// ErrInvalidScope is returned when a key would be granted a permission its
+// owner's roles do not grant
+var ErrInvalidScope = apperror.New(apperror.ErrValidation, "invalid_scope", "scopes must be permissions your roles grant")
+
+// ErrInvalidExpiry is returned when a key would expire in the past
+var ErrInvalidExpiry = apperror.New(apperror.ErrValidation, "invalid_expiry", "expires_at must be in the future")
+
+// APIKeyService manages personal API keys and authenticates requests made
+// with them
+type APIKeyService interface {
+	Create(ctx context.Context, userID uuid.UUID, req *request.CreateAPIKeyRequest) (*response.CreateAPIKeyResponse, error)
+	List(ctx context.Context, userID uuid.UUID) (*response.APIKeyListResponse, error)
+	Revoke(ctx context.Context, userID, id uuid.UUID) error
+	// Authenticate returns the claims of the key's owner, with their
+	// current roles and the key's scopes
+	Authenticate(ctx context.Context, key string) (*token.TokenClaims, error)
+}
+
+// apiKeyService is the concrete implementation of APIKeyService
+type apiKeyService struct {
+	apiKeyRepository interfaces.APIKeyRepository
+	userRepository   interfaces.UserRepository
+}
+
+// NewAPIKeyService creates a new instance of APIKeyService
+func NewAPIKeyService(apiKeyRepository interfaces.APIKeyRepository, userRepository interfaces.UserRepository) APIKeyService {
+	return &apiKeyService{
+		apiKeyRepository: apiKeyRepository,
+		userRepository:   userRepository,
+	}
+}
+
+// hashKey returns the SHA-256 digest stored in place of the raw key
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// roleNames returns the names of the roles granted to user
+func roleNames(user *entity.UserEntity) []string {
+	roles := make([]string, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = string(role.Role)
+	}
+	return roles
+}
+
+// toResponse maps an API key to its API representation
+func toResponse(key *entity.APIKeyEntity) response.APIKeyResponse {
+	return response.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     strings.Fields(key.Scopes),
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+)
+
+// Authenticate looks up an API key and returns claims for its owner. The
+// roles are read from the database on every request, so a key never grants
+// more than its owner currently may do.
+func (s *apiKeyService) Authenticate(ctx context.Context, key string) (*token.TokenClaims, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if !strings.HasPrefix(key, KeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.apiKeyRepository.FindByKeyHash(ctx, hashKey(key))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if apiKey == nil || apiKey.RevokedAt != nil || (apiKey.ExpiresAt != nil && !now.Before(*apiKey.ExpiresAt)) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	user, err := s.userRepository.FindByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= touchInterval {
+		if err := s.apiKeyRepository.Touch(ctx, apiKey.ID, now.UTC()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &token.TokenClaims{
+		UserID: user.ID,
+		Email:  user.Email,
+		Name:   user.Name,
+		Roles:  roleNames(user),
+		Scopes: strings.Fields(apiKey.Scopes),
+	}, nil
+}
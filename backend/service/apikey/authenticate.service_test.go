@@ -0,0 +1,121 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestAuthenticate(t *testing.T) {
+	key := KeyPrefix + "secret"
+	userID := uuid.New()
+	user := &entity.UserEntity{ID: userID, Email: "test@example.com", Roles: []entity.UserRoleEntity{{UserID: userID, Role: entity.RoleViewer}}}
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now().Add(-time.Second)
+
+	tests := []struct {
+		name          string
+		key           string
+		mockKey       *entity.APIKeyEntity
+		mockUser      *entity.UserEntity
+		expectTouch   bool
+		expectedError error
+	}{
+		{
+			name:        "should return the owner's current roles and the key's scopes",
+			key:         key,
+			mockKey:     &entity.APIKeyEntity{ID: uuid.New(), UserID: userID, Scopes: "invoice:read item:read"},
+			mockUser:    user,
+			expectTouch: true,
+		},
+		{
+			name:     "should not record a use again within a minute",
+			key:      key,
+			mockKey:  &entity.APIKeyEntity{ID: uuid.New(), UserID: userID, Scopes: "invoice:read item:read", LastUsedAt: &recent},
+			mockUser: user,
+		},
+		{
+			name:          "should reject a key without the prefix",
+			key:           "secret",
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name:          "should reject an unknown key",
+			key:           key,
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name:          "should reject a revoked key",
+			key:           key,
+			mockKey:       &entity.APIKeyEntity{ID: uuid.New(), UserID: userID, RevokedAt: &past},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name:          "should reject an expired key",
+			key:           key,
+			mockKey:       &entity.APIKeyEntity{ID: uuid.New(), UserID: userID, ExpiresAt: &past},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name:          "should reject the key of a deleted user",
+			key:           key,
+			mockKey:       &entity.APIKeyEntity{ID: uuid.New(), UserID: userID},
+			expectedError: ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPIKeyRepo := mock.NewMockAPIKeyRepository(ctrl)
+			mockUserRepo := mock.NewMockUserRepository(ctrl)
+
+			if tt.key != "secret" {
+				mockAPIKeyRepo.EXPECT().
+					FindByKeyHash(gomock.Any(), hashKey(tt.key)).
+					Return(tt.mockKey, nil).
+					Times(1)
+			}
+			if tt.mockKey != nil && tt.mockKey.RevokedAt == nil && tt.mockKey.ExpiresAt == nil {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), userID).
+					Return(tt.mockUser, nil).
+					Times(1)
+			}
+			if tt.expectTouch {
+				mockAPIKeyRepo.EXPECT().
+					Touch(gomock.Any(), tt.mockKey.ID, gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			svc := NewAPIKeyService(mockAPIKeyRepo, mockUserRepo)
+			claims, err := svc.Authenticate(context.Background(), tt.key)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims.UserID != userID || !slices.Equal(claims.Roles, []string{"viewer"}) {
+				t.Errorf("expected the viewer's claims, got %+v", claims)
+			}
+			if !slices.Equal(claims.Scopes, []string{"invoice:read", "item:read"}) {
+				t.Errorf("expected the key's scopes, got %v", claims.Scopes)
+			}
+		})
+	}
+}
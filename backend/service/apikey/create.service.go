@@ -0,0 +1,77 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	userSvc "github.com/kamil5b/clean-go-vite-react/backend/service/user"
+)
+
+// Create issues a personal API key for the user. Its scopes must be
+// permissions the user's roles grant now; the raw key is returned once and
+// only its hash is kept.
+func (s *apiKeyService) Create(ctx context.Context, userID uuid.UUID, req *request.CreateAPIKeyRequest) (*response.CreateAPIKeyResponse, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, ErrInvalidExpiry
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, userSvc.ErrUserNotFound
+	}
+
+	granted := rbac.Permissions(roleNames(user))
+	var scopes []string
+	for _, scope := range req.Scopes {
+		if !slices.Contains(granted, rbac.Permission(scope)) {
+			return nil, ErrInvalidScope
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	slices.Sort(scopes)
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	key := KeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey := entity.APIKeyEntity{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      req.Name,
+		Prefix:    key[:displayPrefixLength],
+		KeyHash:   hashKey(key),
+		Scopes:    strings.Join(scopes, " "),
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: time.Now(),
+	}
+	if _, err := s.apiKeyRepository.Create(ctx, apiKey); err != nil {
+		return nil, err
+	}
+
+	return &response.CreateAPIKeyResponse{
+		APIKeyResponse: toResponse(&apiKey),
+		Key:            key,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestCreate(t *testing.T) {
+	userID := uuid.New()
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		request        *request.CreateAPIKeyRequest
+		role           entity.Role
+		expectedScopes []string
+		expectedError  error
+	}{
+		{
+			name:           "should create a key with sorted unique scopes",
+			request:        &request.CreateAPIKeyRequest{Name: "CI", Scopes: []string{"invoice:write", "invoice:read", "invoice:write"}, ExpiresAt: &future},
+			role:           entity.RoleAccountant,
+			expectedScopes: []string{"invoice:read", "invoice:write"},
+		},
+		{
+			name:          "should not grant a key more than the user's roles",
+			request:       &request.CreateAPIKeyRequest{Name: "CI", Scopes: []string{"invoice:write"}},
+			role:          entity.RoleViewer,
+			expectedError: ErrInvalidScope,
+		},
+		{
+			name:          "should reject an unknown scope",
+			request:       &request.CreateAPIKeyRequest{Name: "CI", Scopes: []string{"everything"}},
+			role:          entity.RoleAdmin,
+			expectedError: ErrInvalidScope,
+		},
+		{
+			name:          "should reject an expiry in the past",
+			request:       &request.CreateAPIKeyRequest{Name: "CI", Scopes: []string{"invoice:read"}, ExpiresAt: &past},
+			expectedError: ErrInvalidExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPIKeyRepo := mock.NewMockAPIKeyRepository(ctrl)
+			mockUserRepo := mock.NewMockUserRepository(ctrl)
+
+			if tt.expectedError != ErrInvalidExpiry {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), userID).
+					Return(&entity.UserEntity{ID: userID, Roles: []entity.UserRoleEntity{{UserID: userID, Role: tt.role}}}, nil).
+					Times(1)
+			}
+
+			var stored entity.APIKeyEntity
+			if tt.expectedError == nil {
+				mockAPIKeyRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key entity.APIKeyEntity) (*uuid.UUID, error) {
+						stored = key
+						return &key.ID, nil
+					}).
+					Times(1)
+			}
+
+			svc := NewAPIKeyService(mockAPIKeyRepo, mockUserRepo)
+			result, err := svc.Create(context.Background(), userID, tt.request)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.HasPrefix(result.Key, KeyPrefix) || !strings.HasPrefix(result.Key, result.Prefix) {
+				t.Errorf("expected a key starting with %s and its prefix %s, got %s", KeyPrefix, result.Prefix, result.Key)
+			}
+			if stored.KeyHash != hashKey(result.Key) || strings.Contains(stored.KeyHash, result.Key) {
+				t.Errorf("expected only the key's hash to be stored")
+			}
+			if !slices.Equal(result.Scopes, tt.expectedScopes) {
+				t.Errorf("expected scopes %v, got %v", tt.expectedScopes, result.Scopes)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// List lists the user's API keys, revoked ones included
+func (s *apiKeyService) List(ctx context.Context, userID uuid.UUID) (*response.APIKeyListResponse, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	keys, err := s.apiKeyRepository.FindAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyResponses := make([]response.APIKeyResponse, len(keys))
+	for i := range keys {
+		keyResponses[i] = toResponse(&keys[i])
+	}
+
+	return &response.APIKeyListResponse{Data: keyResponses}, nil
+}
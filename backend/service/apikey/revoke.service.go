@@ -0,0 +1,26 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Revoke revokes one of the user's API keys, which stops working at once
+func (s *apiKeyService) Revoke(ctx context.Context, userID, id uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	revoked, err := s.apiKeyRepository.Revoke(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return ErrAPIKeyNotFound
+	}
+
+	return nil
+}
@@ -1,15 +1,35 @@
 package csrf
 
-// CSRFService handles CSRF token generation and validation
+import "time"
+
+// CSRFConfig holds CSRF token configuration
+type CSRFConfig struct {
+	Secret string
+	Expiry time.Duration
+}
+
+// CSRFService handles CSRF token generation and validation.
+// Tokens are bound to a subject (the authenticated user ID, or an empty
+// string for anonymous sessions) and are only valid for that subject.
 type CSRFService interface {
-	GenerateToken() (string, error)
-	ValidateToken(token string) bool
+	GenerateToken(subject string) (string, error)
+	ValidateToken(token, subject string) bool
+	TokenExpiry() time.Duration
 }
 
 // csrfService implements CSRFService
-type csrfService struct{}
+type csrfService struct {
+	config CSRFConfig
+}
 
 // NewCSRFService creates a new CSRF service
-func NewCSRFService() CSRFService {
-	return &csrfService{}
+func NewCSRFService(config CSRFConfig) CSRFService {
+	return &csrfService{
+		config: config,
+	}
+}
+
+// TokenExpiry returns how long newly issued tokens stay valid
+func (s *csrfService) TokenExpiry() time.Duration {
+	return s.config.Expiry
 }
@@ -2,8 +2,16 @@ package csrf
 
 import (
 	"testing"
+	"time"
 )
 
+func newTestCSRFService() CSRFService {
+	return NewCSRFService(CSRFConfig{
+		Secret: "test-csrf-secret",
+		Expiry: time.Hour,
+	})
+}
+
 func TestNewCSRFService(t *testing.T) {
 	tests := []struct {
 		name string
@@ -18,7 +26,7 @@ func TestNewCSRFService(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewCSRFService()
+			service := newTestCSRFService()
 
 			if service == nil {
 				t.Errorf("expected non-nil service, got nil")
@@ -31,3 +39,11 @@ func TestNewCSRFService(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenExpiry(t *testing.T) {
+	service := newTestCSRFService()
+
+	if service.TokenExpiry() != time.Hour {
+		t.Errorf("expected expiry 1h, got %v", service.TokenExpiry())
+	}
+}
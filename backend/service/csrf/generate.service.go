@@ -1,16 +1,34 @@
 package csrf
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
 )
 
-// GenerateToken generates a random CSRF token
-func (s *csrfService) GenerateToken() (string, error) {
+// GenerateToken generates a signed CSRF token bound to the given subject.
+// The token has the form <nonce>.<expires-at>.<signature>, where the
+// signature is an HMAC-SHA256 over the subject, nonce and expiry.
+func (s *csrfService) GenerateToken(subject string) (string, error) {
 	b := make([]byte, 32)
 	_, err := rand.Read(b)
 	if err != nil {
 		return "", err
 	}
-	return hex.EncodeToString(b), nil
+
+	nonce := hex.EncodeToString(b)
+	expiresAt := strconv.FormatInt(time.Now().Add(s.config.Expiry).Unix(), 10)
+
+	return strings.Join([]string{nonce, expiresAt, s.sign(subject, nonce, expiresAt)}, "."), nil
+}
+
+// sign computes the token signature for the given parts
+func (s *csrfService) sign(subject, nonce, expiresAt string) string {
+	mac := hmac.New(sha256.New, []byte(s.config.Secret))
+	mac.Write([]byte(subject + "|" + nonce + "|" + expiresAt))
+	return hex.EncodeToString(mac.Sum(nil))
 }
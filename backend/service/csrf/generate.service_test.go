@@ -2,28 +2,36 @@ package csrf
 
 import (
 	"encoding/hex"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
+const testSubject = "550e8400-e29b-41d4-a716-446655440000"
+
 func TestGenerateToken(t *testing.T) {
 	tests := []struct {
 		name          string
+		subject       string
 		expectedError bool
 	}{
 		{
 			name:          "should generate csrf token successfully",
+			subject:       testSubject,
 			expectedError: false,
 		},
 		{
-			name:          "should generate different tokens on each call",
+			name:          "should generate csrf token for anonymous subject",
+			subject:       "",
 			expectedError: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewCSRFService()
-			token, err := service.GenerateToken()
+			service := newTestCSRFService()
+			token, err := service.GenerateToken(tt.subject)
 
 			if tt.expectedError {
 				if err == nil {
@@ -37,30 +45,21 @@ func TestGenerateToken(t *testing.T) {
 					t.Errorf("expected non-empty token, got empty string")
 				}
 
-				// Verify token is valid hex
-				_, hexErr := hex.DecodeString(token)
-				if hexErr != nil {
-					t.Errorf("token is not valid hex: %v", hexErr)
-				}
-
-				// Verify token length (32 bytes = 64 hex characters)
-				if len(token) != 64 {
-					t.Errorf("expected token length 64, got %d", len(token))
-				}
+				assertTokenFormat(t, token)
 			}
 		})
 	}
 }
 
 func TestGenerateTokenUniqueness(t *testing.T) {
-	service := NewCSRFService()
+	service := newTestCSRFService()
 
-	token1, err1 := service.GenerateToken()
+	token1, err1 := service.GenerateToken(testSubject)
 	if err1 != nil {
 		t.Fatalf("first token generation failed: %v", err1)
 	}
 
-	token2, err2 := service.GenerateToken()
+	token2, err2 := service.GenerateToken(testSubject)
 	if err2 != nil {
 		t.Fatalf("second token generation failed: %v", err2)
 	}
@@ -71,46 +70,69 @@ func TestGenerateTokenUniqueness(t *testing.T) {
 }
 
 func TestGenerateTokenFormat(t *testing.T) {
-	service := NewCSRFService()
+	service := newTestCSRFService()
 
 	for i := 0; i < 10; i++ {
-		token, err := service.GenerateToken()
+		token, err := service.GenerateToken(testSubject)
 
 		if err != nil {
 			t.Fatalf("token generation failed: %v", err)
 		}
 
-		// Verify token is valid hex
-		_, hexErr := hex.DecodeString(token)
-		if hexErr != nil {
-			t.Errorf("token is not valid hex: %v", hexErr)
-		}
+		assertTokenFormat(t, token)
+	}
+}
 
-		// Verify token is exactly 64 characters (32 bytes in hex)
-		if len(token) != 64 {
-			t.Errorf("expected token length 64, got %d", len(token))
-		}
+func TestGenerateTokenExpiry(t *testing.T) {
+	service := newTestCSRFService()
 
-		// Verify token contains only hex characters
-		for _, ch := range token {
-			if !isHexChar(rune(ch)) {
-				t.Errorf("token contains non-hex character: %c", ch)
-			}
-		}
+	token, err := service.GenerateToken(testSubject)
+	if err != nil {
+		t.Fatalf("token generation failed: %v", err)
+	}
+
+	expiresAt, err := strconv.ParseInt(strings.Split(token, ".")[1], 10, 64)
+	if err != nil {
+		t.Fatalf("expiry is not a unix timestamp: %v", err)
+	}
+
+	expected := time.Now().Add(time.Hour).Unix()
+	if expiresAt < expected-1 || expiresAt > expected+1 {
+		t.Errorf("expected expiry around %d, got %d", expected, expiresAt)
 	}
 }
 
-func isHexChar(ch rune) bool {
-	return (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+// assertTokenFormat checks the <nonce>.<expires-at>.<signature> layout
+func assertTokenFormat(t *testing.T, token string) {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 token parts, got %d in %q", len(parts), token)
+	}
+
+	// Verify nonce and signature are 32 bytes of hex (64 characters)
+	for _, part := range []string{parts[0], parts[2]} {
+		if _, err := hex.DecodeString(part); err != nil {
+			t.Errorf("token part is not valid hex: %v", err)
+		}
+		if len(part) != 64 {
+			t.Errorf("expected token part length 64, got %d", len(part))
+		}
+	}
+
+	if _, err := strconv.ParseInt(parts[1], 10, 64); err != nil {
+		t.Errorf("expiry is not a unix timestamp: %v", err)
+	}
 }
 
 func TestGenerateTokenRandomness(t *testing.T) {
-	service := NewCSRFService()
+	service := newTestCSRFService()
 	tokens := make(map[string]bool)
 
 	// Generate 100 tokens and verify they're all unique
 	for i := 0; i < 100; i++ {
-		token, err := service.GenerateToken()
+		token, err := service.GenerateToken(testSubject)
 
 		if err != nil {
 			t.Fatalf("token generation failed on iteration %d: %v", i, err)
@@ -129,8 +151,8 @@ func TestGenerateTokenRandomness(t *testing.T) {
 }
 
 func TestGenerateTokenNonEmpty(t *testing.T) {
-	service := NewCSRFService()
-	token, err := service.GenerateToken()
+	service := newTestCSRFService()
+	token, err := service.GenerateToken(testSubject)
 
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
@@ -139,8 +161,4 @@ func TestGenerateTokenNonEmpty(t *testing.T) {
 	if token == "" {
 		t.Errorf("generated token is empty")
 	}
-
-	if len(token) == 0 {
-		t.Errorf("generated token has zero length")
-	}
 }
@@ -1,7 +1,26 @@
 package csrf
 
-// ValidateToken validates a CSRF token (basic check for non-empty)
-// In production, you'd check against stored tokens in session/Redis
-func (s *csrfService) ValidateToken(token string) bool {
-	return token != ""
+import (
+	"crypto/hmac"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidateToken checks that the token was issued by this service for the
+// given subject and has not expired
+func (s *csrfService) ValidateToken(token, subject string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	nonce, expiresAt, signature := parts[0], parts[1], parts[2]
+
+	expiry, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || time.Now().Unix() > expiry {
+		return false
+	}
+
+	expected := s.sign(subject, nonce, expiresAt)
+	return hmac.Equal([]byte(signature), []byte(expected))
 }
@@ -1,51 +1,98 @@
 package csrf
 
 import (
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidateToken(t *testing.T) {
+	service := newTestCSRFService()
+
+	validToken, err := service.GenerateToken(testSubject)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	parts := strings.Split(validToken, ".")
+
 	tests := []struct {
 		name          string
 		token         string
-		expectedValid  bool
+		subject       string
+		expectedValid bool
 	}{
 		{
-			name:          "should validate non-empty token",
-			token:         "valid-token-123",
+			name:          "should validate generated token for its subject",
+			token:         validToken,
+			subject:       testSubject,
 			expectedValid: true,
 		},
 		{
-			name:          "should validate token with special characters",
-			token:         "token!@#$%^&*()",
-			expectedValid: true,
+			name:          "should reject empty token",
+			token:         "",
+			subject:       testSubject,
+			expectedValid: false,
 		},
 		{
-			name:          "should validate single character token",
-			token:         "a",
-			expectedValid: true,
+			name:          "should reject arbitrary string",
+			token:         "valid-token-123",
+			subject:       testSubject,
+			expectedValid: false,
 		},
 		{
-			name:          "should reject empty token",
-			token:         "",
+			name:          "should reject bare hex token",
+			token:         parts[0],
+			subject:       testSubject,
 			expectedValid: false,
 		},
 		{
-			name:          "should validate hex token",
-			token:         "a1b2c3d4e5f6",
-			expectedValid: true,
+			name:          "should reject token issued for another subject",
+			token:         validToken,
+			subject:       "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			expectedValid: false,
 		},
 		{
-			name:          "should validate long token",
-			token:         "thisisaverylongtokenthatcontainsmanycharactersandshouldbeconsideredvalid",
-			expectedValid: true,
+			name:          "should reject token issued for another subject when anonymous",
+			token:         validToken,
+			subject:       "",
+			expectedValid: false,
+		},
+		{
+			name:          "should reject token with tampered nonce",
+			token:         strings.Repeat("0", 64) + "." + parts[1] + "." + parts[2],
+			subject:       testSubject,
+			expectedValid: false,
+		},
+		{
+			name:          "should reject token with extended expiry",
+			token:         parts[0] + "." + strconv.FormatInt(time.Now().Add(24*time.Hour).Unix(), 10) + "." + parts[2],
+			subject:       testSubject,
+			expectedValid: false,
+		},
+		{
+			name:          "should reject token with forged signature",
+			token:         parts[0] + "." + parts[1] + "." + strings.Repeat("a", 64),
+			subject:       testSubject,
+			expectedValid: false,
+		},
+		{
+			name:          "should reject token with non-numeric expiry",
+			token:         parts[0] + ".tomorrow." + parts[2],
+			subject:       testSubject,
+			expectedValid: false,
+		},
+		{
+			name:          "should reject token with extra parts",
+			token:         validToken + ".extra",
+			subject:       testSubject,
+			expectedValid: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewCSRFService()
-			result := service.ValidateToken(tt.token)
+			result := service.ValidateToken(tt.token, tt.subject)
 
 			if result != tt.expectedValid {
 				t.Errorf("expected validation result %v, got %v for token %q", tt.expectedValid, result, tt.token)
@@ -54,62 +101,57 @@ func TestValidateToken(t *testing.T) {
 	}
 }
 
-func TestValidateTokenEmptyString(t *testing.T) {
-	service := NewCSRFService()
-	result := service.ValidateToken("")
-
-	if result {
-		t.Errorf("expected empty token to be invalid, got valid")
-	}
-}
+func TestValidateTokenExpired(t *testing.T) {
+	service := NewCSRFService(CSRFConfig{
+		Secret: "test-csrf-secret",
+		Expiry: -time.Minute,
+	})
 
-func TestValidateTokenNonEmpty(t *testing.T) {
-	service := NewCSRFService()
-	testTokens := []string{
-		"token",
-		"a",
-		"1",
-		"!@#$%",
-		"very-long-token-with-many-characters-12345",
+	token, err := service.GenerateToken(testSubject)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
 	}
 
-	for _, token := range testTokens {
-		result := service.ValidateToken(token)
-		if !result {
-			t.Errorf("expected token %q to be valid, got invalid", token)
-		}
+	if service.ValidateToken(token, testSubject) {
+		t.Errorf("expected expired token to be invalid, got valid")
 	}
 }
 
-func TestValidateTokenWithGeneratedToken(t *testing.T) {
-	service := NewCSRFService()
+func TestValidateTokenSignedWithOtherSecret(t *testing.T) {
+	service := newTestCSRFService()
+	foreignService := NewCSRFService(CSRFConfig{
+		Secret: "attacker-secret",
+		Expiry: time.Hour,
+	})
 
-	generatedToken, err := service.GenerateToken()
+	forgedToken, err := foreignService.GenerateToken(testSubject)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
 
-	result := service.ValidateToken(generatedToken)
-	if !result {
-		t.Errorf("expected generated token to be valid, got invalid")
+	if service.ValidateToken(forgedToken, testSubject) {
+		t.Errorf("expected token signed with another secret to be invalid, got valid")
 	}
 }
 
 func TestValidateTokenConsistency(t *testing.T) {
-	service := NewCSRFService()
-	token := "consistent-token"
+	service := newTestCSRFService()
+	token, err := service.GenerateToken(testSubject)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
 
-	result1 := service.ValidateToken(token)
-	result2 := service.ValidateToken(token)
-	result3 := service.ValidateToken(token)
+	result1 := service.ValidateToken(token, testSubject)
+	result2 := service.ValidateToken(token, testSubject)
+	result3 := service.ValidateToken(token, testSubject)
 
-	if result1 != result2 || result2 != result3 {
+	if !result1 || result1 != result2 || result2 != result3 {
 		t.Errorf("validation should be consistent for same token")
 	}
 }
 
 func TestValidateTokenWhitespace(t *testing.T) {
-	service := NewCSRFService()
+	service := newTestCSRFService()
 
 	tests := []struct {
 		name     string
@@ -117,30 +159,25 @@ func TestValidateTokenWhitespace(t *testing.T) {
 		expected bool
 	}{
 		{
-			name:     "should validate token with spaces",
+			name:     "should reject token with spaces",
 			token:    "token with spaces",
-			expected: true,
-		},
-		{
-			name:     "should validate token with tabs",
-			token:    "token\twith\ttabs",
-			expected: true,
+			expected: false,
 		},
 		{
-			name:     "should validate token with newlines",
+			name:     "should reject token with newlines",
 			token:    "token\nwith\nnewlines",
-			expected: true,
+			expected: false,
 		},
 		{
-			name:     "should not validate only spaces",
+			name:     "should reject only spaces",
 			token:    "   ",
-			expected: true, // Spaces are still non-empty
+			expected: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := service.ValidateToken(tt.token)
+			result := service.ValidateToken(tt.token, testSubject)
 			if result != tt.expected {
 				t.Errorf("expected %v, got %v for token %q", tt.expected, result, tt.token)
 			}
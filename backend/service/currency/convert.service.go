@@ -0,0 +1,83 @@
+package currency
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+)
+
+// ErrInvalidRate is returned for an exchange rate that is not a positive decimal
+var ErrInvalidRate = apperror.New(apperror.ErrValidation, "invalid_exchange_rate", "exchange rate must be a positive decimal number")
+
+// rateFormat accepts plain decimals such as "1", "0.92" or "151.372"
+var rateFormat = regexp.MustCompile(`^[0-9]{1,12}(\.[0-9]{1,12})?$`)
+
+// rateDigits is the number of decimals kept when formatting a derived rate
+const rateDigits = 12
+
+// ParseRate parses a decimal exchange rate exactly
+func ParseRate(value string) (*big.Rat, error) {
+	if !rateFormat.MatchString(value) {
+		return nil, ErrInvalidRate
+	}
+	rate, ok := new(big.Rat).SetString(value)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	return rate, nil
+}
+
+// FormatRate formats a rate as a decimal string, rounded to 12 decimals with
+// trailing zeros removed
+func FormatRate(rate *big.Rat) string {
+	formatted := rate.FloatString(rateDigits)
+	formatted = strings.TrimRight(formatted, "0")
+	return strings.TrimSuffix(formatted, ".")
+}
+
+// Convert converts an amount in minor units of from into minor units of to,
+// where one unit of from is worth rate units of to. The result is rounded
+// half away from zero to the minor unit of to, e.g. whole yen or
+// thousandths of a dinar.
+func Convert(amount int64, from, to string, rate *big.Rat) (int64, error) {
+	fromDigits, err := MinorUnits(from)
+	if err != nil {
+		return 0, err
+	}
+	toDigits, err := MinorUnits(to)
+	if err != nil {
+		return 0, err
+	}
+
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toDigits)), nil))
+	value.Mul(value, scale)
+	scale.SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fromDigits)), nil))
+	value.Quo(value, scale)
+
+	rounded := roundHalfAwayFromZero(value)
+	if !rounded.IsInt64() {
+		return 0, apperror.New(apperror.ErrValidation, "amount_too_large", "converted amount is too large")
+	}
+
+	return rounded.Int64(), nil
+}
+
+// roundHalfAwayFromZero rounds a rational number to the nearest integer
+func roundHalfAwayFromZero(value *big.Rat) *big.Int {
+	num := new(big.Int).Abs(value.Num())
+	den := value.Denom()
+
+	// (2|num| + den) / 2den rounds |value| half up
+	num.Mul(num, big.NewInt(2))
+	num.Add(num, den)
+	result := num.Quo(num, new(big.Int).Mul(den, big.NewInt(2)))
+
+	if value.Sign() < 0 {
+		result.Neg(result)
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package currency
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		from, to string
+		rate     string
+		expected int64
+	}{
+		{name: "should convert between two-decimal currencies", amount: 10000, from: "EUR", to: "USD", rate: "1.0845", expected: 10845},
+		{name: "should round half away from zero", amount: 1, from: "EUR", to: "USD", rate: "0.5", expected: 1},
+		{name: "should round down below half", amount: 1, from: "EUR", to: "USD", rate: "0.49", expected: 0},
+		{name: "should round negative amounts away from zero", amount: -1, from: "EUR", to: "USD", rate: "0.5", expected: -1},
+		{name: "should round into a currency without minor units", amount: 1050, from: "USD", to: "JPY", rate: "151.37", expected: 1589},
+		{name: "should scale from a currency without minor units", amount: 1000, from: "JPY", to: "USD", rate: "0.0066", expected: 660},
+		{name: "should convert into a three-decimal currency", amount: 1000, from: "USD", to: "KWD", rate: "0.30712", expected: 3071},
+		{name: "should keep the amount at the identity rate", amount: 12345, from: "USD", to: "USD", rate: "1", expected: 12345},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result, err := Convert(tt.amount, tt.from, tt.to, rate)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertRejectsUnknownCurrency(t *testing.T) {
+	if _, err := Convert(100, "USD", "XYZ", big.NewRat(1, 1)); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "should accept an integer", value: "2"},
+		{name: "should accept a decimal", value: "0.000123"},
+		{name: "should reject zero", value: "0", wantErr: true},
+		{name: "should reject a negative rate", value: "-1.5", wantErr: true},
+		{name: "should reject a fraction", value: "1/3", wantErr: true},
+		{name: "should reject an exponent", value: "1e3", wantErr: true},
+		{name: "should reject an empty rate", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatRate(t *testing.T) {
+	if got := FormatRate(big.NewRat(1, 3)); got != "0.333333333333" {
+		t.Errorf("expected 0.333333333333, got %s", got)
+	}
+	if got := FormatRate(big.NewRat(2, 1)); got != "2" {
+		t.Errorf("expected 2, got %s", got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if code, err := Normalize(" eur "); err != nil || code != "EUR" {
+		t.Errorf("expected EUR, got %q (err %v)", code, err)
+	}
+	if _, err := Normalize("EURO"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
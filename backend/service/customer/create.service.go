@@ -0,0 +1,34 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Create creates a new customer owned by ownerID
+func (s *customerService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateCustomerRequest) (*response.CustomerResponse, error) {
+	customer, err := newCustomer(req.Name, req.Email, req.BillingAddress, req.TaxID)
+	if err != nil {
+		return nil, err
+	}
+	customer.ID = uuid.New()
+	customer.OwnerID = ownerID
+
+	id, err := s.customerRepository.Create(ctx, customer)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.customerRepository.FindByID(ctx, ownerID, *id)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	return toResponse(created), nil
+}
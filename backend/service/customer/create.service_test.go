@@ -0,0 +1,98 @@
+package customer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestCreate(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name          string
+		req           request.CreateCustomerRequest
+		expectedName  string
+		expectedEmail string
+		expectCreate  bool
+		expectedError bool
+	}{
+		{
+			name:          "should create a customer with trimmed details",
+			req:           request.CreateCustomerRequest{Name: " Acme Corp ", Email: " billing@acme.test", BillingAddress: "1 Main St\nSpringfield", TaxID: "GB123"},
+			expectedName:  "Acme Corp",
+			expectedEmail: "billing@acme.test",
+			expectCreate:  true,
+		},
+		{
+			name:         "should allow a customer without an email",
+			req:          request.CreateCustomerRequest{Name: "Walk-in"},
+			expectedName: "Walk-in",
+			expectCreate: true,
+		},
+		{
+			name:          "should reject a blank name",
+			req:           request.CreateCustomerRequest{Name: "  ", Email: "billing@acme.test"},
+			expectedError: true,
+		},
+		{
+			name:          "should reject an invalid email",
+			req:           request.CreateCustomerRequest{Name: "Acme Corp", Email: "billing"},
+			expectedError: true,
+		},
+		{
+			name:          "should reject an email with a display name",
+			req:           request.CreateCustomerRequest{Name: "Acme Corp", Email: "Billing <billing@acme.test>"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockCustomerRepository(ctrl)
+			if tt.expectCreate {
+				var stored entity.CustomerEntity
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, customer entity.CustomerEntity) (*uuid.UUID, error) {
+						if customer.OwnerID != ownerID {
+							t.Errorf("expected owner %v, got %v", ownerID, customer.OwnerID)
+						}
+						stored = customer
+						return &customer.ID, nil
+					}).
+					Times(1)
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID) (*entity.CustomerEntity, error) {
+						return &stored, nil
+					}).
+					Times(1)
+			}
+
+			svc := NewCustomerService(mockRepo)
+			result, err := svc.Create(context.Background(), ownerID, &tt.req)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Name != tt.expectedName || result.Email != tt.expectedEmail {
+				t.Errorf("expected %q <%s>, got %q <%s>", tt.expectedName, tt.expectedEmail, result.Name, result.Email)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package customer
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+)
+
+// ErrCustomerNotFound is returned when a customer does not exist or belongs to another user
+var ErrCustomerNotFound = apperror.New(apperror.ErrNotFound, "customer_not_found", "customer not found")
+
+// CustomerService defines the interface for customer operations.
+// Every operation is scoped to the customers owned by ownerID.
+type CustomerService interface {
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateCustomerRequest) (*response.CustomerResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.CustomerResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateCustomerRequest) (*response.CustomerResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) (*response.CustomerPaginationResponse, error)
+}
+
+// customerService is the concrete implementation of CustomerService
+type customerService struct {
+	customerRepository interfaces.CustomerRepository
+}
+
+// NewCustomerService creates a new instance of CustomerService
+func NewCustomerService(customerRepository interfaces.CustomerRepository) CustomerService {
+	return &customerService{
+		customerRepository: customerRepository,
+	}
+}
+
+// newCustomer trims and checks the user-supplied fields of a customer
+func newCustomer(name, email, billingAddress, taxID string) (entity.CustomerEntity, error) {
+	customer := entity.CustomerEntity{
+		Name:           strings.TrimSpace(name),
+		Email:          strings.TrimSpace(email),
+		BillingAddress: strings.TrimSpace(billingAddress),
+		TaxID:          strings.TrimSpace(taxID),
+	}
+
+	if customer.Name == "" {
+		return customer, apperror.New(apperror.ErrValidation, "name_required", "name is required")
+	}
+	if customer.Email != "" {
+		// A bare address only, without a display name
+		address, err := mail.ParseAddress(customer.Email)
+		if err != nil || address.Address != customer.Email {
+			return customer, apperror.New(apperror.ErrValidation, "invalid_email", "email is not a valid address")
+		}
+	}
+
+	return customer, nil
+}
+
+// toResponse maps a customer to its API representation
+func toResponse(customer *entity.CustomerEntity) *response.CustomerResponse {
+	return &response.CustomerResponse{
+		ID:             customer.ID,
+		Name:           customer.Name,
+		Email:          customer.Email,
+		BillingAddress: customer.BillingAddress,
+		TaxID:          customer.TaxID,
+		CreatedAt:      customer.CreatedAt,
+		UpdatedAt:      customer.UpdatedAt,
+	}
+}
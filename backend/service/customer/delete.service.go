@@ -0,0 +1,21 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes a customer
+func (s *customerService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	// Check if customer exists
+	customer, err := s.customerRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if customer == nil {
+		return ErrCustomerNotFound
+	}
+
+	return s.customerRepository.Delete(ctx, ownerID, id)
+}
@@ -0,0 +1,54 @@
+package customer
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// GetByID gets a customer by ID
+func (s *customerService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.CustomerResponse, error) {
+	customer, err := s.customerRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	return toResponse(customer), nil
+}
+
+// GetAll gets all customers with pagination
+func (s *customerService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) (*response.CustomerPaginationResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	customers, total, err := s.customerRepository.FindAll(ctx, ownerID, page, limit, search)
+	if err != nil {
+		return nil, err
+	}
+
+	customerResponses := make([]response.CustomerResponse, len(customers))
+	for i := range customers {
+		customerResponses[i] = *toResponse(&customers[i])
+	}
+
+	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+
+	return &response.CustomerPaginationResponse{
+		Data: customerResponses,
+		Meta: response.CustomerPaginationMeta{
+			TotalData: int(total),
+			Page:      page,
+			Limit:     limit,
+			TotalPage: totalPage,
+		},
+	}, nil
+}
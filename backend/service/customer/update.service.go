@@ -0,0 +1,41 @@
+package customer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Update updates a customer. Issued invoices keep the details they were
+// issued with; drafts show the new ones.
+func (s *customerService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateCustomerRequest) (*response.CustomerResponse, error) {
+	customer, err := newCustomer(req.Name, req.Email, req.BillingAddress, req.TaxID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if customer exists
+	existing, err := s.customerRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	if err := s.customerRepository.Update(ctx, ownerID, id, customer); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.customerRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrCustomerNotFound
+	}
+
+	return toResponse(updated), nil
+}
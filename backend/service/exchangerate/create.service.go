@@ -0,0 +1,34 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Create stores an exchange rate owned by ownerID, replacing the rate for the
+// same pair and effective date if there is one
+func (s *exchangeRateService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateExchangeRateRequest) (*response.ExchangeRateResponse, error) {
+	rate, err := build(ownerID, req.BaseCurrency, req.QuoteCurrency, req.Rate, req.EffectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.exchangeRateRepository.Upsert(ctx, []entity.ExchangeRateEntity{*rate}); err != nil {
+		return nil, err
+	}
+
+	// The stored row keeps its original ID when an existing rate was replaced
+	stored, err := s.exchangeRateRepository.FindLatest(ctx, ownerID, rate.BaseCurrency, rate.QuoteCurrency, rate.EffectiveDate)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, ErrExchangeRateNotFound
+	}
+
+	return toResponse(stored), nil
+}
@@ -0,0 +1,21 @@
+package exchangerate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes an exchange rate. Invoices already issued keep their snapshot.
+func (s *exchangeRateService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	// Check if exchange rate exists
+	rate, err := s.exchangeRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if rate == nil {
+		return ErrExchangeRateNotFound
+	}
+
+	return s.exchangeRateRepository.Delete(ctx, ownerID, id)
+}
@@ -0,0 +1,94 @@
+package exchangerate
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+)
+
+// DateLayout is the format of exchange rate effective dates
+const DateLayout = "2006-01-02"
+
+// ErrExchangeRateNotFound is returned when an exchange rate does not exist or belongs to another user
+var ErrExchangeRateNotFound = apperror.New(apperror.ErrNotFound, "exchange_rate_not_found", "exchange rate not found")
+
+// ExchangeRateService defines the interface for exchange rate operations.
+// Every operation is scoped to the exchange rates owned by ownerID.
+type ExchangeRateService interface {
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateExchangeRateRequest) (*response.ExchangeRateResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.ExchangeRateResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, baseCurrency, quoteCurrency string) (*response.ExchangeRatePaginationResponse, error)
+	// Import stores every rate of a CSV file, see ParseCSV, or none of them
+	Import(ctx context.Context, ownerID uuid.UUID, file io.Reader) (*response.ExchangeRateImportResponse, error)
+}
+
+// exchangeRateService is the concrete implementation of ExchangeRateService
+type exchangeRateService struct {
+	exchangeRateRepository interfaces.ExchangeRateRepository
+}
+
+// NewExchangeRateService creates a new instance of ExchangeRateService
+func NewExchangeRateService(exchangeRateRepository interfaces.ExchangeRateRepository) ExchangeRateService {
+	return &exchangeRateService{
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
+
+// build validates the user-supplied fields of an exchange rate and returns
+// it ready to store. An empty date means today.
+func build(ownerID uuid.UUID, base, quote, rate, date string) (*entity.ExchangeRateEntity, error) {
+	base, err := currency.Normalize(base)
+	if err != nil {
+		return nil, err
+	}
+	quote, err = currency.Normalize(quote)
+	if err != nil {
+		return nil, err
+	}
+	if base == quote {
+		return nil, apperror.New(apperror.ErrValidation, "same_currency", "base_currency and quote_currency must differ")
+	}
+
+	parsed, err := currency.ParseRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := time.Now().UTC().Truncate(24 * time.Hour)
+	if date != "" {
+		if effective, err = time.Parse(DateLayout, date); err != nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "invalid_date", "effective_date must be formatted as %s", DateLayout)
+		}
+	}
+
+	return &entity.ExchangeRateEntity{
+		ID:            uuid.New(),
+		OwnerID:       ownerID,
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          currency.FormatRate(parsed),
+		EffectiveDate: effective,
+	}, nil
+}
+
+// toResponse maps an exchange rate to its API representation
+func toResponse(rate *entity.ExchangeRateEntity) *response.ExchangeRateResponse {
+	return &response.ExchangeRateResponse{
+		ID:            rate.ID,
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		EffectiveDate: rate.EffectiveDate.UTC().Format(DateLayout),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
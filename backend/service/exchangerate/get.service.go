@@ -0,0 +1,69 @@
+package exchangerate
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+)
+
+// GetByID gets an exchange rate by ID
+func (s *exchangeRateService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.ExchangeRateResponse, error) {
+	rate, err := s.exchangeRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		return nil, ErrExchangeRateNotFound
+	}
+
+	return toResponse(rate), nil
+}
+
+// GetAll gets all exchange rates with pagination, optionally filtered by currency
+func (s *exchangeRateService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, baseCurrency, quoteCurrency string) (*response.ExchangeRatePaginationResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	var filter interfaces.ExchangeRateFilter
+	var err error
+	if baseCurrency != "" {
+		if filter.BaseCurrency, err = currency.Normalize(baseCurrency); err != nil {
+			return nil, err
+		}
+	}
+	if quoteCurrency != "" {
+		if filter.QuoteCurrency, err = currency.Normalize(quoteCurrency); err != nil {
+			return nil, err
+		}
+	}
+
+	rates, total, err := s.exchangeRateRepository.FindAll(ctx, ownerID, page, limit, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rateResponses := make([]response.ExchangeRateResponse, len(rates))
+	for i := range rates {
+		rateResponses[i] = *toResponse(&rates[i])
+	}
+
+	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+
+	return &response.ExchangeRatePaginationResponse{
+		Data: rateResponses,
+		Meta: response.ExchangeRatePaginationMeta{
+			TotalData: int(total),
+			Page:      page,
+			Limit:     limit,
+			TotalPage: totalPage,
+		},
+	}, nil
+}
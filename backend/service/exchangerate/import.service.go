@@ -0,0 +1,102 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// MaxImportRows is the largest number of rates accepted in one import
+const MaxImportRows = 10000
+
+// importColumns are the CSV header names an import file must contain
+var importColumns = []string{"base_currency", "quote_currency", "rate", "effective_date"}
+
+// Import stores every rate of a CSV file in one batch, see ParseCSV
+func (s *exchangeRateService) Import(ctx context.Context, ownerID uuid.UUID, file io.Reader) (*response.ExchangeRateImportResponse, error) {
+	rates, err := ParseCSV(ownerID, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.exchangeRateRepository.Upsert(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	return &response.ExchangeRateImportResponse{Imported: len(rates)}, nil
+}
+
+// ParseCSV reads exchange rates from a CSV file whose header names the
+// base_currency, quote_currency, rate and effective_date columns in any
+// order. A blank effective_date means today. Every row must be valid; the
+// first invalid row is reported by its line number. A pair and date listed
+// twice keeps the last rate.
+func ParseCSV(ownerID uuid.UUID, file io.Reader) ([]entity.ExchangeRateEntity, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, apperror.New(apperror.ErrValidation, "invalid_import", "import file is empty")
+	}
+	if err != nil {
+		return nil, apperror.Errorf(apperror.ErrValidation, "invalid_import", "import file is not valid CSV: %w", err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range importColumns {
+		if _, ok := positions[column]; !ok {
+			return nil, apperror.Errorf(apperror.ErrValidation, "invalid_import", "import file is missing the %s column", column)
+		}
+	}
+
+	var rates []entity.ExchangeRateEntity
+	seen := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "invalid_import", "import file is not valid CSV: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		rate, err := build(ownerID,
+			record[positions["base_currency"]],
+			record[positions["quote_currency"]],
+			strings.TrimSpace(record[positions["rate"]]),
+			strings.TrimSpace(record[positions["effective_date"]]),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		key := rate.BaseCurrency + rate.QuoteCurrency + rate.EffectiveDate.Format(DateLayout)
+		if i, ok := seen[key]; ok {
+			rates[i] = *rate
+			continue
+		}
+		if len(rates) == MaxImportRows {
+			return nil, apperror.Errorf(apperror.ErrValidation, "invalid_import", "import file has more than %d rates", MaxImportRows)
+		}
+		seen[key] = len(rates)
+		rates = append(rates, *rate)
+	}
+
+	if len(rates) == 0 {
+		return nil, apperror.New(apperror.ErrValidation, "invalid_import", "import file has no rates")
+	}
+	return rates, nil
+}
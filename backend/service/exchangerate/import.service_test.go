@@ -0,0 +1,104 @@
+package exchangerate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestParseCSV(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name          string
+		file          string
+		expectedRates int
+		expectedError string
+	}{
+		{
+			name:          "should read rates in header order",
+			file:          "effective_date,rate,base_currency,quote_currency\n2024-01-02,1.0845,eur,usd\n2024-01-02,0.0066,JPY,USD\n",
+			expectedRates: 2,
+		},
+		{
+			name:          "should keep the last rate of a repeated pair and date",
+			file:          "base_currency,quote_currency,rate,effective_date\nEUR,USD,1.08,2024-01-02\nEUR,USD,1.09,2024-01-02\n",
+			expectedRates: 1,
+		},
+		{
+			name:          "should reject a missing column",
+			file:          "base_currency,quote_currency,rate\nEUR,USD,1.08\n",
+			expectedError: "missing the effective_date column",
+		},
+		{
+			name:          "should report the line of an invalid rate",
+			file:          "base_currency,quote_currency,rate,effective_date\nEUR,USD,1.08,2024-01-02\nEUR,GBP,abc,2024-01-02\n",
+			expectedError: "line 3",
+		},
+		{
+			name:          "should reject an unknown currency",
+			file:          "base_currency,quote_currency,rate,effective_date\nEUR,XXY,1.08,2024-01-02\n",
+			expectedError: "unknown currency",
+		},
+		{
+			name:          "should reject a file without rates",
+			file:          "base_currency,quote_currency,rate,effective_date\n",
+			expectedError: "no rates",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates, err := ParseCSV(ownerID, strings.NewReader(tt.file))
+
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rates) != tt.expectedRates {
+				t.Fatalf("expected %d rates, got %d", tt.expectedRates, len(rates))
+			}
+			for _, rate := range rates {
+				if rate.OwnerID != ownerID || rate.QuoteCurrency != "USD" {
+					t.Errorf("expected an owned rate quoted in USD, got %+v", rate)
+				}
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.New()
+	mockRepo := mock.NewMockExchangeRateRepository(ctrl)
+	mockRepo.EXPECT().
+		Upsert(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rates []entity.ExchangeRateEntity) error {
+			if len(rates) != 1 || rates[0].Rate != "1.5" {
+				t.Errorf("expected a single normalised rate, got %+v", rates)
+			}
+			return nil
+		}).
+		Times(1)
+
+	svc := NewExchangeRateService(mockRepo)
+	result, err := svc.Import(context.Background(), ownerID, strings.NewReader("base_currency,quote_currency,rate,effective_date\nGBP,USD,1.5000,2024-01-02\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Imported != 1 {
+		t.Errorf("expected 1 imported rate, got %d", result.Imported)
+	}
+}
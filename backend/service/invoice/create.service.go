@@ -2,50 +2,81 @@ package invoice
 
 import (
 	"context"
-	"errors"
 
+	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
-// Create creates a new invoice
-func (s *invoiceService) Create(ctx context.Context, req *request.CreateInvoiceRequest) (*response.InvoiceDetailResponse, error) {
+// Create creates a new invoice owned by ownerID
+func (s *invoiceService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateInvoiceRequest) (*response.InvoiceDetailResponse, error) {
 	if len(req.Items) == 0 {
-		return nil, errors.New("at least one item is required")
+		return nil, ErrItemsRequired
 	}
 
-	// Build invoice items
-	invoiceItems := make([]entity.InvoiceItemEntity, len(req.Items))
-	for i, item := range req.Items {
-		totalPrice := float64(item.Quantity) * item.UnitPrice
-		invoiceItems[i] = entity.InvoiceItemEntity{
-			ItemID:     item.ItemID,
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			TotalPrice: totalPrice,
-		}
+	invoiceCurrency, err := resolveCurrency(req.Currency, s.config.BaseCurrency)
+	if err != nil {
+		return nil, err
 	}
 
-	// Build tags
-	tags := make([]entity.TagEntity, len(req.Tags))
-	for i, tagID := range req.Tags {
-		tags[i] = entity.TagEntity{ID: tagID}
-	}
+	var created *entity.InvoiceEntity
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		invoiceID := uuid.New()
 
-	invoice := entity.InvoiceEntity{
-		GrandPrice: req.GrandPrice,
-		Items:      invoiceItems,
-		Tags:       tags,
-	}
+		customer, err := s.findCustomer(ctx, ownerID, req.CustomerID)
+		if err != nil {
+			return err
+		}
 
-	id, err := s.invoiceRepository.Create(ctx, invoice)
-	if err != nil {
-		return nil, err
-	}
+		// Build invoice items
+		invoiceItems, err := s.buildInvoiceItems(ctx, ownerID, invoiceID, req.Items)
+		if err != nil {
+			return err
+		}
+
+		// Build tags
+		tags, err := s.findOwnedTags(ctx, ownerID, req.Tags)
+		if err != nil {
+			return err
+		}
+
+		invoice := entity.InvoiceEntity{
+			ID:         invoiceID,
+			OwnerID:    ownerID,
+			Status:     entity.InvoiceStatusDraft,
+			CustomerID: &customer.ID,
+			Currency:   invoiceCurrency,
+			Items:      invoiceItems,
+			Tags:       tags,
+		}
+
+		// The totals are always derived from the lines
+		if err := s.applyInvoiceTax(ctx, ownerID, &invoice, req.TaxRateID); err != nil {
+			return err
+		}
+		if err := priceInvoice(&invoice); err != nil {
+			return err
+		}
+		if err := checkGrandPrice(req.GrandPrice, invoice.GrandPrice); err != nil {
+			return err
+		}
+
+		id, err := s.invoiceRepository.Create(ctx, invoice)
+		if err != nil {
+			return err
+		}
 
-	// Fetch created invoice with all relations
-	created, err := s.invoiceRepository.FindByID(ctx, *id)
+		// Fetch created invoice with all relations
+		created, err = s.invoiceRepository.FindByID(ctx, ownerID, *id)
+		if err != nil {
+			return err
+		}
+		if created == nil {
+			return ErrInvoiceNotFound
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -53,6 +84,29 @@ func (s *invoiceService) Create(ctx context.Context, req *request.CreateInvoiceR
 	return s.toDetailResponse(created), nil
 }
 
+// billTo returns who the invoice is billed to: the snapshot taken on issue,
+// or the customer's current details while it has not been issued
+func billTo(invoice *entity.InvoiceEntity) *response.InvoiceCustomerResponse {
+	if invoice.IssuedAt != nil && invoice.CustomerID != nil {
+		return &response.InvoiceCustomerResponse{
+			Name:           invoice.BillTo.Name,
+			Email:          invoice.BillTo.Email,
+			BillingAddress: invoice.BillTo.BillingAddress,
+			TaxID:          invoice.BillTo.TaxID,
+		}
+	}
+	if invoice.Customer == nil {
+		return nil
+	}
+
+	return &response.InvoiceCustomerResponse{
+		Name:           invoice.Customer.Name,
+		Email:          invoice.Customer.Email,
+		BillingAddress: invoice.Customer.BillingAddress,
+		TaxID:          invoice.Customer.TaxID,
+	}
+}
+
 func (s *invoiceService) toDetailResponse(invoice *entity.InvoiceEntity) *response.InvoiceDetailResponse {
 	items := make([]response.InvoiceItemResponse, len(invoice.Items))
 	for i, item := range invoice.Items {
@@ -64,9 +118,16 @@ func (s *invoiceService) toDetailResponse(invoice *entity.InvoiceEntity) *respon
 				Name: item.Item.Name,
 				Desc: item.Item.Desc,
 			},
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			TotalPrice: item.TotalPrice,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			Subtotal:       item.Subtotal,
+			DiscountType:   string(item.DiscountType),
+			DiscountValue:  item.DiscountValue,
+			DiscountAmount: item.DiscountAmount,
+			TaxRateID:      item.TaxRateID,
+			TaxRate:        item.TaxRate,
+			TaxAmount:      item.TaxAmount,
+			TotalPrice:     item.TotalPrice,
 		}
 	}
 
@@ -79,12 +140,36 @@ func (s *invoiceService) toDetailResponse(invoice *entity.InvoiceEntity) *respon
 		}
 	}
 
+	outstanding, credit := balances(invoice)
+
 	return &response.InvoiceDetailResponse{
-		ID:         invoice.ID,
-		GrandPrice: invoice.GrandPrice,
-		Items:      items,
-		Tags:       tags,
-		CreatedAt:  invoice.CreatedAt,
-		UpdatedAt:  invoice.UpdatedAt,
+		ID:                 invoice.ID,
+		Number:             invoice.Number,
+		Status:             string(invoice.Status),
+		CustomerID:         invoice.CustomerID,
+		Customer:           billTo(invoice),
+		Currency:           invoice.Currency,
+		Subtotal:           invoice.Subtotal,
+		DiscountTotal:      invoice.DiscountTotal,
+		TaxRateID:          invoice.TaxRateID,
+		TaxRate:            invoice.TaxRate,
+		TaxTotal:           invoice.TaxTotal,
+		GrandPrice:         invoice.GrandPrice,
+		AmountPaid:         invoice.AmountPaid,
+		CreditedTotal:      invoice.CreditedTotal,
+		OutstandingBalance: outstanding,
+		CreditBalance:      credit,
+		BaseCurrency:       invoice.BaseCurrency,
+		ExchangeRate:       invoice.ExchangeRate,
+		BaseGrandPrice:     invoice.BaseGrandPrice,
+		IssuedAt:           invoice.IssuedAt,
+		PaidAt:             invoice.PaidAt,
+		VoidedAt:           invoice.VoidedAt,
+		Items:              items,
+		Tags:               tags,
+		Payments:           toPaymentResponses(invoice.Payments),
+		CreditNotes:        toCreditNoteResponses(invoice.CreditNotes),
+		CreatedAt:          invoice.CreatedAt,
+		UpdatedAt:          invoice.UpdatedAt,
 	}
 }
@@ -0,0 +1,235 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestCreate(t *testing.T) {
+	ownerID := uuid.New()
+	customerID := uuid.New()
+	itemID := uuid.New()
+	tagID := uuid.New()
+	matchingTotal := int64(2000)
+	mismatchingTotal := int64(1999)
+
+	tests := []struct {
+		name             string
+		storedCustomer   *entity.CustomerEntity
+		storedItem       *entity.ItemEntity
+		storedTag        *entity.TagEntity
+		grandPrice       *int64
+		expectTagLookup  bool
+		expectCreate     bool
+		expectedError    bool
+		expectedErrorMsg string
+	}{
+		{
+			name:            "should create an invoice from the caller's items and tags",
+			storedCustomer:  &entity.CustomerEntity{ID: customerID, OwnerID: ownerID},
+			storedItem:      &entity.ItemEntity{ID: itemID, OwnerID: ownerID},
+			storedTag:       &entity.TagEntity{ID: tagID, OwnerID: ownerID},
+			expectTagLookup: true,
+			expectCreate:    true,
+			expectedError:   false,
+		},
+		{
+			name:            "should accept a grand price that matches the lines",
+			storedCustomer:  &entity.CustomerEntity{ID: customerID, OwnerID: ownerID},
+			storedItem:      &entity.ItemEntity{ID: itemID, OwnerID: ownerID},
+			storedTag:       &entity.TagEntity{ID: tagID, OwnerID: ownerID},
+			grandPrice:      &matchingTotal,
+			expectTagLookup: true,
+			expectCreate:    true,
+			expectedError:   false,
+		},
+		{
+			name:             "should reject a grand price that does not match the lines",
+			storedCustomer:   &entity.CustomerEntity{ID: customerID, OwnerID: ownerID},
+			storedItem:       &entity.ItemEntity{ID: itemID, OwnerID: ownerID},
+			storedTag:        &entity.TagEntity{ID: tagID, OwnerID: ownerID},
+			grandPrice:       &mismatchingTotal,
+			expectTagLookup:  true,
+			expectedError:    true,
+			expectedErrorMsg: "grand_price does not match the invoice lines: expected 2000, got 1999",
+		},
+		{
+			name:             "should reject another user's customer",
+			storedCustomer:   nil,
+			expectedError:    true,
+			expectedErrorMsg: "customer " + customerID.String() + " not found",
+		},
+		{
+			name:             "should reject another user's item",
+			storedCustomer:   &entity.CustomerEntity{ID: customerID, OwnerID: ownerID},
+			storedItem:       nil,
+			expectedError:    true,
+			expectedErrorMsg: "item " + itemID.String() + " not found",
+		},
+		{
+			name:             "should reject another user's tag",
+			storedCustomer:   &entity.CustomerEntity{ID: customerID, OwnerID: ownerID},
+			storedItem:       &entity.ItemEntity{ID: itemID, OwnerID: ownerID},
+			storedTag:        nil,
+			expectTagLookup:  true,
+			expectedError:    true,
+			expectedErrorMsg: "tag " + tagID.String() + " not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockCustomerRepo := mock.NewMockCustomerRepository(ctrl)
+			mockItemRepo := mock.NewMockItemRepository(ctrl)
+			mockTagRepo := mock.NewMockTagRepository(ctrl)
+			mockTaxRateRepo := mock.NewMockTaxRateRepository(ctrl)
+
+			mockCustomerRepo.EXPECT().
+				FindByID(gomock.Any(), ownerID, customerID).
+				Return(tt.storedCustomer, nil).
+				Times(1)
+			if tt.storedCustomer != nil {
+				mockItemRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, itemID).
+					Return(tt.storedItem, nil).
+					Times(1)
+			}
+			if tt.expectTagLookup {
+				mockTagRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, tagID).
+					Return(tt.storedTag, nil).
+					Times(1)
+			}
+			if tt.expectCreate {
+				mockInvoiceRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, invoice entity.InvoiceEntity) (*uuid.UUID, error) {
+						if invoice.OwnerID != ownerID {
+							t.Errorf("expected owner %v, got %v", ownerID, invoice.OwnerID)
+						}
+						if invoice.CustomerID == nil || *invoice.CustomerID != customerID {
+							t.Errorf("expected customer %v, got %v", customerID, invoice.CustomerID)
+						}
+						if invoice.GrandPrice != 2000 {
+							t.Errorf("expected computed grand price 2000, got %d", invoice.GrandPrice)
+						}
+						return &invoice.ID, nil
+					}).
+					Times(1)
+				mockInvoiceRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, gomock.Any()).
+					Return(&entity.InvoiceEntity{ID: uuid.New(), OwnerID: ownerID}, nil).
+					Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mockCustomerRepo, mockItemRepo, mockTagRepo, mockTaxRateRepo, mock.NewMockExchangeRateRepository(ctrl), newMockUnitOfWork(ctrl), InvoiceConfig{})
+			result, err := svc.Create(context.Background(), ownerID, &request.CreateInvoiceRequest{
+				CustomerID: customerID,
+				GrandPrice: tt.grandPrice,
+				Items:      []request.InvoiceItemInput{{ItemID: itemID, Quantity: 2, UnitPrice: 1000}},
+				Tags:       []uuid.UUID{tagID},
+			})
+
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				if err.Error() != tt.expectedErrorMsg {
+					t.Errorf("expected error %q, got %q", tt.expectedErrorMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result == nil {
+				t.Errorf("expected result, got nil")
+			}
+		})
+	}
+}
+
+func TestCreateAppliesDiscountsAndTax(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.New()
+	customerID := uuid.New()
+	itemID := uuid.New()
+	vatID := uuid.New()
+	reducedID := uuid.New()
+
+	mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+	mockCustomerRepo := mock.NewMockCustomerRepository(ctrl)
+	mockItemRepo := mock.NewMockItemRepository(ctrl)
+	mockTaxRateRepo := mock.NewMockTaxRateRepository(ctrl)
+
+	mockCustomerRepo.EXPECT().
+		FindByID(gomock.Any(), ownerID, customerID).
+		Return(&entity.CustomerEntity{ID: customerID, OwnerID: ownerID}, nil).
+		Times(1)
+	mockItemRepo.EXPECT().
+		FindByID(gomock.Any(), ownerID, itemID).
+		Return(&entity.ItemEntity{ID: itemID, OwnerID: ownerID}, nil).
+		Times(2)
+	mockTaxRateRepo.EXPECT().
+		FindByID(gomock.Any(), ownerID, reducedID).
+		Return(&entity.TaxRateEntity{ID: reducedID, OwnerID: ownerID, Rate: 500}, nil).
+		Times(1)
+	mockTaxRateRepo.EXPECT().
+		FindByID(gomock.Any(), ownerID, vatID).
+		Return(&entity.TaxRateEntity{ID: vatID, OwnerID: ownerID, Rate: 2000}, nil).
+		Times(1)
+
+	var stored entity.InvoiceEntity
+	mockInvoiceRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, invoice entity.InvoiceEntity) (*uuid.UUID, error) {
+			stored = invoice
+			return &invoice.ID, nil
+		}).
+		Times(1)
+	mockInvoiceRepo.EXPECT().
+		FindByID(gomock.Any(), ownerID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ uuid.UUID) (*entity.InvoiceEntity, error) {
+			return &stored, nil
+		}).
+		Times(1)
+
+	svc := NewInvoiceService(mockInvoiceRepo, mockCustomerRepo, mockItemRepo, mock.NewMockTagRepository(ctrl), mockTaxRateRepo, mock.NewMockExchangeRateRepository(ctrl), newMockUnitOfWork(ctrl), InvoiceConfig{})
+	result, err := svc.Create(context.Background(), ownerID, &request.CreateInvoiceRequest{
+		CustomerID: customerID,
+		TaxRateID:  &vatID,
+		Items: []request.InvoiceItemInput{
+			// 2 × 1000 less 10% = 1800, taxed at the invoice rate
+			{ItemID: itemID, Quantity: 2, UnitPrice: 1000, DiscountType: "percent", DiscountValue: 1000},
+			// 1 × 999 less 99 = 900, taxed at its own 5% = 45
+			{ItemID: itemID, Quantity: 1, UnitPrice: 999, DiscountType: "fixed", DiscountValue: 99, TaxRateID: &reducedID},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Subtotal != 2999 || result.DiscountTotal != 299 || result.TaxTotal != 405 || result.GrandPrice != 3105 {
+		t.Errorf("expected subtotal 2999, discount 299, tax 405 and total 3105, got %d, %d, %d and %d",
+			result.Subtotal, result.DiscountTotal, result.TaxTotal, result.GrandPrice)
+	}
+	if result.TaxRate != 2000 || result.TaxRateID == nil || *result.TaxRateID != vatID {
+		t.Errorf("expected the invoice-level rate to be recorded, got %v at %d", result.TaxRateID, result.TaxRate)
+	}
+	if line := result.Items[1]; line.TaxAmount != 45 || line.TotalPrice != 945 {
+		t.Errorf("expected line tax 45 and total 945, got %d and %d", line.TaxAmount, line.TotalPrice)
+	}
+}
@@ -0,0 +1,304 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// CreateCreditNote credits lines of an issued invoice under the next credit
+// note number and settles the invoice against what it still owes, so an
+// invoice credited in full or already paid for the rest becomes paid.
+func (s *invoiceService) CreateCreditNote(ctx context.Context, ownerID, id uuid.UUID, req *request.CreateCreditNoteRequest) (*response.InvoiceDetailResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, apperror.New(apperror.ErrValidation, "reason_required", "reason is required")
+	}
+	if len(req.Lines) == 0 {
+		return nil, apperror.New(apperror.ErrValidation, "lines_required", "at least one line is required")
+	}
+
+	var result *entity.InvoiceEntity
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		locked, err := s.invoiceRepository.LockByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			return ErrInvoiceNotFound
+		}
+		if !acceptsCreditNote(locked.Status) {
+			return ErrInvalidTransition
+		}
+
+		// Earlier credit notes cannot change while the invoice is locked
+		invoice, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if invoice == nil {
+			return ErrInvoiceNotFound
+		}
+
+		note, err := buildCreditNote(invoice, req.Lines)
+		if err != nil {
+			return err
+		}
+		issuedAt := time.Now()
+		note.ID = uuid.New()
+		note.OwnerID = ownerID
+		note.InvoiceID = invoice.ID
+		note.Reason = reason
+		note.IssuedAt = issuedAt
+		if err := s.invoiceRepository.CreateCreditNote(ctx, note, func(seq int64) string {
+			return formatNumber(s.config.CreditNoteNumberFormat, issuedAt, seq)
+		}); err != nil {
+			return err
+		}
+
+		from := locked.Status
+		locked.CreditedTotal += note.Total
+		settle(locked, locked.AmountPaid, issuedAt)
+		if locked.Status != from {
+			if !canTransition(from, locked.Status) {
+				return ErrInvalidTransition
+			}
+			updated, err := s.invoiceRepository.UpdateStatus(ctx, ownerID, id, from, *locked)
+			if err != nil {
+				return err
+			}
+			if !updated {
+				return ErrInvalidTransition
+			}
+		}
+
+		result, err = s.invoiceRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			return ErrInvoiceNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDetailResponse(result), nil
+}
+
+// GetCreditNotes lists an invoice's credit notes in the order they were issued
+func (s *invoiceService) GetCreditNotes(ctx context.Context, ownerID, id uuid.UUID) ([]response.CreditNoteResponse, error) {
+	invoice, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return toCreditNoteResponses(invoice.CreditNotes), nil
+}
+
+// acceptsCreditNote reports whether an invoice in status may be credited.
+// Drafts are edited instead and void invoices owe nothing.
+func acceptsCreditNote(status entity.InvoiceStatus) bool {
+	return status == entity.InvoiceStatusIssued || status == entity.InvoiceStatusPartiallyPaid ||
+		status == entity.InvoiceStatusPaid
+}
+
+// creditedLine is how much of an invoice line earlier credit notes credited
+type creditedLine struct {
+	quantity  int
+	amount    int64
+	taxAmount int64
+}
+
+// buildCreditNote prices the lines credited by inputs against the invoice
+// and what its earlier credit notes already credited. A line's amounts are
+// its own pro rata share, and the credit for the last units of a line is
+// whatever of it is left, so crediting a line in parts adds up exactly.
+// Tax at the invoice's rate is applied once to the note as on the invoice.
+func buildCreditNote(invoice *entity.InvoiceEntity, inputs []request.CreditNoteLineInput) (entity.CreditNoteEntity, error) {
+	credited := make(map[uuid.UUID]creditedLine)
+	for _, note := range invoice.CreditNotes {
+		for _, line := range note.Lines {
+			prior := credited[line.InvoiceItemID]
+			prior.quantity += line.Quantity
+			prior.amount += line.Amount
+			prior.taxAmount += line.TaxAmount
+			credited[line.InvoiceItemID] = prior
+		}
+	}
+
+	items := make(map[uuid.UUID]entity.InvoiceItemEntity, len(invoice.Items))
+	for _, item := range invoice.Items {
+		items[item.ID] = item
+	}
+
+	var note entity.CreditNoteEntity
+	var untaxed int64
+	var err error
+	seen := make(map[uuid.UUID]bool, len(inputs))
+	for i, input := range inputs {
+		item, ok := items[input.InvoiceItemID]
+		if !ok {
+			return note, apperror.Errorf(apperror.ErrValidation, "unknown_invoice_item", "invoice item %s not found", input.InvoiceItemID)
+		}
+		if input.Quantity <= 0 {
+			return note, ErrInvalidQuantity
+		}
+		if seen[item.ID] {
+			return note, apperror.Errorf(apperror.ErrValidation, "duplicate_line", "invoice item %s is credited more than once", item.ID)
+		}
+		seen[item.ID] = true
+
+		prior := credited[item.ID]
+		if left := item.Quantity - prior.quantity; input.Quantity > left {
+			return note, fmt.Errorf("%w: invoice item %s has %d left to credit", ErrCreditExceedsInvoice, item.ID, left)
+		}
+
+		line, err := creditLine(item, input.Quantity, prior)
+		if err != nil {
+			return note, err
+		}
+		line.ID = uuid.New()
+		line.Position = i
+		note.Lines = append(note.Lines, line)
+
+		if note.Subtotal, err = addAmounts(note.Subtotal, line.Amount); err != nil {
+			return note, err
+		}
+		if note.TaxTotal, err = addAmounts(note.TaxTotal, line.TaxAmount); err != nil {
+			return note, err
+		}
+		if item.TaxRateID == nil {
+			untaxed += line.Amount
+		}
+	}
+
+	if invoice.TaxRateID != nil {
+		tax, err := applyRate(untaxed, invoice.TaxRate)
+		if err != nil {
+			return note, err
+		}
+		if note.TaxTotal, err = addAmounts(note.TaxTotal, tax); err != nil {
+			return note, err
+		}
+	}
+	if note.Total, err = addAmounts(note.Subtotal, note.TaxTotal); err != nil {
+		return note, err
+	}
+
+	// Rounding the invoice's tax separately must never credit more than it
+	// owes, and crediting what is left of every line credits the rest of it
+	left := invoice.GrandPrice - invoice.CreditedTotal
+	if note.Total > left || creditsEveryLine(invoice, inputs) {
+		note.Total = left
+		note.TaxTotal = left - note.Subtotal
+	}
+
+	return note, nil
+}
+
+// creditsEveryLine reports whether inputs, together with the invoice's
+// earlier credit notes, credit the full quantity of every line
+func creditsEveryLine(invoice *entity.InvoiceEntity, inputs []request.CreditNoteLineInput) bool {
+	quantities := make(map[uuid.UUID]int, len(inputs))
+	for _, input := range inputs {
+		quantities[input.InvoiceItemID] = input.Quantity
+	}
+
+	for _, note := range invoice.CreditNotes {
+		for _, line := range note.Lines {
+			quantities[line.InvoiceItemID] += line.Quantity
+		}
+	}
+	for _, item := range invoice.Items {
+		if quantities[item.ID] != item.Quantity {
+			return false
+		}
+	}
+
+	return true
+}
+
+// creditLine prices quantity units of an invoice line, given what earlier
+// credit notes credited of it
+func creditLine(item entity.InvoiceItemEntity, quantity int, prior creditedLine) (entity.CreditNoteLineEntity, error) {
+	line := entity.CreditNoteLineEntity{
+		InvoiceItemID: item.ID,
+		Quantity:      quantity,
+	}
+
+	net := item.Subtotal - item.DiscountAmount
+	if prior.quantity+quantity == item.Quantity {
+		line.Amount = net - prior.amount
+		line.TaxAmount = item.TaxAmount - prior.taxAmount
+	} else {
+		var err error
+		if line.Amount, err = prorate(net, quantity, item.Quantity); err != nil {
+			return line, err
+		}
+		if line.TaxAmount, err = prorate(item.TaxAmount, quantity, item.Quantity); err != nil {
+			return line, err
+		}
+	}
+
+	total, err := addAmounts(line.Amount, line.TaxAmount)
+	if err != nil {
+		return line, err
+	}
+	line.Total = total
+	return line, nil
+}
+
+// prorate returns amount × part / whole, rounding halves up to the next
+// minor unit
+func prorate(amount int64, part, whole int) (int64, error) {
+	if part != 0 && amount > (math.MaxInt64-int64(whole)/2)/int64(part) {
+		return 0, apperror.New(apperror.ErrValidation, "amount_too_large", "amount is too large")
+	}
+
+	return (amount*int64(part) + int64(whole)/2) / int64(whole), nil
+}
+
+// toCreditNoteResponses maps credit notes to their API representation
+func toCreditNoteResponses(notes []entity.CreditNoteEntity) []response.CreditNoteResponse {
+	result := make([]response.CreditNoteResponse, len(notes))
+	for i, note := range notes {
+		lines := make([]response.CreditNoteLineResponse, len(note.Lines))
+		for j, line := range note.Lines {
+			lines[j] = response.CreditNoteLineResponse{
+				ID:            line.ID,
+				InvoiceItemID: line.InvoiceItemID,
+				Quantity:      line.Quantity,
+				Amount:        line.Amount,
+				TaxAmount:     line.TaxAmount,
+				Total:         line.Total,
+			}
+		}
+
+		result[i] = response.CreditNoteResponse{
+			ID:        note.ID,
+			Number:    note.Number,
+			Reason:    note.Reason,
+			Subtotal:  note.Subtotal,
+			TaxTotal:  note.TaxTotal,
+			Total:     note.Total,
+			IssuedAt:  note.IssuedAt,
+			Lines:     lines,
+			CreatedAt: note.CreatedAt,
+		}
+	}
+	return result
+}
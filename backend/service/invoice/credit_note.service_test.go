@@ -0,0 +1,305 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+// creditableInvoice returns an issued invoice with a 3 × 1001 line taxed at
+// its own 10% rate and a 1 × 500 line taxed at the invoice's 12.5% rate
+func creditableInvoice() *entity.InvoiceEntity {
+	taxRateID := uuid.New()
+	return &entity.InvoiceEntity{
+		ID:         uuid.New(),
+		Status:     entity.InvoiceStatusIssued,
+		TaxRateID:  &taxRateID,
+		TaxRate:    1250,
+		Subtotal:   3503,
+		TaxTotal:   363,
+		GrandPrice: 3866,
+		Items: []entity.InvoiceItemEntity{
+			{ID: uuid.New(), Quantity: 3, UnitPrice: 1001, Subtotal: 3003, TaxRateID: &taxRateID, TaxRate: 1000, TaxAmount: 300, TotalPrice: 3303},
+			{ID: uuid.New(), Quantity: 1, UnitPrice: 500, Subtotal: 500, TotalPrice: 500},
+		},
+	}
+}
+
+func TestBuildCreditNote(t *testing.T) {
+	invoice := creditableInvoice()
+	taxed, untaxed := invoice.Items[0].ID, invoice.Items[1].ID
+
+	tests := []struct {
+		name             string
+		prior            []entity.CreditNoteLineEntity
+		lines            []request.CreditNoteLineInput
+		expectedSubtotal int64
+		expectedTax      int64
+		expectedTotal    int64
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name:             "should credit a share of a line with its own tax",
+			lines:            []request.CreditNoteLineInput{{InvoiceItemID: taxed, Quantity: 1}},
+			expectedSubtotal: 1001,
+			expectedTax:      100,
+			expectedTotal:    1101,
+		},
+		{
+			name:             "should credit what is left of a line credited before",
+			prior:            []entity.CreditNoteLineEntity{{InvoiceItemID: taxed, Quantity: 2, Amount: 2002, TaxAmount: 200}},
+			lines:            []request.CreditNoteLineInput{{InvoiceItemID: taxed, Quantity: 1}},
+			expectedSubtotal: 1001,
+			expectedTax:      100,
+			expectedTotal:    1101,
+		},
+		{
+			name:             "should apply the invoice's tax rate to lines without their own",
+			lines:            []request.CreditNoteLineInput{{InvoiceItemID: untaxed, Quantity: 1}},
+			expectedSubtotal: 500,
+			expectedTax:      63,
+			expectedTotal:    563,
+		},
+		{
+			name:             "should credit the rest of the invoice when every line is credited in full",
+			prior:            []entity.CreditNoteLineEntity{{InvoiceItemID: taxed, Quantity: 1, Amount: 1001, TaxAmount: 100}},
+			lines:            []request.CreditNoteLineInput{{InvoiceItemID: taxed, Quantity: 2}, {InvoiceItemID: untaxed, Quantity: 1}},
+			expectedSubtotal: 2502,
+			expectedTax:      263,
+			expectedTotal:    2765,
+		},
+		{
+			name:          "should reject crediting more than is left of a line",
+			prior:         []entity.CreditNoteLineEntity{{InvoiceItemID: taxed, Quantity: 2, Amount: 2002, TaxAmount: 200}},
+			lines:         []request.CreditNoteLineInput{{InvoiceItemID: taxed, Quantity: 2}},
+			expectedError: ErrCreditExceedsInvoice,
+		},
+		{
+			name:        "should reject a line of another invoice",
+			lines:       []request.CreditNoteLineInput{{InvoiceItemID: uuid.New(), Quantity: 1}},
+			expectError: true,
+		},
+		{
+			name:        "should reject crediting a line twice in one note",
+			lines:       []request.CreditNoteLineInput{{InvoiceItemID: taxed, Quantity: 1}, {InvoiceItemID: taxed, Quantity: 1}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := *invoice
+			stored.CreditedTotal = 0
+			stored.CreditNotes = nil
+			if tt.prior != nil {
+				var total int64
+				for _, line := range tt.prior {
+					total += line.Amount + line.TaxAmount
+				}
+				stored.CreditedTotal = total
+				stored.CreditNotes = []entity.CreditNoteEntity{{Total: total, Lines: tt.prior}}
+			}
+
+			note, err := buildCreditNote(&stored, tt.lines)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if note.Subtotal != tt.expectedSubtotal || note.TaxTotal != tt.expectedTax || note.Total != tt.expectedTotal {
+				t.Errorf("expected %d + %d = %d, got %d + %d = %d", tt.expectedSubtotal, tt.expectedTax, tt.expectedTotal,
+					note.Subtotal, note.TaxTotal, note.Total)
+			}
+			if len(note.Lines) != len(tt.lines) {
+				t.Errorf("expected %d lines, got %d", len(tt.lines), len(note.Lines))
+			}
+		})
+	}
+}
+
+func TestCreateCreditNote(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name                string
+		status              entity.InvoiceStatus
+		amountPaid          int64
+		lines               func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput
+		expectCreate        bool
+		expectUpdate        bool
+		expectedStatus      entity.InvoiceStatus
+		expectedOutstanding int64
+		expectedCredit      int64
+		expectedError       error
+	}{
+		{
+			name:   "should reduce the outstanding balance of an issued invoice",
+			status: entity.InvoiceStatusIssued,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectCreate:        true,
+			expectedStatus:      entity.InvoiceStatusIssued,
+			expectedOutstanding: 3303,
+		},
+		{
+			name:   "should settle an invoice credited in full",
+			status: entity.InvoiceStatusIssued,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[0].ID, Quantity: 3}, {InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectCreate:   true,
+			expectUpdate:   true,
+			expectedStatus: entity.InvoiceStatusPaid,
+		},
+		{
+			name:       "should settle a partially paid invoice whose rest is credited",
+			status:     entity.InvoiceStatusPartiallyPaid,
+			amountPaid: 3303,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectCreate:   true,
+			expectUpdate:   true,
+			expectedStatus: entity.InvoiceStatusPaid,
+		},
+		{
+			name:       "should turn a credited paid invoice into credit",
+			status:     entity.InvoiceStatusPaid,
+			amountPaid: 3866,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectCreate:   true,
+			expectedStatus: entity.InvoiceStatusPaid,
+			expectedCredit: 563,
+		},
+		{
+			name:   "should reject crediting a draft",
+			status: entity.InvoiceStatusDraft,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:   "should reject crediting a void invoice",
+			status: entity.InvoiceStatusVoid,
+			lines: func(invoice *entity.InvoiceEntity) []request.CreditNoteLineInput {
+				return []request.CreditNoteLineInput{{InvoiceItemID: invoice.Items[1].ID, Quantity: 1}}
+			},
+			expectedError: ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			invoice := creditableInvoice()
+			invoice.OwnerID = ownerID
+			invoice.Status = tt.status
+			invoice.AmountPaid = tt.amountPaid
+			locked := *invoice
+			locked.Items = nil
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockInvoiceRepo.EXPECT().
+				LockByID(gomock.Any(), ownerID, invoice.ID).
+				Return(&locked, nil).
+				Times(1)
+
+			var created entity.CreditNoteEntity
+			if tt.expectCreate {
+				mockInvoiceRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, invoice.ID).
+					Return(invoice, nil).
+					Times(1)
+				mockInvoiceRepo.EXPECT().
+					CreateCreditNote(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, note entity.CreditNoteEntity, formatNumber func(seq int64) string) error {
+						if note.InvoiceID != invoice.ID || note.OwnerID != ownerID || note.Reason != "Damaged goods" {
+							t.Errorf("credit note not linked to the invoice, owner and reason: %+v", note)
+						}
+						if number := formatNumber(7); number != "CN-"+time.Now().Format("2006")+"-000007" {
+							t.Errorf("unexpected credit note number %q", number)
+						}
+						created = note
+						return nil
+					}).
+					Times(1)
+			}
+			if tt.expectUpdate {
+				mockInvoiceRepo.EXPECT().
+					UpdateStatus(gomock.Any(), ownerID, invoice.ID, tt.status, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, _ entity.InvoiceStatus, updated entity.InvoiceEntity) (bool, error) {
+						if updated.Status != tt.expectedStatus || updated.PaidAt == nil {
+							t.Errorf("expected status %q with a paid date, got %q (%v)", tt.expectedStatus, updated.Status, updated.PaidAt)
+						}
+						return true, nil
+					}).
+					Times(1)
+			}
+			if tt.expectCreate {
+				mockInvoiceRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, invoice.ID).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID) (*entity.InvoiceEntity, error) {
+						result := *invoice
+						result.Status = tt.expectedStatus
+						result.CreditedTotal = created.Total
+						result.CreditNotes = []entity.CreditNoteEntity{created}
+						return &result, nil
+					}).
+					Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mock.NewMockExchangeRateRepository(ctrl), newMockUnitOfWork(ctrl), InvoiceConfig{})
+			result, err := svc.CreateCreditNote(context.Background(), ownerID, invoice.ID, &request.CreateCreditNoteRequest{
+				Reason: " Damaged goods ",
+				Lines:  tt.lines(invoice),
+			})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != string(tt.expectedStatus) {
+				t.Errorf("expected status %q, got %q", tt.expectedStatus, result.Status)
+			}
+			if result.OutstandingBalance != tt.expectedOutstanding {
+				t.Errorf("expected outstanding balance %d, got %d", tt.expectedOutstanding, result.OutstandingBalance)
+			}
+			if result.CreditBalance != tt.expectedCredit {
+				t.Errorf("expected credit balance %d, got %d", tt.expectedCredit, result.CreditBalance)
+			}
+			if len(result.CreditNotes) != 1 || result.CreditedTotal != created.Total {
+				t.Errorf("expected the credit note in the response, got %+v", result.CreditNotes)
+			}
+		})
+	}
+}
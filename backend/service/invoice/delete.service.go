@@ -6,13 +6,15 @@ import (
 	"github.com/google/uuid"
 )
 
-// Delete deletes an invoice
-func (s *invoiceService) Delete(ctx context.Context, id uuid.UUID) error {
-	// Check if invoice exists
-	_, err := s.invoiceRepository.FindByID(ctx, id)
-	if err != nil {
-		return err
-	}
+// Delete deletes a draft invoice. The invoice stays locked until it is
+// deleted, so an invoice issued meanwhile is never deleted.
+func (s *invoiceService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	return s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Check if invoice exists and is still a draft
+		if _, err := s.findEditable(ctx, ownerID, id); err != nil {
+			return err
+		}
 
-	return s.invoiceRepository.Delete(ctx, id)
+		return s.invoiceRepository.Delete(ctx, ownerID, id)
+	})
 }
@@ -0,0 +1,69 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestDelete(t *testing.T) {
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+
+	tests := []struct {
+		name          string
+		locked        *entity.InvoiceEntity
+		expectDelete  bool
+		expectedError error
+	}{
+		{
+			name:         "should delete a draft while it is locked",
+			locked:       &entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, Status: entity.InvoiceStatusDraft},
+			expectDelete: true,
+		},
+		{
+			name:          "should reject an issued invoice",
+			locked:        &entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, Status: entity.InvoiceStatusIssued},
+			expectedError: ErrInvoiceNotEditable,
+		},
+		{
+			name:          "should return not found for another owner's invoice",
+			expectedError: ErrInvoiceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			unitOfWork := mock.NewMockUnitOfWork(ctrl)
+			unitOfWork.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(context.WithValue(ctx, txMarker{}, true))
+				}).
+				Times(1)
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockInvoiceRepo.EXPECT().
+				LockByID(inTx{}, ownerID, invoiceID).
+				Return(tt.locked, nil).
+				Times(1)
+			if tt.expectDelete {
+				mockInvoiceRepo.EXPECT().Delete(inTx{}, ownerID, invoiceID).Return(nil).Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mock.NewMockExchangeRateRepository(ctrl), unitOfWork, InvoiceConfig{})
+
+			err := svc.Delete(context.Background(), ownerID, invoiceID)
+			if err != tt.expectedError {
+				t.Errorf("expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+)
+
+// DefaultBaseCurrency is used when no base currency is configured
+const DefaultBaseCurrency = "USD"
+
+// resolveCurrency validates a requested invoice currency, falling back to
+// fallback when none was sent
+func resolveCurrency(requested, fallback string) (string, error) {
+	if requested == "" {
+		return fallback, nil
+	}
+
+	code, err := currency.Normalize(requested)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidCurrency, err)
+	}
+	return code, nil
+}
+
+// exchangeRate finds the owner's rate for converting from into to that was
+// effective at the given time. A stored rate for the inverse pair is used
+// when the direct pair has none, rounded to the precision of a stored rate so
+// that a snapshot of it converts the same way later.
+func (s *invoiceService) exchangeRate(ctx context.Context, ownerID uuid.UUID, from, to string, at time.Time) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	direct, err := s.exchangeRateRepository.FindLatest(ctx, ownerID, from, to, at)
+	if err != nil {
+		return nil, err
+	}
+	if direct != nil {
+		return currency.ParseRate(direct.Rate)
+	}
+
+	inverse, err := s.exchangeRateRepository.FindLatest(ctx, ownerID, to, from, at)
+	if err != nil {
+		return nil, err
+	}
+	if inverse != nil {
+		rate, err := currency.ParseRate(inverse.Rate)
+		if err != nil {
+			return nil, err
+		}
+		return currency.ParseRate(currency.FormatRate(rate.Inv(rate)))
+	}
+
+	return nil, fmt.Errorf("%w: %s to %s", ErrExchangeRateNotFound, from, to)
+}
+
+// conversionRate returns the rate for converting an invoice into target. An
+// issued invoice uses its snapshot when it was taken against target; anything
+// else uses the rate effective now.
+func (s *invoiceService) conversionRate(ctx context.Context, ownerID uuid.UUID, from string, snapshotBase, snapshotRate *string, target string) (*big.Rat, error) {
+	if snapshotBase != nil && snapshotRate != nil && *snapshotBase == target {
+		return currency.ParseRate(*snapshotRate)
+	}
+
+	return s.exchangeRate(ctx, ownerID, from, target, time.Now())
+}
+
+// convertAmounts converts an invoice's totals into target at rate
+func convertAmounts(from, target string, rate *big.Rat, grandPrice, amountPaid, outstanding int64) (*response.ConvertedAmounts, error) {
+	amounts := []int64{grandPrice, amountPaid, outstanding}
+	for i, amount := range amounts {
+		converted, err := currency.Convert(amount, from, target, rate)
+		if err != nil {
+			return nil, err
+		}
+		amounts[i] = converted
+	}
+
+	return &response.ConvertedAmounts{
+		Currency:           target,
+		ExchangeRate:       currency.FormatRate(rate),
+		GrandPrice:         amounts[0],
+		AmountPaid:         amounts[1],
+		OutstandingBalance: amounts[2],
+	}, nil
+}
+
+// snapshotExchangeRate fills in the base currency, exchange rate and converted
+// grand total of an invoice being issued at issuedAt
+func (s *invoiceService) snapshotExchangeRate(ctx context.Context, invoice *entity.InvoiceEntity, issuedAt time.Time) error {
+	base := s.config.BaseCurrency
+	rate, err := s.exchangeRate(ctx, invoice.OwnerID, invoice.Currency, base, issuedAt)
+	if err != nil {
+		return err
+	}
+	converted, err := currency.Convert(invoice.GrandPrice, invoice.Currency, base, rate)
+	if err != nil {
+		return err
+	}
+
+	formatted := currency.FormatRate(rate)
+	invoice.BaseCurrency = &base
+	invoice.ExchangeRate = &formatted
+	invoice.BaseGrandPrice = &converted
+	return nil
+}
@@ -5,21 +5,56 @@ import (
 	"math"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
 // GetByID gets an invoice by ID
-func (s *invoiceService) GetByID(ctx context.Context, id uuid.UUID) (*response.InvoiceDetailResponse, error) {
-	invoice, err := s.invoiceRepository.FindByID(ctx, id)
+func (s *invoiceService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error) {
+	invoice, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
 
 	return s.toDetailResponse(invoice), nil
 }
 
-// GetAll gets all invoices with pagination
-func (s *invoiceService) GetAll(ctx context.Context, page, limit int, search string) (*response.InvoicePaginationResponse, error) {
+// QueryFields whitelists the fields invoices can be filtered and sorted by
+var QueryFields = query.Fields{
+	"number":      {Sortable: true, Nullable: true},
+	"status":      {Parse: parseStatusValue, Ops: query.EqualityOps, Sortable: true},
+	"customer_id": {Parse: parseCustomerID, Ops: query.EqualityOps},
+	"currency":    {Parse: parseCurrencyValue, Ops: query.EqualityOps, Sortable: true},
+	"tag_id":      {Parse: query.UUID, Ops: query.EqualityOps},
+	"grand_price": {Parse: query.Int, Ops: query.RangeOps, Sortable: true},
+	"issued_at":   {Parse: query.Time, Ops: query.RangeOps, Sortable: true, Nullable: true},
+	"created_at":  {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+	"updated_at":  {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+}
+
+func parseStatusValue(value string) (any, error) {
+	return parseStatus(value)
+}
+
+func parseCustomerID(value string) (any, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, ErrInvalidCustomerID
+	}
+	return id, nil
+}
+
+func parseCurrencyValue(value string) (any, error) {
+	return resolveCurrency(value, "")
+}
+
+// GetAll gets all invoices matching spec, paged by offset or by spec.Cursor.
+// When baseCurrency is set each invoice's totals are also converted into it.
+func (s *invoiceService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec, baseCurrency string) (*response.InvoicePaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -27,7 +62,23 @@ func (s *invoiceService) GetAll(ctx context.Context, page, limit int, search str
 		limit = 10
 	}
 
-	invoices, total, err := s.invoiceRepository.FindAll(ctx, page, limit, search)
+	if baseCurrency != "" {
+		code, err := resolveCurrency(baseCurrency, "")
+		if err != nil {
+			return nil, err
+		}
+		baseCurrency = code
+	}
+
+	var invoices []entity.InvoiceEntity
+	var total int64
+	var cursors query.Cursors
+	var err error
+	if spec.Cursor != nil {
+		invoices, cursors, err = s.invoiceRepository.FindByCursor(ctx, ownerID, limit, spec)
+	} else {
+		invoices, total, err = s.invoiceRepository.FindAll(ctx, ownerID, page, limit, spec)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -43,25 +94,50 @@ func (s *invoiceService) GetAll(ctx context.Context, page, limit int, search str
 			}
 		}
 
+		outstanding, _ := balances(&invoice)
+
+		var converted *response.ConvertedAmounts
+		if baseCurrency != "" {
+			rate, err := s.conversionRate(ctx, ownerID, invoice.Currency, invoice.BaseCurrency, invoice.ExchangeRate, baseCurrency)
+			if err != nil {
+				return nil, err
+			}
+			converted, err = convertAmounts(invoice.Currency, baseCurrency, rate, invoice.GrandPrice, invoice.AmountPaid, outstanding)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		invoiceList[i] = response.InvoiceListItem{
-			ID:         invoice.ID,
-			GrandPrice: invoice.GrandPrice,
-			Tags:       tags,
-			TotalItem:  len(invoice.Items),
-			CreatedAt:  invoice.CreatedAt,
-			UpdatedAt:  invoice.UpdatedAt,
+			ID:                 invoice.ID,
+			Number:             invoice.Number,
+			Status:             string(invoice.Status),
+			CustomerID:         invoice.CustomerID,
+			Customer:           billTo(&invoice),
+			Currency:           invoice.Currency,
+			GrandPrice:         invoice.GrandPrice,
+			AmountPaid:         invoice.AmountPaid,
+			OutstandingBalance: outstanding,
+			Converted:          converted,
+			Tags:               tags,
+			TotalItem:          len(invoice.Items),
+			CreatedAt:          invoice.CreatedAt,
+			UpdatedAt:          invoice.UpdatedAt,
 		}
 	}
 
-	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+	meta := response.InvoicePaginationMeta{Limit: limit}
+	if spec.Cursor != nil {
+		meta.NextCursor = query.Token(cursors.Next)
+		meta.PrevCursor = query.Token(cursors.Prev)
+	} else {
+		meta.TotalData = int(total)
+		meta.Page = page
+		meta.TotalPage = int(math.Ceil(float64(total) / float64(limit)))
+	}
 
 	return &response.InvoicePaginationResponse{
 		Data: invoiceList,
-		Meta: response.InvoicePaginationMeta{
-			TotalData: int(total),
-			Page:      page,
-			Limit:     limit,
-			TotalPage: totalPage,
-		},
+		Meta: meta,
 	}, nil
 }
@@ -4,30 +4,115 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 )
 
-// InvoiceService defines the interface for invoice operations
+// ErrInvoiceNotFound is returned when an invoice does not exist or belongs to another user
+var ErrInvoiceNotFound = apperror.New(apperror.ErrNotFound, "invoice_not_found", "invoice not found")
+
+// ErrInvoiceNotEditable is returned when changing or deleting an invoice that is no longer a draft
+var ErrInvoiceNotEditable = apperror.New(apperror.ErrConflict, "invoice_not_editable", "only draft invoices can be modified")
+
+// ErrInvalidTransition is returned when an invoice cannot move to the requested status
+var ErrInvalidTransition = apperror.New(apperror.ErrConflict, "invalid_status_transition", "invalid invoice status transition")
+
+// ErrInvalidStatus is returned when filtering by an unknown status
+var ErrInvalidStatus = apperror.New(apperror.ErrBadRequest, "invalid_status", "invalid invoice status")
+
+// ErrInvalidPayment is returned when a payment has an unknown kind or method
+var ErrInvalidPayment = apperror.New(apperror.ErrValidation, "invalid_payment", "invalid payment kind or method")
+
+// ErrRefundExceedsPaid is returned when a refund is larger than the net amount paid
+var ErrRefundExceedsPaid = apperror.New(apperror.ErrConflict, "refund_exceeds_paid", "refund exceeds the amount paid")
+
+// ErrGrandPriceMismatch is returned when a client-supplied grand total disagrees with the invoice lines
+var ErrGrandPriceMismatch = apperror.New(apperror.ErrValidation, "grand_price_mismatch", "grand_price does not match the invoice lines")
+
+// ErrInvalidCurrency is returned for a currency code that is not an ISO 4217 currency
+var ErrInvalidCurrency = apperror.New(apperror.ErrValidation, "invalid_currency", "invalid currency")
+
+// ErrCustomerRequired is returned when an invoice has no customer to bill
+var ErrCustomerRequired = apperror.New(apperror.ErrValidation, "customer_required", "customer_id is required")
+
+// ErrInvalidCustomerID is returned when filtering by a customer ID that is not a UUID
+var ErrInvalidCustomerID = apperror.New(apperror.ErrBadRequest, "invalid_customer_id", "invalid customer_id")
+
+// ErrCreditExceedsInvoice is returned when a credit note credits more of a line than is left to credit
+var ErrCreditExceedsInvoice = apperror.New(apperror.ErrConflict, "credit_exceeds_invoice", "credit exceeds the invoiced quantity")
+
+// ErrExchangeRateNotFound is returned when no exchange rate converts between two currencies
+var ErrExchangeRateNotFound = apperror.New(apperror.ErrConflict, "exchange_rate_unavailable", "exchange rate not found")
+
+// ErrItemsRequired is returned when an invoice has no lines
+var ErrItemsRequired = apperror.New(apperror.ErrValidation, "items_required", "at least one item is required")
+
+// ErrInvalidQuantity is returned when a line or credited line has no units
+var ErrInvalidQuantity = apperror.New(apperror.ErrValidation, "invalid_quantity", "quantity must be greater than zero")
+
+// InvoiceService defines the interface for invoice operations.
+// Every operation is scoped to the invoices owned by ownerID.
 type InvoiceService interface {
-	Create(ctx context.Context, req *request.CreateInvoiceRequest) (*response.InvoiceDetailResponse, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*response.InvoiceDetailResponse, error)
-	Update(ctx context.Context, id uuid.UUID, req *request.UpdateInvoiceRequest) (*response.InvoiceDetailResponse, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int, search string) (*response.InvoicePaginationResponse, error)
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateInvoiceRequest) (*response.InvoiceDetailResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateInvoiceRequest) (*response.InvoiceDetailResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec, baseCurrency string) (*response.InvoicePaginationResponse, error)
+	Summary(ctx context.Context, ownerID uuid.UUID, status, baseCurrency string) (*response.InvoiceSummaryResponse, error)
+	Issue(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error)
+	RecordPayment(ctx context.Context, ownerID, id uuid.UUID, req *request.CreatePaymentRequest) (*response.InvoiceDetailResponse, error)
+	GetPayments(ctx context.Context, ownerID, id uuid.UUID) ([]response.PaymentResponse, error)
+	CreateCreditNote(ctx context.Context, ownerID, id uuid.UUID, req *request.CreateCreditNoteRequest) (*response.InvoiceDetailResponse, error)
+	GetCreditNotes(ctx context.Context, ownerID, id uuid.UUID) ([]response.CreditNoteResponse, error)
+	Void(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error)
+}
+
+// InvoiceConfig holds invoice service configuration
+type InvoiceConfig struct {
+	// NumberFormat builds invoice numbers on issue, see ValidateNumberFormat
+	NumberFormat string
+	// CreditNoteNumberFormat builds credit note numbers in the same way
+	CreditNoteNumberFormat string
+	// BaseCurrency is the default invoice currency and the one issued
+	// invoices snapshot their exchange rate against
+	BaseCurrency string
 }
 
 // invoiceService is the concrete implementation of InvoiceService
 type invoiceService struct {
-	invoiceRepository interfaces.InvoiceRepository
-	tagRepository     interfaces.TagRepository
+	invoiceRepository      interfaces.InvoiceRepository
+	customerRepository     interfaces.CustomerRepository
+	itemRepository         interfaces.ItemRepository
+	tagRepository          interfaces.TagRepository
+	taxRateRepository      interfaces.TaxRateRepository
+	exchangeRateRepository interfaces.ExchangeRateRepository
+	unitOfWork             interfaces.UnitOfWork
+	config                 InvoiceConfig
 }
 
 // NewInvoiceService creates a new instance of InvoiceService
-func NewInvoiceService(invoiceRepository interfaces.InvoiceRepository, tagRepository interfaces.TagRepository) InvoiceService {
+func NewInvoiceService(invoiceRepository interfaces.InvoiceRepository, customerRepository interfaces.CustomerRepository, itemRepository interfaces.ItemRepository, tagRepository interfaces.TagRepository, taxRateRepository interfaces.TaxRateRepository, exchangeRateRepository interfaces.ExchangeRateRepository, unitOfWork interfaces.UnitOfWork, config InvoiceConfig) InvoiceService {
+	if config.NumberFormat == "" {
+		config.NumberFormat = DefaultNumberFormat
+	}
+	if config.CreditNoteNumberFormat == "" {
+		config.CreditNoteNumberFormat = DefaultCreditNoteNumberFormat
+	}
+	if config.BaseCurrency == "" {
+		config.BaseCurrency = DefaultBaseCurrency
+	}
+
 	return &invoiceService{
-		invoiceRepository: invoiceRepository,
-		tagRepository:     tagRepository,
+		invoiceRepository:      invoiceRepository,
+		customerRepository:     customerRepository,
+		itemRepository:         itemRepository,
+		tagRepository:          tagRepository,
+		taxRateRepository:      taxRateRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		unitOfWork:             unitOfWork,
+		config:                 config,
 	}
 }
@@ -0,0 +1,49 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+// newMockUnitOfWork returns a unit of work that runs fn directly, as a real
+// transaction would from the service's point of view
+func newMockUnitOfWork(ctrl *gomock.Controller) *mock.MockUnitOfWork {
+	unitOfWork := mock.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+	return unitOfWork
+}
+
+func TestNewInvoiceService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewInvoiceService(
+		mock.NewMockInvoiceRepository(ctrl),
+		mock.NewMockCustomerRepository(ctrl),
+		mock.NewMockItemRepository(ctrl),
+		mock.NewMockTagRepository(ctrl),
+		mock.NewMockTaxRateRepository(ctrl),
+		mock.NewMockExchangeRateRepository(ctrl),
+		mock.NewMockUnitOfWork(ctrl),
+		InvoiceConfig{},
+	)
+
+	svc, ok := service.(*invoiceService)
+	if !ok {
+		t.Fatalf("expected *invoiceService, got %T", service)
+	}
+	if svc.config.NumberFormat != DefaultNumberFormat {
+		t.Errorf("expected default number format, got %q", svc.config.NumberFormat)
+	}
+	if svc.config.BaseCurrency != DefaultBaseCurrency {
+		t.Errorf("expected default base currency, got %q", svc.config.BaseCurrency)
+	}
+}
@@ -0,0 +1,75 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Issue finalises a draft invoice, assigns its sequential number and
+// snapshots its customer's billing details and its exchange rate to the base
+// currency. Its lines can no longer change afterwards; corrections are made
+// with credit notes.
+func (s *invoiceService) Issue(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error) {
+	var invoice *entity.InvoiceEntity
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		locked, err := s.invoiceRepository.LockByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			return ErrInvoiceNotFound
+		}
+		if !canTransition(locked.Status, entity.InvoiceStatusIssued) {
+			return ErrInvalidTransition
+		}
+
+		// Bill the customer as they are now, whatever later edits bring
+		if locked.CustomerID == nil {
+			return ErrCustomerRequired
+		}
+		customer, err := s.findCustomer(ctx, ownerID, *locked.CustomerID)
+		if err != nil {
+			return err
+		}
+		locked.BillTo = entity.CustomerSnapshot{
+			Name:           customer.Name,
+			Email:          customer.Email,
+			BillingAddress: customer.BillingAddress,
+			TaxID:          customer.TaxID,
+		}
+
+		issuedAt := time.Now()
+		locked.IssuedAt = &issuedAt
+		if err := s.snapshotExchangeRate(ctx, locked, issuedAt); err != nil {
+			return err
+		}
+
+		issued, err := s.invoiceRepository.Issue(ctx, ownerID, id, *locked, func(seq int64) string {
+			return formatNumber(s.config.NumberFormat, issuedAt, seq)
+		})
+		if err != nil {
+			return err
+		}
+		if !issued {
+			return ErrInvalidTransition
+		}
+
+		invoice, err = s.invoiceRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if invoice == nil {
+			return ErrInvoiceNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDetailResponse(invoice), nil
+}
@@ -0,0 +1,180 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestIssue(t *testing.T) {
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+	customerID := uuid.New()
+
+	tests := []struct {
+		name           string
+		status         entity.InvoiceStatus
+		noCustomer     bool
+		currency       string
+		direct         *entity.ExchangeRateEntity
+		inverse        *entity.ExchangeRateEntity
+		expectIssue    bool
+		issued         bool
+		expectedRate   string
+		expectedAmount int64
+		expectedError  error
+	}{
+		{
+			name:           "should issue a draft with a formatted number",
+			status:         entity.InvoiceStatusDraft,
+			currency:       "USD",
+			expectIssue:    true,
+			issued:         true,
+			expectedRate:   "1",
+			expectedAmount: 12345,
+		},
+		{
+			name:           "should snapshot the rate of a foreign currency invoice",
+			status:         entity.InvoiceStatusDraft,
+			currency:       "EUR",
+			direct:         &entity.ExchangeRateEntity{Rate: "1.0845"},
+			expectIssue:    true,
+			issued:         true,
+			expectedRate:   "1.0845",
+			expectedAmount: 13388, // 123.45 EUR × 1.0845 = 133.881525 USD
+		},
+		{
+			name:           "should invert a rate stored for the opposite pair",
+			status:         entity.InvoiceStatusDraft,
+			currency:       "JPY",
+			inverse:        &entity.ExchangeRateEntity{Rate: "151.515151515152"}, // USD→JPY
+			expectIssue:    true,
+			issued:         true,
+			expectedRate:   "0.0066",
+			expectedAmount: 8148, // 12345 JPY × 0.0066 = 81.477 USD
+		},
+		{
+			name:          "should reject issuing without an exchange rate",
+			status:        entity.InvoiceStatusDraft,
+			currency:      "EUR",
+			expectedError: ErrExchangeRateNotFound,
+		},
+		{
+			name:          "should reject issuing a draft without a customer",
+			status:        entity.InvoiceStatusDraft,
+			noCustomer:    true,
+			currency:      "USD",
+			expectedError: ErrCustomerRequired,
+		},
+		{
+			name:          "should reject issuing an issued invoice",
+			status:        entity.InvoiceStatusIssued,
+			currency:      "USD",
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:          "should reject a concurrent issue",
+			status:        entity.InvoiceStatusDraft,
+			currency:      "USD",
+			expectIssue:   true,
+			issued:        false,
+			expectedError: ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			locked := &entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, Status: tt.status, Currency: tt.currency, GrandPrice: 12345}
+			if !tt.noCustomer {
+				locked.CustomerID = &customerID
+			}
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockInvoiceRepo.EXPECT().
+				LockByID(gomock.Any(), ownerID, invoiceID).
+				Return(locked, nil).
+				Times(1)
+
+			mockCustomerRepo := mock.NewMockCustomerRepository(ctrl)
+			if tt.status == entity.InvoiceStatusDraft && !tt.noCustomer {
+				mockCustomerRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, customerID).
+					Return(&entity.CustomerEntity{ID: customerID, OwnerID: ownerID, Name: "Acme Corp", TaxID: "GB123"}, nil).
+					Times(1)
+			}
+
+			mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+			if tt.status == entity.InvoiceStatusDraft && !tt.noCustomer && tt.currency != "USD" {
+				mockExchangeRateRepo.EXPECT().
+					FindLatest(gomock.Any(), ownerID, tt.currency, "USD", gomock.Any()).
+					Return(tt.direct, nil).
+					Times(1)
+				if tt.direct == nil {
+					mockExchangeRateRepo.EXPECT().
+						FindLatest(gomock.Any(), ownerID, "USD", tt.currency, gomock.Any()).
+						Return(tt.inverse, nil).
+						Times(1)
+				}
+			}
+
+			var number string
+			var snapshot entity.InvoiceEntity
+			if tt.expectIssue {
+				mockInvoiceRepo.EXPECT().
+					Issue(gomock.Any(), ownerID, invoiceID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, issued entity.InvoiceEntity, formatNumber func(int64) string) (bool, error) {
+						number = formatNumber(42)
+						snapshot = issued
+						return tt.issued, nil
+					}).
+					Times(1)
+			}
+			if tt.issued {
+				mockInvoiceRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, invoiceID).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID) (*entity.InvoiceEntity, error) {
+						return &entity.InvoiceEntity{ID: invoiceID, Number: &number, Status: entity.InvoiceStatusIssued}, nil
+					}).
+					Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mockCustomerRepo, mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mockExchangeRateRepo, newMockUnitOfWork(ctrl), InvoiceConfig{
+				NumberFormat: "INV-{SEQ:4}",
+				BaseCurrency: "USD",
+			})
+			result, err := svc.Issue(context.Background(), ownerID, invoiceID)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Number == nil || *result.Number != "INV-0042" {
+				t.Errorf("expected number INV-0042, got %v", result.Number)
+			}
+			if snapshot.IssuedAt == nil || snapshot.BaseCurrency == nil || *snapshot.BaseCurrency != "USD" {
+				t.Fatalf("expected an issue date and USD snapshot, got %+v", snapshot)
+			}
+			if snapshot.ExchangeRate == nil || *snapshot.ExchangeRate != tt.expectedRate {
+				t.Errorf("expected rate %s, got %v", tt.expectedRate, snapshot.ExchangeRate)
+			}
+			if snapshot.BaseGrandPrice == nil || *snapshot.BaseGrandPrice != tt.expectedAmount {
+				t.Errorf("expected base grand price %d, got %v", tt.expectedAmount, snapshot.BaseGrandPrice)
+			}
+			if snapshot.BillTo.Name != "Acme Corp" || snapshot.BillTo.TaxID != "GB123" {
+				t.Errorf("expected the customer to be snapshotted, got %+v", snapshot.BillTo)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package invoice
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultNumberFormat is used when no invoice number format is configured
+const DefaultNumberFormat = "INV-{YYYY}-{SEQ:6}"
+
+// DefaultCreditNoteNumberFormat is used when no credit note number format is configured
+const DefaultCreditNoteNumberFormat = "CN-{YYYY}-{SEQ:6}"
+
+// numberToken matches the placeholders supported in invoice and credit note
+// number formats: {YYYY}, {YY}, {MM}, {DD} for the issue date and {SEQ} or
+// {SEQ:n} for the sequence value zero-padded to n digits
+var numberToken = regexp.MustCompile(`\{(YYYY|YY|MM|DD|SEQ(?::(\d{1,2}))?)\}`)
+
+// ValidateNumberFormat checks that format contains a sequence placeholder and
+// no unknown placeholders
+func ValidateNumberFormat(format string) error {
+	seq := false
+	for _, match := range numberToken.FindAllStringSubmatch(format, -1) {
+		if match[1] == "SEQ" || match[2] != "" {
+			seq = true
+		}
+	}
+	if !seq {
+		return errors.New("number format must contain {SEQ} or {SEQ:n}")
+	}
+
+	rest := numberToken.ReplaceAllString(format, "")
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("number format %q contains an unknown placeholder", format)
+	}
+
+	return nil
+}
+
+// formatNumber renders an invoice or credit note number from format for the
+// given issue date and sequence value
+func formatNumber(format string, issuedAt time.Time, seq int64) string {
+	return numberToken.ReplaceAllStringFunc(format, func(token string) string {
+		match := numberToken.FindStringSubmatch(token)
+		switch match[1] {
+		case "YYYY":
+			return issuedAt.Format("2006")
+		case "YY":
+			return issuedAt.Format("06")
+		case "MM":
+			return issuedAt.Format("01")
+		case "DD":
+			return issuedAt.Format("02")
+		}
+
+		width := 0
+		if match[2] != "" {
+			width, _ = strconv.Atoi(match[2])
+		}
+		return fmt.Sprintf("%0*d", width, seq)
+	})
+}
@@ -0,0 +1,56 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatNumber(t *testing.T) {
+	issuedAt := time.Date(2026, time.March, 7, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		format   string
+		seq      int64
+		expected string
+	}{
+		{name: "should render the default format", format: DefaultNumberFormat, seq: 123, expected: "INV-2026-000123"},
+		{name: "should render date parts", format: "{YY}{MM}{DD}/{SEQ:3}", seq: 7, expected: "260307/007"},
+		{name: "should render an unpadded sequence", format: "#{SEQ}", seq: 42, expected: "#42"},
+		{name: "should not truncate a sequence wider than the padding", format: "{SEQ:2}", seq: 12345, expected: "12345"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatNumber(tt.format, issuedAt, tt.seq); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateNumberFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		format        string
+		expectedError bool
+	}{
+		{name: "should accept the default format", format: DefaultNumberFormat},
+		{name: "should accept an unpadded sequence", format: "INV{SEQ}"},
+		{name: "should reject a format without a sequence", format: "INV-{YYYY}", expectedError: true},
+		{name: "should reject an unknown placeholder", format: "{CUSTOMER}-{SEQ}", expectedError: true},
+		{name: "should reject an unbalanced brace", format: "INV-{SEQ}}", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNumberFormat(tt.format)
+			if tt.expectedError && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// RecordPayment appends a payment or refund to an invoice's ledger and
+// settles the invoice status against the new net amount paid. A payment
+// larger than the outstanding balance is accepted and reported as credit.
+func (s *invoiceService) RecordPayment(ctx context.Context, ownerID, id uuid.UUID, req *request.CreatePaymentRequest) (*response.InvoiceDetailResponse, error) {
+	payment, err := parsePayment(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *entity.InvoiceEntity
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		invoice, err := s.invoiceRepository.LockByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if invoice == nil {
+			return ErrInvoiceNotFound
+		}
+		if !acceptsPayment(invoice.Status, payment.Kind) {
+			return ErrInvalidTransition
+		}
+
+		payment.ID = uuid.New()
+		payment.InvoiceID = invoice.ID
+		payment.OwnerID = ownerID
+		if err := s.invoiceRepository.CreatePayment(ctx, payment); err != nil {
+			return err
+		}
+
+		net, err := s.invoiceRepository.SumPayments(ctx, invoice.ID)
+		if err != nil {
+			return err
+		}
+		if net < 0 {
+			return ErrRefundExceedsPaid
+		}
+
+		from := invoice.Status
+		settle(invoice, net, payment.PaidAt)
+		if !canTransition(from, invoice.Status) {
+			return ErrInvalidTransition
+		}
+
+		updated, err := s.invoiceRepository.UpdateStatus(ctx, ownerID, id, from, *invoice)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return ErrInvalidTransition
+		}
+
+		result, err = s.invoiceRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if result == nil {
+			return ErrInvoiceNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDetailResponse(result), nil
+}
+
+// GetPayments lists an invoice's payments ledger in the order the entries were paid
+func (s *invoiceService) GetPayments(ctx context.Context, ownerID, id uuid.UUID) ([]response.PaymentResponse, error) {
+	invoice, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return toPaymentResponses(invoice.Payments), nil
+}
+
+// parsePayment validates a payment request and applies its defaults
+func parsePayment(req *request.CreatePaymentRequest) (entity.PaymentEntity, error) {
+	if req.Amount <= 0 {
+		return entity.PaymentEntity{}, apperror.New(apperror.ErrValidation, "invalid_amount", "amount must be greater than zero")
+	}
+
+	payment := entity.PaymentEntity{
+		Kind:      entity.PaymentKind(req.Kind),
+		Amount:    req.Amount,
+		Method:    entity.PaymentMethod(req.Method),
+		PaidAt:    time.Now(),
+		Reference: req.Reference,
+	}
+	if req.PaidAt != nil {
+		payment.PaidAt = *req.PaidAt
+	}
+
+	switch payment.Kind {
+	case "":
+		payment.Kind = entity.PaymentKindPayment
+	case entity.PaymentKindPayment, entity.PaymentKindRefund:
+	default:
+		return entity.PaymentEntity{}, ErrInvalidPayment
+	}
+
+	switch payment.Method {
+	case "":
+		payment.Method = entity.PaymentMethodOther
+	case entity.PaymentMethodCash, entity.PaymentMethodBankTransfer, entity.PaymentMethodCard,
+		entity.PaymentMethodCheque, entity.PaymentMethodOther:
+	default:
+		return entity.PaymentEntity{}, ErrInvalidPayment
+	}
+
+	return payment, nil
+}
+
+// acceptsPayment reports whether an invoice in status may take a ledger entry
+// of kind. Payments need an open balance; refunds need money to return.
+func acceptsPayment(status entity.InvoiceStatus, kind entity.PaymentKind) bool {
+	if kind == entity.PaymentKindRefund {
+		return status == entity.InvoiceStatusPartiallyPaid || status == entity.InvoiceStatusPaid
+	}
+	return status == entity.InvoiceStatusIssued || status == entity.InvoiceStatusPartiallyPaid
+}
+
+// settle derives an invoice's status from the net amount paid against what
+// is owed after credit notes. The paid date is kept from the first time the
+// invoice was settled in full.
+func settle(invoice *entity.InvoiceEntity, net int64, paidAt time.Time) {
+	invoice.AmountPaid = net
+
+	switch {
+	case net >= invoice.GrandPrice-invoice.CreditedTotal:
+		invoice.Status = entity.InvoiceStatusPaid
+		if invoice.PaidAt == nil {
+			invoice.PaidAt = &paidAt
+		}
+	case net > 0:
+		invoice.Status = entity.InvoiceStatusPartiallyPaid
+		invoice.PaidAt = nil
+	default:
+		invoice.Status = entity.InvoiceStatusIssued
+		invoice.PaidAt = nil
+	}
+}
+
+// balances splits the difference between what an invoice owes after credit
+// notes and the amount paid into what is still owed and what has been overpaid
+func balances(invoice *entity.InvoiceEntity) (outstanding, credit int64) {
+	if invoice.Status == entity.InvoiceStatusVoid {
+		return 0, invoice.AmountPaid
+	}
+	due := invoice.GrandPrice - invoice.CreditedTotal
+	if invoice.AmountPaid > due {
+		return 0, invoice.AmountPaid - due
+	}
+	return due - invoice.AmountPaid, 0
+}
+
+// toPaymentResponses maps ledger entries to their API representation
+func toPaymentResponses(payments []entity.PaymentEntity) []response.PaymentResponse {
+	result := make([]response.PaymentResponse, len(payments))
+	for i, payment := range payments {
+		result[i] = response.PaymentResponse{
+			ID:        payment.ID,
+			Kind:      string(payment.Kind),
+			Amount:    payment.Amount,
+			Method:    string(payment.Method),
+			PaidAt:    payment.PaidAt,
+			Reference: payment.Reference,
+			CreatedAt: payment.CreatedAt,
+		}
+	}
+	return result
+}
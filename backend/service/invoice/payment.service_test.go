@@ -0,0 +1,271 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestRecordPayment(t *testing.T) {
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+
+	tests := []struct {
+		name                string
+		stored              entity.InvoiceEntity
+		req                 request.CreatePaymentRequest
+		expectPayment       bool
+		net                 int64
+		expectUpdate        bool
+		updated             bool
+		expectedStatus      entity.InvoiceStatus
+		expectedOutstanding int64
+		expectedCredit      int64
+		expectedError       error
+	}{
+		{
+			name:                "should partially pay an issued invoice",
+			stored:              entity.InvoiceEntity{Status: entity.InvoiceStatusIssued, GrandPrice: 1000},
+			req:                 request.CreatePaymentRequest{Amount: 400, Method: "card"},
+			expectPayment:       true,
+			net:                 400,
+			expectUpdate:        true,
+			updated:             true,
+			expectedStatus:      entity.InvoiceStatusPartiallyPaid,
+			expectedOutstanding: 600,
+		},
+		{
+			name:           "should settle a partially paid invoice",
+			stored:         entity.InvoiceEntity{Status: entity.InvoiceStatusPartiallyPaid, GrandPrice: 1000, AmountPaid: 400},
+			req:            request.CreatePaymentRequest{Amount: 600},
+			expectPayment:  true,
+			net:            1000,
+			expectUpdate:   true,
+			updated:        true,
+			expectedStatus: entity.InvoiceStatusPaid,
+		},
+		{
+			name:           "should record an overpayment as credit",
+			stored:         entity.InvoiceEntity{Status: entity.InvoiceStatusIssued, GrandPrice: 1000},
+			req:            request.CreatePaymentRequest{Amount: 1200},
+			expectPayment:  true,
+			net:            1200,
+			expectUpdate:   true,
+			updated:        true,
+			expectedStatus: entity.InvoiceStatusPaid,
+			expectedCredit: 200,
+		},
+		{
+			name:                "should reopen a paid invoice on a refund",
+			stored:              entity.InvoiceEntity{Status: entity.InvoiceStatusPaid, GrandPrice: 1000, AmountPaid: 1000},
+			req:                 request.CreatePaymentRequest{Kind: "refund", Amount: 300},
+			expectPayment:       true,
+			net:                 700,
+			expectUpdate:        true,
+			updated:             true,
+			expectedStatus:      entity.InvoiceStatusPartiallyPaid,
+			expectedOutstanding: 300,
+		},
+		{
+			name:           "should keep a paid invoice paid when refunding credit",
+			stored:         entity.InvoiceEntity{Status: entity.InvoiceStatusPaid, GrandPrice: 1000, AmountPaid: 1200},
+			req:            request.CreatePaymentRequest{Kind: "refund", Amount: 200},
+			expectPayment:  true,
+			net:            1000,
+			expectUpdate:   true,
+			updated:        true,
+			expectedStatus: entity.InvoiceStatusPaid,
+		},
+		{
+			name:          "should reject a refund larger than the amount paid",
+			stored:        entity.InvoiceEntity{Status: entity.InvoiceStatusPartiallyPaid, GrandPrice: 1000, AmountPaid: 400},
+			req:           request.CreatePaymentRequest{Kind: "refund", Amount: 500},
+			expectPayment: true,
+			net:           -100,
+			expectedError: ErrRefundExceedsPaid,
+		},
+		{
+			name:          "should reject a refund on an unpaid invoice",
+			stored:        entity.InvoiceEntity{Status: entity.InvoiceStatusIssued, GrandPrice: 1000},
+			req:           request.CreatePaymentRequest{Kind: "refund", Amount: 100},
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:          "should reject a payment on a paid invoice",
+			stored:        entity.InvoiceEntity{Status: entity.InvoiceStatusPaid, GrandPrice: 1000, AmountPaid: 1000},
+			req:           request.CreatePaymentRequest{Amount: 100},
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:          "should reject a payment on a draft",
+			stored:        entity.InvoiceEntity{Status: entity.InvoiceStatusDraft, GrandPrice: 1000},
+			req:           request.CreatePaymentRequest{Amount: 100},
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:          "should reject a payment on a void invoice",
+			stored:        entity.InvoiceEntity{Status: entity.InvoiceStatusVoid, GrandPrice: 1000},
+			req:           request.CreatePaymentRequest{Amount: 100},
+			expectedError: ErrInvalidTransition,
+		},
+		{
+			name:           "should reject a concurrent transition",
+			stored:         entity.InvoiceEntity{Status: entity.InvoiceStatusIssued, GrandPrice: 1000},
+			req:            request.CreatePaymentRequest{Amount: 1000},
+			expectPayment:  true,
+			net:            1000,
+			expectUpdate:   true,
+			updated:        false,
+			expectedStatus: entity.InvoiceStatusPaid,
+			expectedError:  ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			stored := tt.stored
+			stored.ID = invoiceID
+			stored.OwnerID = ownerID
+
+			mockInvoiceRepo.EXPECT().
+				LockByID(gomock.Any(), ownerID, invoiceID).
+				Return(&stored, nil).
+				Times(1)
+			if tt.expectPayment {
+				mockInvoiceRepo.EXPECT().
+					CreatePayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, payment entity.PaymentEntity) error {
+						if payment.InvoiceID != invoiceID || payment.OwnerID != ownerID {
+							t.Errorf("payment not linked to the invoice and owner")
+						}
+						if payment.Amount != tt.req.Amount {
+							t.Errorf("expected amount %d, got %d", tt.req.Amount, payment.Amount)
+						}
+						return nil
+					}).
+					Times(1)
+				mockInvoiceRepo.EXPECT().
+					SumPayments(gomock.Any(), invoiceID).
+					Return(tt.net, nil).
+					Times(1)
+			}
+			if tt.expectUpdate {
+				mockInvoiceRepo.EXPECT().
+					UpdateStatus(gomock.Any(), ownerID, invoiceID, tt.stored.Status, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, _ entity.InvoiceStatus, invoice entity.InvoiceEntity) (bool, error) {
+						if invoice.Status != tt.expectedStatus {
+							t.Errorf("expected status %q, got %q", tt.expectedStatus, invoice.Status)
+						}
+						if invoice.AmountPaid != tt.net {
+							t.Errorf("expected amount paid %d, got %d", tt.net, invoice.AmountPaid)
+						}
+						if (invoice.PaidAt != nil) != (tt.expectedStatus == entity.InvoiceStatusPaid) {
+							t.Errorf("unexpected paid date %v for status %q", invoice.PaidAt, invoice.Status)
+						}
+						return tt.updated, nil
+					}).
+					Times(1)
+			}
+			if tt.updated {
+				mockInvoiceRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, invoiceID).
+					Return(&entity.InvoiceEntity{ID: invoiceID, Status: tt.expectedStatus, GrandPrice: tt.stored.GrandPrice, AmountPaid: tt.net}, nil).
+					Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mock.NewMockExchangeRateRepository(ctrl), newMockUnitOfWork(ctrl), InvoiceConfig{})
+			result, err := svc.RecordPayment(context.Background(), ownerID, invoiceID, &tt.req)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Status != string(tt.expectedStatus) {
+				t.Errorf("expected status %q, got %q", tt.expectedStatus, result.Status)
+			}
+			if result.OutstandingBalance != tt.expectedOutstanding {
+				t.Errorf("expected outstanding balance %d, got %d", tt.expectedOutstanding, result.OutstandingBalance)
+			}
+			if result.CreditBalance != tt.expectedCredit {
+				t.Errorf("expected credit balance %d, got %d", tt.expectedCredit, result.CreditBalance)
+			}
+		})
+	}
+}
+
+func TestParsePayment(t *testing.T) {
+	paidAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            request.CreatePaymentRequest
+		expectedKind   entity.PaymentKind
+		expectedMethod entity.PaymentMethod
+		expectedError  bool
+	}{
+		{
+			name:           "should default to a payment by other means",
+			req:            request.CreatePaymentRequest{Amount: 100},
+			expectedKind:   entity.PaymentKindPayment,
+			expectedMethod: entity.PaymentMethodOther,
+		},
+		{
+			name:           "should keep an explicit refund and method",
+			req:            request.CreatePaymentRequest{Kind: "refund", Amount: 100, Method: "bank_transfer", PaidAt: &paidAt},
+			expectedKind:   entity.PaymentKindRefund,
+			expectedMethod: entity.PaymentMethodBankTransfer,
+		},
+		{
+			name:          "should reject an unknown kind",
+			req:           request.CreatePaymentRequest{Kind: "chargeback", Amount: 100},
+			expectedError: true,
+		},
+		{
+			name:          "should reject an unknown method",
+			req:           request.CreatePaymentRequest{Amount: 100, Method: "barter"},
+			expectedError: true,
+		},
+		{
+			name:          "should reject a non-positive amount",
+			req:           request.CreatePaymentRequest{Amount: 0},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment, err := parsePayment(&tt.req)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("parsePayment() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if tt.expectedError {
+				return
+			}
+			if payment.Kind != tt.expectedKind {
+				t.Errorf("expected kind %q, got %q", tt.expectedKind, payment.Kind)
+			}
+			if payment.Method != tt.expectedMethod {
+				t.Errorf("expected method %q, got %q", tt.expectedMethod, payment.Method)
+			}
+			if tt.req.PaidAt != nil && !payment.PaidAt.Equal(*tt.req.PaidAt) {
+				t.Errorf("expected paid date %v, got %v", *tt.req.PaidAt, payment.PaidAt)
+			}
+		})
+	}
+}
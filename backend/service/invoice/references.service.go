@@ -0,0 +1,118 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+)
+
+// findCustomer loads the customer an invoice is billed to, rejecting a
+// missing customer or one not owned by ownerID
+func (s *invoiceService) findCustomer(ctx context.Context, ownerID uuid.UUID, customerID uuid.UUID) (*entity.CustomerEntity, error) {
+	if customerID == uuid.Nil {
+		return nil, ErrCustomerRequired
+	}
+
+	customer, err := s.customerRepository.FindByID(ctx, ownerID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, apperror.Errorf(apperror.ErrValidation, "unknown_customer", "customer %s not found", customerID)
+	}
+
+	return customer, nil
+}
+
+// buildInvoiceItems turns the requested lines into priced invoice items,
+// rejecting any line that references an item or tax rate not owned by ownerID
+func (s *invoiceService) buildInvoiceItems(ctx context.Context, ownerID, invoiceID uuid.UUID, inputs []request.InvoiceItemInput) ([]entity.InvoiceItemEntity, error) {
+	invoiceItems := make([]entity.InvoiceItemEntity, len(inputs))
+	for i, input := range inputs {
+		item, err := s.itemRepository.FindByID(ctx, ownerID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "unknown_item", "item %s not found", input.ItemID)
+		}
+
+		taxRate, err := s.findTaxRate(ctx, ownerID, input.TaxRateID)
+		if err != nil {
+			return nil, err
+		}
+
+		invoiceItems[i] = entity.InvoiceItemEntity{
+			ID:            uuid.New(),
+			InvoiceID:     invoiceID,
+			ItemID:        input.ItemID,
+			Quantity:      input.Quantity,
+			UnitPrice:     input.UnitPrice,
+			DiscountType:  entity.DiscountType(input.DiscountType),
+			DiscountValue: input.DiscountValue,
+		}
+		if taxRate != nil {
+			invoiceItems[i].TaxRateID = &taxRate.ID
+			invoiceItems[i].TaxRate = taxRate.Rate
+		}
+		if err := priceLine(&invoiceItems[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return invoiceItems, nil
+}
+
+// findOwnedTags loads the requested tags, rejecting any tag not owned by ownerID
+func (s *invoiceService) findOwnedTags(ctx context.Context, ownerID uuid.UUID, tagIDs []uuid.UUID) ([]entity.TagEntity, error) {
+	tags := make([]entity.TagEntity, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tag, err := s.tagRepository.FindByID(ctx, ownerID, tagID)
+		if err != nil {
+			return nil, err
+		}
+		if tag == nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "unknown_tag", "tag %s not found", tagID)
+		}
+		tags[i] = *tag
+	}
+
+	return tags, nil
+}
+
+// findTaxRate loads an optional tax rate, rejecting one not owned by ownerID
+func (s *invoiceService) findTaxRate(ctx context.Context, ownerID uuid.UUID, taxRateID *uuid.UUID) (*entity.TaxRateEntity, error) {
+	if taxRateID == nil {
+		return nil, nil
+	}
+
+	taxRate, err := s.taxRateRepository.FindByID(ctx, ownerID, *taxRateID)
+	if err != nil {
+		return nil, err
+	}
+	if taxRate == nil {
+		return nil, apperror.Errorf(apperror.ErrValidation, "unknown_tax_rate", "tax rate %s not found", *taxRateID)
+	}
+
+	return taxRate, nil
+}
+
+// applyInvoiceTax sets the invoice-level tax rate, copying its current rate
+// so later changes to the tax rate do not reprice the invoice
+func (s *invoiceService) applyInvoiceTax(ctx context.Context, ownerID uuid.UUID, invoice *entity.InvoiceEntity, taxRateID *uuid.UUID) error {
+	taxRate, err := s.findTaxRate(ctx, ownerID, taxRateID)
+	if err != nil {
+		return err
+	}
+
+	invoice.TaxRateID = nil
+	invoice.TaxRate = 0
+	if taxRate != nil {
+		invoice.TaxRateID = &taxRate.ID
+		invoice.TaxRate = taxRate.Rate
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// transitions lists the statuses each status may move to.
+// Refunds move paid invoices back; void invoices are final.
+var transitions = map[entity.InvoiceStatus][]entity.InvoiceStatus{
+	entity.InvoiceStatusDraft:         {entity.InvoiceStatusIssued, entity.InvoiceStatusVoid},
+	entity.InvoiceStatusIssued:        {entity.InvoiceStatusPartiallyPaid, entity.InvoiceStatusPaid, entity.InvoiceStatusVoid},
+	entity.InvoiceStatusPartiallyPaid: {entity.InvoiceStatusIssued, entity.InvoiceStatusPartiallyPaid, entity.InvoiceStatusPaid},
+	entity.InvoiceStatusPaid:          {entity.InvoiceStatusIssued, entity.InvoiceStatusPartiallyPaid, entity.InvoiceStatusPaid},
+}
+
+// canTransition reports whether an invoice may move from one status to another
+func canTransition(from, to entity.InvoiceStatus) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// parseStatus validates a status taken from user input
+func parseStatus(status string) (entity.InvoiceStatus, error) {
+	switch s := entity.InvoiceStatus(status); s {
+	case entity.InvoiceStatusDraft, entity.InvoiceStatusIssued, entity.InvoiceStatusPartiallyPaid,
+		entity.InvoiceStatusPaid, entity.InvoiceStatusVoid:
+		return s, nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
+
+// findEditable locks an invoice and ensures it is still a draft. It must run
+// inside a unit of work, so the invoice cannot be issued before the caller's
+// writes are committed.
+func (s *invoiceService) findEditable(ctx context.Context, ownerID, id uuid.UUID) (*entity.InvoiceEntity, error) {
+	invoice, err := s.invoiceRepository.LockByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
+	if invoice.Status != entity.InvoiceStatusDraft {
+		return nil, ErrInvoiceNotEditable
+	}
+
+	return invoice, nil
+}
+
+// transition applies change to the stored invoice and persists the new
+// lifecycle fields, failing if the move is not allowed or raced with another
+func (s *invoiceService) transition(ctx context.Context, ownerID, id uuid.UUID, change func(invoice *entity.InvoiceEntity) error) (*entity.InvoiceEntity, error) {
+	invoice, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, ErrInvoiceNotFound
+	}
+
+	from := invoice.Status
+	if err := change(invoice); err != nil {
+		return nil, err
+	}
+	if !canTransition(from, invoice.Status) {
+		return nil, ErrInvalidTransition
+	}
+
+	updated, err := s.invoiceRepository.UpdateStatus(ctx, ownerID, id, from, *invoice)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrInvalidTransition
+	}
+
+	result, err := s.invoiceRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return result, nil
+}
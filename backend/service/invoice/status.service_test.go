@@ -0,0 +1,49 @@
+package invoice
+
+import (
+	"testing"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     entity.InvoiceStatus
+		to       entity.InvoiceStatus
+		expected bool
+	}{
+		{name: "should issue a draft", from: entity.InvoiceStatusDraft, to: entity.InvoiceStatusIssued, expected: true},
+		{name: "should void a draft", from: entity.InvoiceStatusDraft, to: entity.InvoiceStatusVoid, expected: true},
+		{name: "should not pay a draft", from: entity.InvoiceStatusDraft, to: entity.InvoiceStatusPaid, expected: false},
+		{name: "should partially pay an issued invoice", from: entity.InvoiceStatusIssued, to: entity.InvoiceStatusPartiallyPaid, expected: true},
+		{name: "should pay an issued invoice", from: entity.InvoiceStatusIssued, to: entity.InvoiceStatusPaid, expected: true},
+		{name: "should void an issued invoice", from: entity.InvoiceStatusIssued, to: entity.InvoiceStatusVoid, expected: true},
+		{name: "should accept further partial payments", from: entity.InvoiceStatusPartiallyPaid, to: entity.InvoiceStatusPartiallyPaid, expected: true},
+		{name: "should settle a partially paid invoice", from: entity.InvoiceStatusPartiallyPaid, to: entity.InvoiceStatusPaid, expected: true},
+		{name: "should not void a partially paid invoice", from: entity.InvoiceStatusPartiallyPaid, to: entity.InvoiceStatusVoid, expected: false},
+		{name: "should reopen a paid invoice on a partial refund", from: entity.InvoiceStatusPaid, to: entity.InvoiceStatusPartiallyPaid, expected: true},
+		{name: "should return a fully refunded invoice to issued", from: entity.InvoiceStatusPaid, to: entity.InvoiceStatusIssued, expected: true},
+		{name: "should not void a paid invoice", from: entity.InvoiceStatusPaid, to: entity.InvoiceStatusVoid, expected: false},
+		{name: "should not return a paid invoice to draft", from: entity.InvoiceStatusPaid, to: entity.InvoiceStatusDraft, expected: false},
+		{name: "should not reissue a void invoice", from: entity.InvoiceStatusVoid, to: entity.InvoiceStatusIssued, expected: false},
+		{name: "should not return an issued invoice to draft", from: entity.InvoiceStatusIssued, to: entity.InvoiceStatusDraft, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canTransition(tt.from, tt.to); got != tt.expected {
+				t.Errorf("canTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	if status, err := parseStatus("partially_paid"); err != nil || status != entity.InvoiceStatusPartiallyPaid {
+		t.Errorf("expected partially_paid, got %q (err %v)", status, err)
+	}
+	if _, err := parseStatus("archived"); err != ErrInvalidStatus {
+		t.Errorf("expected ErrInvalidStatus, got %v", err)
+	}
+}
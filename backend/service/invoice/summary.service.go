@@ -0,0 +1,92 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+)
+
+// receivableStatuses are the statuses summarised when no status is requested
+var receivableStatuses = []entity.InvoiceStatus{
+	entity.InvoiceStatusIssued,
+	entity.InvoiceStatusPartiallyPaid,
+	entity.InvoiceStatusPaid,
+}
+
+// Summary totals the owner's invoices in baseCurrency, or the configured base
+// currency when empty. Issued invoices snapshotted against that currency are
+// converted at their snapshot rate and the rest at the latest rate. Without a
+// status only issued, partially paid and paid invoices are included.
+func (s *invoiceService) Summary(ctx context.Context, ownerID uuid.UUID, status, baseCurrency string) (*response.InvoiceSummaryResponse, error) {
+	target, err := resolveCurrency(baseCurrency, s.config.BaseCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := interfaces.InvoiceFilter{Statuses: receivableStatuses}
+	if status != "" {
+		parsed, err := parseStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		filter = interfaces.InvoiceFilter{Status: parsed}
+	}
+
+	groups, err := s.invoiceRepository.Summarize(ctx, ownerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &response.InvoiceSummaryResponse{
+		Currency:   target,
+		ByCurrency: []response.InvoiceCurrencyTotals{},
+	}
+	byCurrency := make(map[string]int)
+	for _, group := range groups {
+		rate, err := s.conversionRate(ctx, ownerID, group.Currency, group.BaseCurrency, group.ExchangeRate, target)
+		if err != nil {
+			return nil, err
+		}
+		converted, err := convertAmounts(group.Currency, target, rate, group.GrandPrice, group.AmountPaid, group.OutstandingBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		i, ok := byCurrency[group.Currency]
+		if !ok {
+			i = len(summary.ByCurrency)
+			byCurrency[group.Currency] = i
+			summary.ByCurrency = append(summary.ByCurrency, response.InvoiceCurrencyTotals{
+				Currency:  group.Currency,
+				Converted: response.ConvertedAmounts{Currency: target, ExchangeRate: converted.ExchangeRate},
+			})
+		}
+		totals := &summary.ByCurrency[i]
+		if totals.Converted.ExchangeRate != converted.ExchangeRate {
+			totals.Converted.ExchangeRate = ""
+		}
+
+		amounts := []*int64{
+			&totals.GrandPrice, &totals.AmountPaid, &totals.OutstandingBalance,
+			&totals.Converted.GrandPrice, &totals.Converted.AmountPaid, &totals.Converted.OutstandingBalance,
+			&summary.GrandPrice, &summary.AmountPaid, &summary.OutstandingBalance,
+		}
+		values := []int64{
+			group.GrandPrice, group.AmountPaid, group.OutstandingBalance,
+			converted.GrandPrice, converted.AmountPaid, converted.OutstandingBalance,
+			converted.GrandPrice, converted.AmountPaid, converted.OutstandingBalance,
+		}
+		for j, amount := range amounts {
+			if *amount, err = addAmounts(*amount, values[j]); err != nil {
+				return nil, err
+			}
+		}
+		totals.Count += group.Count
+		summary.Count += group.Count
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,66 @@
+package invoice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.New()
+	usd := "USD"
+	snapshot := "1.1"
+	identity := "1"
+
+	mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+	mockInvoiceRepo.EXPECT().
+		Summarize(gomock.Any(), ownerID, interfaces.InvoiceFilter{Statuses: receivableStatuses}).
+		Return([]interfaces.InvoiceTotals{
+			{Currency: "EUR", BaseCurrency: &usd, ExchangeRate: &snapshot, Count: 2, GrandPrice: 2000, AmountPaid: 500, OutstandingBalance: 1500},
+			{Currency: "EUR", Count: 1, GrandPrice: 100, OutstandingBalance: 100},
+			{Currency: "USD", BaseCurrency: &usd, ExchangeRate: &identity, Count: 1, GrandPrice: 1000, OutstandingBalance: 1000},
+		}, nil).
+		Times(1)
+
+	// Only the group without a snapshot against USD needs the latest rate
+	mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+	mockExchangeRateRepo.EXPECT().
+		FindLatest(gomock.Any(), ownerID, "EUR", "USD", gomock.Any()).
+		Return(&entity.ExchangeRateEntity{Rate: "1.2"}, nil).
+		Times(1)
+
+	svc := NewInvoiceService(mockInvoiceRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mockExchangeRateRepo, newMockUnitOfWork(ctrl), InvoiceConfig{
+		BaseCurrency: "USD",
+	})
+	summary, err := svc.Summary(context.Background(), ownerID, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if summary.Currency != "USD" || summary.Count != 4 {
+		t.Fatalf("expected 4 invoices in USD, got %d in %s", summary.Count, summary.Currency)
+	}
+	// 2000 × 1.1 + 100 × 1.2 + 1000
+	if summary.GrandPrice != 3320 || summary.AmountPaid != 550 || summary.OutstandingBalance != 2770 {
+		t.Errorf("unexpected converted totals %+v", summary)
+	}
+	if len(summary.ByCurrency) != 2 {
+		t.Fatalf("expected 2 currencies, got %+v", summary.ByCurrency)
+	}
+
+	eur := summary.ByCurrency[0]
+	if eur.Currency != "EUR" || eur.Count != 3 || eur.GrandPrice != 2100 || eur.Converted.GrandPrice != 2320 {
+		t.Errorf("unexpected EUR totals %+v", eur)
+	}
+	if eur.Converted.ExchangeRate != "" {
+		t.Errorf("expected no single rate for EUR converted at two rates, got %s", eur.Converted.ExchangeRate)
+	}
+}
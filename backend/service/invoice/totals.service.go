@@ -0,0 +1,163 @@
+package invoice
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// basisPoints is the denominator of rates expressed in basis points (100%)
+const basisPoints = 10000
+
+// lineTotal returns quantity × unitPrice in minor units
+func lineTotal(quantity int, unitPrice int64) (int64, error) {
+	if quantity <= 0 {
+		return 0, ErrInvalidQuantity
+	}
+	if unitPrice < 0 {
+		return 0, apperror.New(apperror.ErrValidation, "invalid_unit_price", "unit_price must not be negative")
+	}
+	if unitPrice > math.MaxInt64/int64(quantity) {
+		return 0, apperror.New(apperror.ErrValidation, "amount_too_large", "line total is too large")
+	}
+
+	return int64(quantity) * unitPrice, nil
+}
+
+// applyRate returns amount × rate / 10000 for a rate in basis points,
+// rounding halves up to the next minor unit
+func applyRate(amount, rate int64) (int64, error) {
+	if rate != 0 && amount > (math.MaxInt64-basisPoints/2)/rate {
+		return 0, apperror.New(apperror.ErrValidation, "amount_too_large", "amount is too large")
+	}
+
+	return (amount*rate + basisPoints/2) / basisPoints, nil
+}
+
+// addAmounts sums amounts in minor units, failing instead of overflowing
+func addAmounts(amounts ...int64) (int64, error) {
+	var total int64
+	for _, amount := range amounts {
+		if amount > math.MaxInt64-total {
+			return 0, apperror.New(apperror.ErrValidation, "amount_too_large", "total is too large")
+		}
+		total += amount
+	}
+
+	return total, nil
+}
+
+// lineDiscount returns the amount a discount takes off a line subtotal
+func lineDiscount(subtotal int64, discountType entity.DiscountType, value int64) (int64, error) {
+	if value < 0 {
+		return 0, apperror.New(apperror.ErrValidation, "invalid_discount", "discount_value must not be negative")
+	}
+
+	switch discountType {
+	case "":
+		if value != 0 {
+			return 0, apperror.New(apperror.ErrValidation, "invalid_discount", "discount_value requires a discount_type")
+		}
+		return 0, nil
+	case entity.DiscountTypePercent:
+		if value > basisPoints {
+			return 0, apperror.New(apperror.ErrValidation, "invalid_discount", "percent discount must not exceed 10000 basis points")
+		}
+		return applyRate(subtotal, value)
+	case entity.DiscountTypeFixed:
+		if value > subtotal {
+			return 0, apperror.New(apperror.ErrValidation, "invalid_discount", "fixed discount exceeds the line subtotal")
+		}
+		return value, nil
+	default:
+		return 0, apperror.Errorf(apperror.ErrValidation, "invalid_discount", "invalid discount_type %q", discountType)
+	}
+}
+
+// priceLine fills in the amounts of an invoice line from its quantity, unit
+// price, discount and, when the line has its own tax rate, that rate
+func priceLine(line *entity.InvoiceItemEntity) error {
+	subtotal, err := lineTotal(line.Quantity, line.UnitPrice)
+	if err != nil {
+		return err
+	}
+	discount, err := lineDiscount(subtotal, line.DiscountType, line.DiscountValue)
+	if err != nil {
+		return err
+	}
+
+	var tax int64
+	if line.TaxRateID != nil {
+		if tax, err = applyRate(subtotal-discount, line.TaxRate); err != nil {
+			return err
+		}
+	}
+	total, err := addAmounts(subtotal-discount, tax)
+	if err != nil {
+		return err
+	}
+
+	line.Subtotal = subtotal
+	line.DiscountAmount = discount
+	line.TaxAmount = tax
+	line.TotalPrice = total
+	return nil
+}
+
+// priceInvoice computes the subtotal, discount, tax and grand total of an
+// invoice from its priced lines. The invoice-level tax rate, when set, is
+// applied once to the combined discounted amount of the lines without a tax
+// rate of their own, so it is rounded a single time.
+func priceInvoice(invoice *entity.InvoiceEntity) error {
+	var subtotal, discount, tax, untaxed int64
+	var err error
+	for _, line := range invoice.Items {
+		if subtotal, err = addAmounts(subtotal, line.Subtotal); err != nil {
+			return err
+		}
+		if discount, err = addAmounts(discount, line.DiscountAmount); err != nil {
+			return err
+		}
+		if tax, err = addAmounts(tax, line.TaxAmount); err != nil {
+			return err
+		}
+		if line.TaxRateID == nil {
+			if untaxed, err = addAmounts(untaxed, line.Subtotal-line.DiscountAmount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if invoice.TaxRateID != nil {
+		invoiceTax, err := applyRate(untaxed, invoice.TaxRate)
+		if err != nil {
+			return err
+		}
+		if tax, err = addAmounts(tax, invoiceTax); err != nil {
+			return err
+		}
+	}
+
+	grand, err := addAmounts(subtotal-discount, tax)
+	if err != nil {
+		return err
+	}
+
+	invoice.Subtotal = subtotal
+	invoice.DiscountTotal = discount
+	invoice.TaxTotal = tax
+	invoice.GrandPrice = grand
+	return nil
+}
+
+// checkGrandPrice rejects a client-supplied grand total that disagrees with the
+// server-computed one. A nil value means the client left it to the server.
+func checkGrandPrice(supplied *int64, computed int64) error {
+	if supplied != nil && *supplied != computed {
+		return fmt.Errorf("%w: expected %d, got %d", ErrGrandPriceMismatch, computed, *supplied)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package invoice
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+func TestLineTotal(t *testing.T) {
+	tests := []struct {
+		name          string
+		quantity      int
+		unitPrice     int64
+		expected      int64
+		expectedError bool
+	}{
+		{
+			name:      "should multiply quantity by unit price",
+			quantity:  3,
+			unitPrice: 1999,
+			expected:  5997,
+		},
+		{
+			name:      "should allow a zero unit price",
+			quantity:  2,
+			unitPrice: 0,
+			expected:  0,
+		},
+		{
+			name:          "should reject a zero quantity",
+			quantity:      0,
+			unitPrice:     100,
+			expectedError: true,
+		},
+		{
+			name:          "should reject a negative unit price",
+			quantity:      1,
+			unitPrice:     -1,
+			expectedError: true,
+		},
+		{
+			name:          "should reject a total that overflows",
+			quantity:      2,
+			unitPrice:     math.MaxInt64/2 + 1,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := lineTotal(tt.quantity, tt.unitPrice)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if total != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, total)
+			}
+		})
+	}
+}
+
+func TestApplyRate(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        int64
+		rate          int64
+		expected      int64
+		expectedError bool
+	}{
+		{name: "should apply a whole percentage", amount: 1000, rate: 2000, expected: 200},
+		{name: "should round half a minor unit up", amount: 5, rate: 1000, expected: 1},
+		{name: "should round below half a minor unit down", amount: 4, rate: 1000, expected: 0},
+		{name: "should return zero for a zero rate", amount: 1000, rate: 0, expected: 0},
+		{name: "should reject an amount that overflows", amount: math.MaxInt64 / 2, rate: 10000, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyRate(tt.amount, tt.rate)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("applyRate() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLineDiscount(t *testing.T) {
+	tests := []struct {
+		name          string
+		discountType  entity.DiscountType
+		value         int64
+		expected      int64
+		expectedError bool
+	}{
+		{name: "should take nothing off without a discount", expected: 0},
+		{name: "should take a percentage off", discountType: entity.DiscountTypePercent, value: 1250, expected: 125},
+		{name: "should take a fixed amount off", discountType: entity.DiscountTypeFixed, value: 300, expected: 300},
+		{name: "should allow a full discount", discountType: entity.DiscountTypePercent, value: 10000, expected: 1000},
+		{name: "should reject a value without a type", value: 100, expectedError: true},
+		{name: "should reject a percentage above 100%", discountType: entity.DiscountTypePercent, value: 10001, expectedError: true},
+		{name: "should reject a fixed discount above the subtotal", discountType: entity.DiscountTypeFixed, value: 1001, expectedError: true},
+		{name: "should reject a negative discount", discountType: entity.DiscountTypeFixed, value: -1, expectedError: true},
+		{name: "should reject an unknown type", discountType: "bogof", value: 1, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := lineDiscount(1000, tt.discountType, tt.value)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("lineDiscount() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPriceInvoice(t *testing.T) {
+	rateID := uuid.New()
+
+	tests := []struct {
+		name             string
+		invoice          entity.InvoiceEntity
+		expectedSubtotal int64
+		expectedDiscount int64
+		expectedTax      int64
+		expectedGrand    int64
+		expectedError    bool
+	}{
+		{
+			name: "should sum untaxed lines",
+			invoice: entity.InvoiceEntity{Items: []entity.InvoiceItemEntity{
+				{Subtotal: 1010, TotalPrice: 1010},
+				{Subtotal: 2020, TotalPrice: 2020},
+				{Subtotal: 3030, TotalPrice: 3030},
+			}},
+			expectedSubtotal: 6060,
+			expectedGrand:    6060,
+		},
+		{
+			name: "should tax lines without their own rate once at the invoice rate",
+			invoice: entity.InvoiceEntity{
+				TaxRateID: &rateID,
+				TaxRate:   1000,
+				Items: []entity.InvoiceItemEntity{
+					{Subtotal: 5, TotalPrice: 5},
+					{Subtotal: 5, TotalPrice: 5},
+					{Subtotal: 1000, DiscountAmount: 100, TaxRateID: &rateID, TaxRate: 500, TaxAmount: 45, TotalPrice: 945},
+				},
+			},
+			expectedSubtotal: 1010,
+			expectedDiscount: 100,
+			expectedTax:      46,
+			expectedGrand:    956,
+		},
+		{
+			name: "should reject a total that overflows",
+			invoice: entity.InvoiceEntity{Items: []entity.InvoiceItemEntity{
+				{Subtotal: math.MaxInt64},
+				{Subtotal: 1},
+			}},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoice := tt.invoice
+			err := priceInvoice(&invoice)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("priceInvoice() error = %v, expectedError %v", err, tt.expectedError)
+			}
+			if tt.expectedError {
+				return
+			}
+			if invoice.Subtotal != tt.expectedSubtotal || invoice.DiscountTotal != tt.expectedDiscount ||
+				invoice.TaxTotal != tt.expectedTax || invoice.GrandPrice != tt.expectedGrand {
+				t.Errorf("expected %d - %d + %d = %d, got %d - %d + %d = %d",
+					tt.expectedSubtotal, tt.expectedDiscount, tt.expectedTax, tt.expectedGrand,
+					invoice.Subtotal, invoice.DiscountTotal, invoice.TaxTotal, invoice.GrandPrice)
+			}
+		})
+	}
+}
+
+func TestCheckGrandPrice(t *testing.T) {
+	matching := int64(500)
+	mismatching := int64(499)
+
+	if err := checkGrandPrice(nil, 500); err != nil {
+		t.Errorf("expected omitted grand price to be accepted, got %v", err)
+	}
+	if err := checkGrandPrice(&matching, 500); err != nil {
+		t.Errorf("expected matching grand price to be accepted, got %v", err)
+	}
+	if err := checkGrandPrice(&mismatching, 500); !errors.Is(err, ErrGrandPriceMismatch) {
+		t.Errorf("expected ErrGrandPriceMismatch, got %v", err)
+	}
+}
@@ -2,7 +2,6 @@ package invoice
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
@@ -10,68 +9,78 @@ import (
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
-// Update updates an invoice
-func (s *invoiceService) Update(ctx context.Context, id uuid.UUID, req *request.UpdateInvoiceRequest) (*response.InvoiceDetailResponse, error) {
+// Update replaces the customer, lines, tags and tax rate of a draft invoice in a single
+// transaction. The currency is kept unless the request sets a new one.
+func (s *invoiceService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateInvoiceRequest) (*response.InvoiceDetailResponse, error) {
 	if len(req.Items) == 0 {
-		return nil, errors.New("at least one item is required")
+		return nil, ErrItemsRequired
 	}
 
-	// Check if invoice exists
-	_, err := s.invoiceRepository.FindByID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
-	// Delete existing invoice items and tags
-	if err := s.invoiceRepository.DeleteInvoiceItems(ctx, id); err != nil {
-		return nil, err
-	}
-	if err := s.invoiceRepository.DeleteInvoiceTags(ctx, id); err != nil {
-		return nil, err
-	}
-
-	// Update grand price
-	invoice := entity.InvoiceEntity{
-		GrandPrice: req.GrandPrice,
-	}
-	if err := s.invoiceRepository.Update(ctx, id, invoice); err != nil {
-		return nil, err
-	}
-
-	// Create new invoice items with proper invoice ID
-	invoiceItems := make([]entity.InvoiceItemEntity, len(req.Items))
-	for i, item := range req.Items {
-		totalPrice := float64(item.Quantity) * item.UnitPrice
-		invoiceItems[i] = entity.InvoiceItemEntity{
-			InvoiceID:  id,
-			ItemID:     item.ItemID,
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			TotalPrice: totalPrice,
+	var updated *entity.InvoiceEntity
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Check if invoice exists and is still a draft
+		existing, err := s.findEditable(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		invoiceCurrency, err := resolveCurrency(req.Currency, existing.Currency)
+		if err != nil {
+			return err
+		}
+		customer, err := s.findCustomer(ctx, ownerID, req.CustomerID)
+		if err != nil {
+			return err
 		}
-	}
 
-	// Re-create invoice with items and tags for associations
-	invoiceWithRelations := entity.InvoiceEntity{
-		ID:         id,
-		GrandPrice: req.GrandPrice,
-		Items:      invoiceItems,
-	}
+		// Resolve the new items and tags before touching the stored invoice
+		invoiceItems, err := s.buildInvoiceItems(ctx, ownerID, id, req.Items)
+		if err != nil {
+			return err
+		}
+		tags, err := s.findOwnedTags(ctx, ownerID, req.Tags)
+		if err != nil {
+			return err
+		}
 
-	// Build tags
-	if len(req.Tags) > 0 {
-		tags := make([]entity.TagEntity, len(req.Tags))
-		for i, tagID := range req.Tags {
-			tags[i] = entity.TagEntity{ID: tagID}
+		// The totals are always derived from the lines
+		pricing := entity.InvoiceEntity{CustomerID: &customer.ID, Currency: invoiceCurrency, Items: invoiceItems}
+		if err := s.applyInvoiceTax(ctx, ownerID, &pricing, req.TaxRateID); err != nil {
+			return err
+		}
+		if err := priceInvoice(&pricing); err != nil {
+			return err
+		}
+		if err := checkGrandPrice(req.GrandPrice, pricing.GrandPrice); err != nil {
+			return err
 		}
-		invoiceWithRelations.Tags = tags
-	}
 
-	// Save with associations - this creates items and tag associations
-	s.invoiceRepository.Create(ctx, invoiceWithRelations)
+		// Replace items and tags
+		if err := s.invoiceRepository.DeleteInvoiceItems(ctx, id); err != nil {
+			return err
+		}
+		if err := s.invoiceRepository.DeleteInvoiceTags(ctx, id); err != nil {
+			return err
+		}
+		if err := s.invoiceRepository.Update(ctx, ownerID, id, pricing); err != nil {
+			return err
+		}
+		if err := s.invoiceRepository.CreateInvoiceItems(ctx, invoiceItems); err != nil {
+			return err
+		}
+		if err := s.invoiceRepository.AddInvoiceTags(ctx, id, tags); err != nil {
+			return err
+		}
 
-	// Fetch updated invoice with all relations
-	updated, err := s.invoiceRepository.FindByID(ctx, id)
+		// Fetch updated invoice with all relations
+		updated, err = s.invoiceRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrInvoiceNotFound
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
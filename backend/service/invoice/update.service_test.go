@@ -0,0 +1,174 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestUpdateRejectsNonDraft(t *testing.T) {
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+
+	for _, status := range []entity.InvoiceStatus{
+		entity.InvoiceStatusIssued,
+		entity.InvoiceStatusPartiallyPaid,
+		entity.InvoiceStatusPaid,
+		entity.InvoiceStatusVoid,
+	} {
+		t.Run("should reject updating a "+string(status)+" invoice", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockInvoiceRepo.EXPECT().
+				LockByID(gomock.Any(), ownerID, invoiceID).
+				Return(&entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, Status: status}, nil).
+				Times(2)
+
+			svc := NewInvoiceService(mockInvoiceRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), mock.NewMockExchangeRateRepository(ctrl), newMockUnitOfWork(ctrl), InvoiceConfig{})
+
+			_, err := svc.Update(context.Background(), ownerID, invoiceID, &request.UpdateInvoiceRequest{
+				Items: []request.InvoiceItemInput{{ItemID: uuid.New(), Quantity: 1, UnitPrice: 100}},
+			})
+			if err != ErrInvoiceNotEditable {
+				t.Errorf("expected ErrInvoiceNotEditable on update, got %v", err)
+			}
+
+			if err := svc.Delete(context.Background(), ownerID, invoiceID); err != ErrInvoiceNotEditable {
+				t.Errorf("expected ErrInvoiceNotEditable on delete, got %v", err)
+			}
+		})
+	}
+}
+
+// txMarker marks contexts created by the recording unit of work in TestUpdate
+type txMarker struct{}
+
+// inTx matches contexts handed out by the recording unit of work
+type inTx struct{}
+
+func (inTx) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(txMarker{}) != nil
+}
+
+func (inTx) String() string { return "is a context inside the unit of work" }
+
+func TestUpdate(t *testing.T) {
+	ownerID := uuid.New()
+	invoiceID := uuid.New()
+	customerID := uuid.New()
+	itemID := uuid.New()
+	tagID := uuid.New()
+
+	tests := []struct {
+		name          string
+		createErr     error
+		expectedError error
+	}{
+		{
+			name: "should replace lines and tags inside the unit of work",
+		},
+		{
+			name:          "should return the failure so the unit of work rolls back",
+			createErr:     errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var unitOfWorkErr error
+			unitOfWork := mock.NewMockUnitOfWork(ctrl)
+			unitOfWork.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					unitOfWorkErr = fn(context.WithValue(ctx, txMarker{}, true))
+					return unitOfWorkErr
+				}).
+				Times(1)
+
+			mockInvoiceRepo := mock.NewMockInvoiceRepository(ctrl)
+			mockCustomerRepo := mock.NewMockCustomerRepository(ctrl)
+			mockItemRepo := mock.NewMockItemRepository(ctrl)
+			mockTagRepo := mock.NewMockTagRepository(ctrl)
+			mockTaxRateRepo := mock.NewMockTaxRateRepository(ctrl)
+
+			mockInvoiceRepo.EXPECT().
+				LockByID(inTx{}, ownerID, invoiceID).
+				Return(&entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, Status: entity.InvoiceStatusDraft}, nil).
+				Times(1)
+			mockCustomerRepo.EXPECT().
+				FindByID(inTx{}, ownerID, customerID).
+				Return(&entity.CustomerEntity{ID: customerID, OwnerID: ownerID}, nil).
+				Times(1)
+			mockItemRepo.EXPECT().
+				FindByID(inTx{}, ownerID, itemID).
+				Return(&entity.ItemEntity{ID: itemID, OwnerID: ownerID}, nil).
+				Times(1)
+			mockTagRepo.EXPECT().
+				FindByID(inTx{}, ownerID, tagID).
+				Return(&entity.TagEntity{ID: tagID, OwnerID: ownerID}, nil).
+				Times(1)
+
+			gomock.InOrder(
+				mockInvoiceRepo.EXPECT().DeleteInvoiceItems(inTx{}, invoiceID).Return(nil),
+				mockInvoiceRepo.EXPECT().DeleteInvoiceTags(inTx{}, invoiceID).Return(nil),
+				mockInvoiceRepo.EXPECT().
+					Update(inTx{}, ownerID, invoiceID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID, invoice entity.InvoiceEntity) error {
+						if invoice.CustomerID == nil || *invoice.CustomerID != customerID {
+							t.Errorf("expected customer %v, got %v", customerID, invoice.CustomerID)
+						}
+						if invoice.Subtotal != 1500 || invoice.GrandPrice != 1500 {
+							t.Errorf("expected subtotal and grand price 1500, got %d and %d", invoice.Subtotal, invoice.GrandPrice)
+						}
+						return nil
+					}),
+				mockInvoiceRepo.EXPECT().
+					CreateInvoiceItems(inTx{}, gomock.Len(1)).
+					Return(tt.createErr),
+			)
+			if tt.createErr == nil {
+				mockInvoiceRepo.EXPECT().AddInvoiceTags(inTx{}, invoiceID, gomock.Len(1)).Return(nil).Times(1)
+				mockInvoiceRepo.EXPECT().
+					FindByID(inTx{}, ownerID, invoiceID).
+					Return(&entity.InvoiceEntity{ID: invoiceID, OwnerID: ownerID, GrandPrice: 1500}, nil).
+					Times(1)
+			}
+
+			svc := NewInvoiceService(mockInvoiceRepo, mockCustomerRepo, mockItemRepo, mockTagRepo, mockTaxRateRepo, mock.NewMockExchangeRateRepository(ctrl), unitOfWork, InvoiceConfig{})
+			result, err := svc.Update(context.Background(), ownerID, invoiceID, &request.UpdateInvoiceRequest{
+				CustomerID: customerID,
+				Items:      []request.InvoiceItemInput{{ItemID: itemID, Quantity: 3, UnitPrice: 500}},
+				Tags:       []uuid.UUID{tagID},
+			})
+
+			if tt.expectedError != nil {
+				if err == nil || err.Error() != tt.expectedError.Error() {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				if unitOfWorkErr == nil {
+					t.Errorf("expected the unit of work to observe the failure")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.GrandPrice != 1500 {
+				t.Errorf("expected grand price 1500, got %d", result.GrandPrice)
+			}
+		})
+	}
+}
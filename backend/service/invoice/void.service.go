@@ -0,0 +1,25 @@
+package invoice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Void cancels an invoice that has not received any payment
+func (s *invoiceService) Void(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceDetailResponse, error) {
+	voided, err := s.transition(ctx, ownerID, id, func(invoice *entity.InvoiceEntity) error {
+		now := time.Now()
+		invoice.Status = entity.InvoiceStatusVoid
+		invoice.VoidedAt = &now
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toDetailResponse(voided), nil
+}
@@ -0,0 +1,37 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Create creates a new invoice template owned by ownerID. Templates are
+// active unless the request says otherwise.
+func (s *invoiceTemplateService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateInvoiceTemplateRequest) (*response.InvoiceTemplateResponse, error) {
+	template, err := s.build(ctx, ownerID, templateInput(*req), entity.InvoiceTemplateEntity{
+		ID:     uuid.New(),
+		Active: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.templateRepository.Create(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.templateRepository.FindByID(ctx, ownerID, *id)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrInvoiceTemplateNotFound
+	}
+
+	return toResponse(created), nil
+}
@@ -0,0 +1,149 @@
+package invoicetemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	invoiceSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoice"
+)
+
+func TestCreate(t *testing.T) {
+	ownerID := uuid.New()
+	customerID := uuid.New()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name             string
+		req              request.CreateInvoiceTemplateRequest
+		itemFound        bool
+		expectCreate     bool
+		expectedCurrency string
+		expectedAnchor   int
+		expectedError    error
+	}{
+		{
+			name: "should create an active template in the base currency",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", CustomerID: customerID, Cadence: "monthly", NextRunDate: "2024-01-31",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			itemFound:        true,
+			expectCreate:     true,
+			expectedCurrency: "USD",
+			expectedAnchor:   31,
+		},
+		{
+			name: "should normalise the template currency",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", CustomerID: customerID, Cadence: "yearly", NextRunDate: "2024-06-01", Currency: "eur",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			itemFound:        true,
+			expectCreate:     true,
+			expectedCurrency: "EUR",
+			expectedAnchor:   1,
+		},
+		{
+			name: "should reject an unknown cadence",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", CustomerID: customerID, Cadence: "daily",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			expectedError: ErrInvalidCadence,
+		},
+		{
+			name: "should reject an unknown currency",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", CustomerID: customerID, Cadence: "monthly", Currency: "XYZ",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			expectedError: invoiceSvc.ErrInvalidCurrency,
+		},
+		{
+			name: "should reject a template without a customer",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", Cadence: "monthly",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			expectedError: invoiceSvc.ErrCustomerRequired,
+		},
+		{
+			name: "should reject an item owned by another user",
+			req: request.CreateInvoiceTemplateRequest{
+				Name: "Hosting", CustomerID: customerID, Cadence: "monthly",
+				Items: []request.InvoiceItemInput{{ItemID: itemID, Quantity: 1, UnitPrice: 1000}},
+			},
+			itemFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCustomerRepo := mock.NewMockCustomerRepository(ctrl)
+			mockItemRepo := mock.NewMockItemRepository(ctrl)
+			if tt.expectedError == nil {
+				mockCustomerRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, customerID).
+					Return(&entity.CustomerEntity{ID: customerID, OwnerID: ownerID}, nil).
+					Times(1)
+				var item *entity.ItemEntity
+				if tt.itemFound {
+					item = &entity.ItemEntity{ID: itemID, OwnerID: ownerID}
+				}
+				mockItemRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, itemID).
+					Return(item, nil).
+					Times(1)
+			}
+
+			var stored entity.InvoiceTemplateEntity
+			mockTemplateRepo := mock.NewMockInvoiceTemplateRepository(ctrl)
+			if tt.expectCreate {
+				mockTemplateRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, template entity.InvoiceTemplateEntity) (*uuid.UUID, error) {
+						stored = template
+						return &template.ID, nil
+					}).
+					Times(1)
+				mockTemplateRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, gomock.Any()).
+					DoAndReturn(func(context.Context, uuid.UUID, uuid.UUID) (*entity.InvoiceTemplateEntity, error) {
+						return &stored, nil
+					}).
+					Times(1)
+			}
+
+			service := NewInvoiceTemplateService(mockTemplateRepo, mockCustomerRepo, mockItemRepo, mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), &fakeInvoiceService{}, newMockUnitOfWork(ctrl), InvoiceTemplateConfig{})
+			result, err := service.Create(context.Background(), ownerID, &tt.req)
+
+			if !tt.expectCreate {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", result)
+				}
+				if tt.expectedError != nil && !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Active || result.Currency != tt.expectedCurrency || result.NextRunDate != tt.req.NextRunDate {
+				t.Errorf("unexpected template %+v", result)
+			}
+			if stored.OwnerID != ownerID || stored.AnchorDay != tt.expectedAnchor || len(stored.Items) != 1 {
+				t.Errorf("unexpected stored template %+v", stored)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes an invoice template. Invoices it already created are kept.
+func (s *invoiceTemplateService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	template, err := s.templateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if template == nil {
+		return ErrInvoiceTemplateNotFound
+	}
+
+	return s.templateRepository.Delete(ctx, ownerID, id)
+}
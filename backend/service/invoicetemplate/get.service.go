@@ -0,0 +1,54 @@
+package invoicetemplate
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// GetByID gets an invoice template by ID
+func (s *invoiceTemplateService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceTemplateResponse, error) {
+	template, err := s.templateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, ErrInvoiceTemplateNotFound
+	}
+
+	return toResponse(template), nil
+}
+
+// GetAll gets all invoice templates with pagination
+func (s *invoiceTemplateService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int) (*response.InvoiceTemplatePaginationResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	templates, total, err := s.templateRepository.FindAll(ctx, ownerID, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	templateResponses := make([]response.InvoiceTemplateResponse, len(templates))
+	for i := range templates {
+		templateResponses[i] = *toResponse(&templates[i])
+	}
+
+	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+
+	return &response.InvoiceTemplatePaginationResponse{
+		Data: templateResponses,
+		Meta: response.InvoiceTemplatePaginationMeta{
+			TotalData: int(total),
+			Page:      page,
+			Limit:     limit,
+			TotalPage: totalPage,
+		},
+	}, nil
+}
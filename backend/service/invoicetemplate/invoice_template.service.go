@@ -0,0 +1,311 @@
+package invoicetemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+	invoiceSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoice"
+)
+
+// DateLayout is the format of template run dates
+const DateLayout = "2006-01-02"
+
+// ErrInvoiceTemplateNotFound is returned when a template does not exist or belongs to another user
+var ErrInvoiceTemplateNotFound = apperror.New(apperror.ErrNotFound, "invoice_template_not_found", "invoice template not found")
+
+// ErrInvalidCadence is returned for a cadence other than weekly, monthly, quarterly or yearly
+var ErrInvalidCadence = apperror.New(apperror.ErrValidation, "invalid_cadence", "cadence must be weekly, monthly, quarterly or yearly")
+
+// InvoiceTemplateService defines the interface for recurring invoice
+// templates. CRUD operations are scoped to the templates owned by ownerID;
+// RunDue works across all owners and is meant for the scheduler.
+type InvoiceTemplateService interface {
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateInvoiceTemplateRequest) (*response.InvoiceTemplateResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.InvoiceTemplateResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateInvoiceTemplateRequest) (*response.InvoiceTemplateResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int) (*response.InvoiceTemplatePaginationResponse, error)
+	// RunDue creates the invoices of every template due at now and returns
+	// how many were created
+	RunDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// InvoiceTemplateConfig holds invoice template service configuration
+type InvoiceTemplateConfig struct {
+	// BaseCurrency is the currency of templates that do not set one
+	BaseCurrency string
+}
+
+// invoiceTemplateService is the concrete implementation of InvoiceTemplateService
+type invoiceTemplateService struct {
+	templateRepository interfaces.InvoiceTemplateRepository
+	customerRepository interfaces.CustomerRepository
+	itemRepository     interfaces.ItemRepository
+	tagRepository      interfaces.TagRepository
+	taxRateRepository  interfaces.TaxRateRepository
+	invoiceService     invoiceSvc.InvoiceService
+	unitOfWork         interfaces.UnitOfWork
+	config             InvoiceTemplateConfig
+}
+
+// NewInvoiceTemplateService creates a new instance of InvoiceTemplateService.
+// Invoices are created through invoiceService, so they are priced and
+// validated exactly like invoices created by hand.
+func NewInvoiceTemplateService(templateRepository interfaces.InvoiceTemplateRepository, customerRepository interfaces.CustomerRepository, itemRepository interfaces.ItemRepository, tagRepository interfaces.TagRepository, taxRateRepository interfaces.TaxRateRepository, invoiceService invoiceSvc.InvoiceService, unitOfWork interfaces.UnitOfWork, config InvoiceTemplateConfig) InvoiceTemplateService {
+	if config.BaseCurrency == "" {
+		config.BaseCurrency = invoiceSvc.DefaultBaseCurrency
+	}
+
+	return &invoiceTemplateService{
+		templateRepository: templateRepository,
+		customerRepository: customerRepository,
+		itemRepository:     itemRepository,
+		tagRepository:      tagRepository,
+		taxRateRepository:  taxRateRepository,
+		invoiceService:     invoiceService,
+		unitOfWork:         unitOfWork,
+		config:             config,
+	}
+}
+
+// templateInput holds the fields shared by create and update requests
+type templateInput struct {
+	Name        string
+	CustomerID  uuid.UUID
+	Currency    string
+	TaxRateID   *uuid.UUID
+	Items       []request.InvoiceItemInput
+	Tags        []uuid.UUID
+	Cadence     string
+	NextRunDate string
+	AutoIssue   bool
+	Active      *bool
+}
+
+// build validates input and turns it into a template owned by ownerID,
+// starting from existing for the fields the input leaves unset
+func (s *invoiceTemplateService) build(ctx context.Context, ownerID uuid.UUID, input templateInput, existing entity.InvoiceTemplateEntity) (entity.InvoiceTemplateEntity, error) {
+	template := existing
+	if input.Name == "" {
+		return template, apperror.New(apperror.ErrValidation, "name_required", "name is required")
+	}
+	if len(input.Items) == 0 {
+		return template, invoiceSvc.ErrItemsRequired
+	}
+
+	cadence := entity.TemplateCadence(input.Cadence)
+	switch cadence {
+	case entity.TemplateCadenceWeekly, entity.TemplateCadenceMonthly, entity.TemplateCadenceQuarterly, entity.TemplateCadenceYearly:
+	default:
+		return template, ErrInvalidCadence
+	}
+
+	if input.Currency != "" {
+		code, err := currency.Normalize(input.Currency)
+		if err != nil {
+			return template, fmt.Errorf("%w %q", invoiceSvc.ErrInvalidCurrency, input.Currency)
+		}
+		template.Currency = code
+	} else if template.Currency == "" {
+		template.Currency = s.config.BaseCurrency
+	}
+
+	if input.NextRunDate != "" {
+		next, err := time.Parse(DateLayout, input.NextRunDate)
+		if err != nil {
+			return template, apperror.Errorf(apperror.ErrValidation, "invalid_date", "next_run_date must be formatted as %s", DateLayout)
+		}
+		template.NextRunDate = next
+		template.AnchorDay = next.Day()
+	} else if template.NextRunDate.IsZero() {
+		template.NextRunDate = truncateToDate(time.Now())
+		template.AnchorDay = template.NextRunDate.Day()
+	}
+
+	if err := s.checkCustomer(ctx, ownerID, input.CustomerID); err != nil {
+		return template, err
+	}
+	if err := s.checkTaxRate(ctx, ownerID, input.TaxRateID); err != nil {
+		return template, err
+	}
+	items, err := s.buildItems(ctx, ownerID, input.Items)
+	if err != nil {
+		return template, err
+	}
+	tags, err := s.findOwnedTags(ctx, ownerID, input.Tags)
+	if err != nil {
+		return template, err
+	}
+
+	template.OwnerID = ownerID
+	template.Name = input.Name
+	template.CustomerID = &input.CustomerID
+	template.TaxRateID = input.TaxRateID
+	template.Cadence = cadence
+	template.AutoIssue = input.AutoIssue
+	if input.Active != nil {
+		template.Active = *input.Active
+	}
+	template.Items = items
+	template.Tags = tags
+	return template, nil
+}
+
+// buildItems turns the requested lines into template lines, rejecting any
+// line that references an item or tax rate not owned by ownerID. Amounts are
+// checked again when each invoice is priced.
+func (s *invoiceTemplateService) buildItems(ctx context.Context, ownerID uuid.UUID, inputs []request.InvoiceItemInput) ([]entity.InvoiceTemplateItemEntity, error) {
+	items := make([]entity.InvoiceTemplateItemEntity, len(inputs))
+	for i, input := range inputs {
+		if input.Quantity < 1 || input.UnitPrice < 0 || input.DiscountValue < 0 {
+			return nil, apperror.New(apperror.ErrValidation, "invalid_line", "quantity must be positive and amounts must not be negative")
+		}
+		switch entity.DiscountType(input.DiscountType) {
+		case "", entity.DiscountTypePercent, entity.DiscountTypeFixed:
+		default:
+			return nil, apperror.Errorf(apperror.ErrValidation, "invalid_discount", "unknown discount type %q", input.DiscountType)
+		}
+
+		item, err := s.itemRepository.FindByID(ctx, ownerID, input.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if item == nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "unknown_item", "item %s not found", input.ItemID)
+		}
+		if err := s.checkTaxRate(ctx, ownerID, input.TaxRateID); err != nil {
+			return nil, err
+		}
+
+		items[i] = entity.InvoiceTemplateItemEntity{
+			ID:            uuid.New(),
+			ItemID:        input.ItemID,
+			Quantity:      input.Quantity,
+			UnitPrice:     input.UnitPrice,
+			DiscountType:  entity.DiscountType(input.DiscountType),
+			DiscountValue: input.DiscountValue,
+			TaxRateID:     input.TaxRateID,
+			Position:      i,
+		}
+	}
+
+	return items, nil
+}
+
+// findOwnedTags loads the requested tags, rejecting any tag not owned by ownerID
+func (s *invoiceTemplateService) findOwnedTags(ctx context.Context, ownerID uuid.UUID, tagIDs []uuid.UUID) ([]entity.TagEntity, error) {
+	tags := make([]entity.TagEntity, len(tagIDs))
+	for i, tagID := range tagIDs {
+		tag, err := s.tagRepository.FindByID(ctx, ownerID, tagID)
+		if err != nil {
+			return nil, err
+		}
+		if tag == nil {
+			return nil, apperror.Errorf(apperror.ErrValidation, "unknown_tag", "tag %s not found", tagID)
+		}
+		tags[i] = *tag
+	}
+
+	return tags, nil
+}
+
+// checkCustomer rejects a missing customer or one not owned by ownerID
+func (s *invoiceTemplateService) checkCustomer(ctx context.Context, ownerID uuid.UUID, customerID uuid.UUID) error {
+	if customerID == uuid.Nil {
+		return invoiceSvc.ErrCustomerRequired
+	}
+
+	customer, err := s.customerRepository.FindByID(ctx, ownerID, customerID)
+	if err != nil {
+		return err
+	}
+	if customer == nil {
+		return apperror.Errorf(apperror.ErrValidation, "unknown_customer", "customer %s not found", customerID)
+	}
+
+	return nil
+}
+
+// checkTaxRate rejects an optional tax rate not owned by ownerID
+func (s *invoiceTemplateService) checkTaxRate(ctx context.Context, ownerID uuid.UUID, taxRateID *uuid.UUID) error {
+	if taxRateID == nil {
+		return nil
+	}
+
+	taxRate, err := s.taxRateRepository.FindByID(ctx, ownerID, *taxRateID)
+	if err != nil {
+		return err
+	}
+	if taxRate == nil {
+		return apperror.Errorf(apperror.ErrValidation, "unknown_tax_rate", "tax rate %s not found", *taxRateID)
+	}
+
+	return nil
+}
+
+// toResponse maps a template to its API representation
+func toResponse(template *entity.InvoiceTemplateEntity) *response.InvoiceTemplateResponse {
+	items := make([]response.InvoiceTemplateItemResponse, len(template.Items))
+	for i, item := range template.Items {
+		items[i] = response.InvoiceTemplateItemResponse{
+			ID:     item.ID,
+			ItemID: item.ItemID,
+			Item: response.ItemResponse{
+				ID:   item.Item.ID,
+				Name: item.Item.Name,
+				Desc: item.Item.Desc,
+			},
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			DiscountType:  string(item.DiscountType),
+			DiscountValue: item.DiscountValue,
+			TaxRateID:     item.TaxRateID,
+		}
+	}
+
+	tags := make([]response.TagResponse, len(template.Tags))
+	for i, tag := range template.Tags {
+		tags[i] = response.TagResponse{
+			ID:       tag.ID,
+			Name:     tag.Name,
+			ColorHex: tag.ColorHex,
+		}
+	}
+
+	var customer *response.InvoiceCustomerResponse
+	if template.Customer != nil {
+		customer = &response.InvoiceCustomerResponse{
+			Name:           template.Customer.Name,
+			Email:          template.Customer.Email,
+			BillingAddress: template.Customer.BillingAddress,
+			TaxID:          template.Customer.TaxID,
+		}
+	}
+
+	return &response.InvoiceTemplateResponse{
+		ID:          template.ID,
+		Name:        template.Name,
+		CustomerID:  template.CustomerID,
+		Customer:    customer,
+		Currency:    template.Currency,
+		TaxRateID:   template.TaxRateID,
+		Cadence:     string(template.Cadence),
+		NextRunDate: template.NextRunDate.UTC().Format(DateLayout),
+		AutoIssue:   template.AutoIssue,
+		Active:      template.Active,
+		LastRunAt:   template.LastRunAt,
+		LastError:   template.LastError,
+		Items:       items,
+		Tags:        tags,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	invoiceSvc "github.com/kamil5b/clean-go-vite-react/backend/service/invoice"
+)
+
+// newMockUnitOfWork returns a unit of work that runs fn directly, as a real
+// transaction would from the service's point of view
+func newMockUnitOfWork(ctrl *gomock.Controller) *mock.MockUnitOfWork {
+	unitOfWork := mock.NewMockUnitOfWork(ctrl)
+	unitOfWork.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+	return unitOfWork
+}
+
+// fakeInvoiceService records the invoices a template run creates and issues.
+// Methods the runner does not use panic through the nil embedded interface.
+type fakeInvoiceService struct {
+	invoiceSvc.InvoiceService
+	created   []*request.CreateInvoiceRequest
+	issued    []uuid.UUID
+	createErr error
+}
+
+func (f *fakeInvoiceService) Create(_ context.Context, _ uuid.UUID, req *request.CreateInvoiceRequest) (*response.InvoiceDetailResponse, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	f.created = append(f.created, req)
+	return &response.InvoiceDetailResponse{ID: uuid.New()}, nil
+}
+
+func (f *fakeInvoiceService) Issue(_ context.Context, _, id uuid.UUID) (*response.InvoiceDetailResponse, error) {
+	f.issued = append(f.issued, id)
+	return &response.InvoiceDetailResponse{ID: id}, nil
+}
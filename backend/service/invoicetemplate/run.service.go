@@ -0,0 +1,161 @@
+package invoicetemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+)
+
+// DueBatchSize is how many due templates RunDue handles per call; the rest
+// are picked up by the next call
+const DueBatchSize = 100
+
+// MaxCatchUpRuns caps how many missed dates of one template RunDue creates
+// invoices for in a single call, e.g. after the server was down for months
+const MaxCatchUpRuns = 24
+
+// RetryBaseDelay is how long a template waits after its first failed run;
+// each further failure in a row doubles the wait, up to MaxRetryDelay
+const RetryBaseDelay = 5 * time.Minute
+
+// MaxRetryDelay caps how long a failing template waits between attempts
+const MaxRetryDelay = 24 * time.Hour
+
+// errRunSkipped rolls back a run that another process already completed
+var errRunSkipped = errors.New("template run skipped")
+
+// RunDue creates an invoice for every date each due template has reached,
+// oldest first. Each date runs in its own unit of work that creates the
+// invoice and advances the template together, and the run is recorded under
+// a unique date per template, so a crash or restart at any point neither
+// skips nor repeats an invoice. A failed template keeps its date and the
+// error is stored on it for the owner to see; it is retried after a delay
+// that grows with each failure in a row, so templates that keep failing do
+// not fill every batch ahead of the ones that can run.
+func (s *invoiceTemplateService) RunDue(ctx context.Context, now time.Time) (int, error) {
+	templates, err := s.templateRepository.FindDue(ctx, now, DueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var failures []error
+	for _, template := range templates {
+		for range MaxCatchUpRuns {
+			ran, err := s.runOnce(ctx, template.OwnerID, template.ID, now)
+			if err != nil {
+				if ctx.Err() != nil {
+					return created, ctx.Err()
+				}
+				failures = append(failures, fmt.Errorf("invoice template %s: %w", template.ID, err))
+				if err := s.templateRepository.RecordFailure(ctx, template.ID, err.Error(), now.Add(retryDelay(template.FailureCount+1))); err != nil {
+					failures = append(failures, err)
+				}
+				break
+			}
+			if !ran {
+				break
+			}
+			created++
+		}
+	}
+
+	return created, errors.Join(failures...)
+}
+
+// retryDelay is how long a template waits after failing the given number of
+// runs in a row
+func retryDelay(failures int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < failures && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryDelay)
+}
+
+// runOnce creates the invoice for the template's next run date if that date
+// has been reached. It returns false when there was nothing to do.
+func (s *invoiceTemplateService) runOnce(ctx context.Context, ownerID, id uuid.UUID, now time.Time) (bool, error) {
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		template, err := s.templateRepository.LockByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if template == nil || !template.Active || template.NextRunDate.After(now) {
+			return errRunSkipped
+		}
+
+		invoice, err := s.invoiceService.Create(ctx, ownerID, toInvoiceRequest(template))
+		if err != nil {
+			return err
+		}
+		if template.AutoIssue {
+			if _, err := s.invoiceService.Issue(ctx, ownerID, invoice.ID); err != nil {
+				return err
+			}
+		}
+
+		recorded, err := s.templateRepository.RecordRun(ctx, entity.InvoiceTemplateRunEntity{
+			ID:         uuid.New(),
+			TemplateID: template.ID,
+			RunDate:    template.NextRunDate,
+			InvoiceID:  invoice.ID,
+			CreatedAt:  now,
+		}, nextRunDate(template.NextRunDate, template.Cadence, template.AnchorDay))
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			return errRunSkipped
+		}
+		return nil
+	})
+	if errors.Is(err, errRunSkipped) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// toInvoiceRequest copies a template's customer, lines, tags and currency
+// into a request for a new invoice
+func toInvoiceRequest(template *entity.InvoiceTemplateEntity) *request.CreateInvoiceRequest {
+	items := make([]request.InvoiceItemInput, len(template.Items))
+	for i, item := range template.Items {
+		items[i] = request.InvoiceItemInput{
+			ItemID:        item.ItemID,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			DiscountType:  string(item.DiscountType),
+			DiscountValue: item.DiscountValue,
+			TaxRateID:     item.TaxRateID,
+		}
+	}
+
+	tags := make([]uuid.UUID, len(template.Tags))
+	for i, tag := range template.Tags {
+		tags[i] = tag.ID
+	}
+
+	// A template without a customer fails like an invoice without one
+	var customerID uuid.UUID
+	if template.CustomerID != nil {
+		customerID = *template.CustomerID
+	}
+
+	return &request.CreateInvoiceRequest{
+		CustomerID: customerID,
+		Currency:   template.Currency,
+		TaxRateID:  template.TaxRateID,
+		Items:      items,
+		Tags:       tags,
+	}
+}
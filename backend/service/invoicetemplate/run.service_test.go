@@ -0,0 +1,210 @@
+package invoicetemplate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestRunDue(t *testing.T) {
+	ownerID := uuid.New()
+	templateID := uuid.New()
+	customerID := uuid.New()
+	itemID := uuid.New()
+	tagID := uuid.New()
+	now := date(2024, 3, 31).Add(9 * time.Hour)
+
+	tests := []struct {
+		name          string
+		nextRunDate   time.Time
+		autoIssue     bool
+		recorded      bool
+		failures      int
+		createErr     error
+		expectedRetry time.Duration
+		expectedRuns  []time.Time
+		expectedCount int
+		expectIssued  bool
+		expectError   bool
+	}{
+		{
+			name:          "should create the invoice for today's run",
+			nextRunDate:   date(2024, 3, 31),
+			recorded:      true,
+			expectedRuns:  []time.Time{date(2024, 3, 31)},
+			expectedCount: 1,
+		},
+		{
+			name:          "should issue the invoice when the template auto-issues",
+			nextRunDate:   date(2024, 3, 31),
+			autoIssue:     true,
+			recorded:      true,
+			expectedRuns:  []time.Time{date(2024, 3, 31)},
+			expectedCount: 1,
+			expectIssued:  true,
+		},
+		{
+			name:          "should catch up on every missed date",
+			nextRunDate:   date(2024, 1, 31),
+			recorded:      true,
+			expectedRuns:  []time.Time{date(2024, 1, 31), date(2024, 2, 29), date(2024, 3, 31)},
+			expectedCount: 3,
+		},
+		{
+			name:          "should skip a date another process already ran",
+			nextRunDate:   date(2024, 3, 31),
+			recorded:      false,
+			expectedRuns:  []time.Time{date(2024, 3, 31)},
+			expectedCount: 0,
+		},
+		{
+			name:          "should store the error of a failed run",
+			nextRunDate:   date(2024, 3, 31),
+			createErr:     errors.New("item not found"),
+			expectedRetry: RetryBaseDelay,
+			expectError:   true,
+		},
+		{
+			name:          "should wait longer after each failure in a row",
+			nextRunDate:   date(2024, 3, 31),
+			failures:      3,
+			createErr:     errors.New("item not found"),
+			expectedRetry: 8 * RetryBaseDelay,
+			expectError:   true,
+		},
+		{
+			name:          "should cap the wait after many failures",
+			nextRunDate:   date(2024, 3, 31),
+			failures:      40,
+			createErr:     errors.New("item not found"),
+			expectedRetry: MaxRetryDelay,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateRepo := mock.NewMockInvoiceTemplateRepository(ctrl)
+			mockTemplateRepo.EXPECT().
+				FindDue(gomock.Any(), now, DueBatchSize).
+				Return([]entity.InvoiceTemplateEntity{{ID: templateID, OwnerID: ownerID, FailureCount: tt.failures}}, nil).
+				Times(1)
+
+			// The stored template follows the runs that have been recorded
+			next := tt.nextRunDate
+			mockTemplateRepo.EXPECT().
+				LockByID(gomock.Any(), ownerID, templateID).
+				DoAndReturn(func(context.Context, uuid.UUID, uuid.UUID) (*entity.InvoiceTemplateEntity, error) {
+					return &entity.InvoiceTemplateEntity{
+						ID:          templateID,
+						OwnerID:     ownerID,
+						CustomerID:  &customerID,
+						Currency:    "EUR",
+						Cadence:     entity.TemplateCadenceMonthly,
+						NextRunDate: next,
+						AnchorDay:   31,
+						AutoIssue:   tt.autoIssue,
+						Active:      true,
+						Items:       []entity.InvoiceTemplateItemEntity{{ItemID: itemID, Quantity: 2, UnitPrice: 1500}},
+						Tags:        []entity.TagEntity{{ID: tagID}},
+					}, nil
+				}).
+				AnyTimes()
+
+			var runs []time.Time
+			mockTemplateRepo.EXPECT().
+				RecordRun(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, run entity.InvoiceTemplateRunEntity, nextRunDate time.Time) (bool, error) {
+					runs = append(runs, run.RunDate)
+					if tt.recorded {
+						next = nextRunDate
+					}
+					return tt.recorded, nil
+				}).
+				AnyTimes()
+
+			if tt.expectError {
+				mockTemplateRepo.EXPECT().
+					RecordFailure(gomock.Any(), templateID, "item not found", now.Add(tt.expectedRetry)).
+					Return(nil).
+					Times(1)
+			}
+
+			invoices := &fakeInvoiceService{createErr: tt.createErr}
+			service := NewInvoiceTemplateService(mockTemplateRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), invoices, newMockUnitOfWork(ctrl), InvoiceTemplateConfig{})
+
+			count, err := service.RunDue(context.Background(), now)
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "item not found") {
+					t.Errorf("expected run error, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("expected %d invoices, got %d", tt.expectedCount, count)
+			}
+
+			if len(runs) != len(tt.expectedRuns) {
+				t.Fatalf("expected runs %v, got %v", tt.expectedRuns, runs)
+			}
+			for i := range runs {
+				if !runs[i].Equal(tt.expectedRuns[i]) {
+					t.Errorf("expected run %d on %s, got %s", i, tt.expectedRuns[i].Format(DateLayout), runs[i].Format(DateLayout))
+				}
+			}
+
+			if len(invoices.created) > 0 {
+				req := invoices.created[0]
+				if req.CustomerID != customerID || req.Currency != "EUR" || len(req.Items) != 1 || req.Items[0].ItemID != itemID || req.Items[0].UnitPrice != 1500 || len(req.Tags) != 1 || req.Tags[0] != tagID {
+					t.Errorf("expected the template's lines, got %+v", req)
+				}
+			}
+			expectedIssued := 0
+			if tt.expectIssued {
+				expectedIssued = tt.expectedCount
+			}
+			if len(invoices.issued) != expectedIssued {
+				t.Errorf("expected %d issued invoices, got %d", expectedIssued, len(invoices.issued))
+			}
+		})
+	}
+}
+
+func TestRunDueSkipsPausedTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.New()
+	templateID := uuid.New()
+	now := date(2024, 3, 31)
+
+	// A template paused after FindDue saw it must not run
+	mockTemplateRepo := mock.NewMockInvoiceTemplateRepository(ctrl)
+	mockTemplateRepo.EXPECT().
+		FindDue(gomock.Any(), now, DueBatchSize).
+		Return([]entity.InvoiceTemplateEntity{{ID: templateID, OwnerID: ownerID}}, nil).
+		Times(1)
+	mockTemplateRepo.EXPECT().
+		LockByID(gomock.Any(), ownerID, templateID).
+		Return(&entity.InvoiceTemplateEntity{ID: templateID, OwnerID: ownerID, NextRunDate: now, Active: false}, nil).
+		Times(1)
+
+	invoices := &fakeInvoiceService{}
+	service := NewInvoiceTemplateService(mockTemplateRepo, mock.NewMockCustomerRepository(ctrl), mock.NewMockItemRepository(ctrl), mock.NewMockTagRepository(ctrl), mock.NewMockTaxRateRepository(ctrl), invoices, newMockUnitOfWork(ctrl), InvoiceTemplateConfig{})
+
+	count, err := service.RunDue(context.Background(), now)
+	if err != nil || count != 0 || len(invoices.created) != 0 {
+		t.Errorf("expected nothing to run, got %d (err %v)", count, err)
+	}
+}
@@ -0,0 +1,35 @@
+package invoicetemplate
+
+import (
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// nextRunDate returns the run date following current. Month-based cadences
+// keep to anchorDay, falling back to the last day of shorter months, so a
+// schedule anchored on the 31st runs on Jan 31, Feb 29, Mar 31 and so on.
+func nextRunDate(current time.Time, cadence entity.TemplateCadence, anchorDay int) time.Time {
+	months := 0
+	switch cadence {
+	case entity.TemplateCadenceWeekly:
+		return current.AddDate(0, 0, 7)
+	case entity.TemplateCadenceMonthly:
+		months = 1
+	case entity.TemplateCadenceQuarterly:
+		months = 3
+	case entity.TemplateCadenceYearly:
+		months = 12
+	}
+
+	// Day 1 never overflows, so AddDate lands in the intended month
+	first := time.Date(current.Year(), current.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, months, 0)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(max(anchorDay, 1), lastDay)-1)
+}
+
+// truncateToDate returns midnight UTC of the calendar date of t in UTC
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
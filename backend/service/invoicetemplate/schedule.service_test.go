@@ -0,0 +1,37 @@
+package invoicetemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNextRunDate(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   time.Time
+		cadence   entity.TemplateCadence
+		anchorDay int
+		expected  time.Time
+	}{
+		{name: "should add a week", current: date(2024, 12, 30), cadence: entity.TemplateCadenceWeekly, anchorDay: 30, expected: date(2025, 1, 6)},
+		{name: "should move to the same day next month", current: date(2024, 1, 15), cadence: entity.TemplateCadenceMonthly, anchorDay: 15, expected: date(2024, 2, 15)},
+		{name: "should clamp to the end of a shorter month", current: date(2024, 1, 31), cadence: entity.TemplateCadenceMonthly, anchorDay: 31, expected: date(2024, 2, 29)},
+		{name: "should return to the anchor day after a short month", current: date(2024, 2, 29), cadence: entity.TemplateCadenceMonthly, anchorDay: 31, expected: date(2024, 3, 31)},
+		{name: "should add three months for quarterly", current: date(2024, 11, 30), cadence: entity.TemplateCadenceQuarterly, anchorDay: 30, expected: date(2025, 2, 28)},
+		{name: "should keep a leap day anchor in later years", current: date(2024, 2, 29), cadence: entity.TemplateCadenceYearly, anchorDay: 29, expected: date(2025, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := nextRunDate(tt.current, tt.cadence, tt.anchorDay); !result.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected.Format(DateLayout), result.Format(DateLayout))
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package invoicetemplate
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Scheduler periodically creates the invoices of due templates inside the
+// server process. Running several servers is safe: every run is guarded by
+// a row lock and a unique run date, so each date yields one invoice.
+type Scheduler struct {
+	service  InvoiceTemplateService
+	interval time.Duration
+	now      func() time.Time
+	done     chan struct{}
+}
+
+// NewScheduler creates a scheduler that calls service.RunDue every interval
+func NewScheduler(service InvoiceTemplateService, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		service:  service,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start runs due templates right away and then every interval until ctx is
+// cancelled. It returns immediately; use Wait to block until a run in
+// progress has finished after cancellation. A non-positive interval disables
+// the scheduler.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.done = make(chan struct{})
+	if s.interval <= 0 {
+		close(s.done)
+		return
+	}
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			s.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Wait blocks until the scheduler started by Start has stopped
+func (s *Scheduler) Wait() {
+	if s.done != nil {
+		<-s.done
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	created, err := s.service.RunDue(ctx, s.now())
+	if err != nil && ctx.Err() == nil {
+		log.Printf("invoice scheduler: %v", err)
+	}
+	if created > 0 {
+		log.Printf("invoice scheduler: created %d invoices", created)
+	}
+}
@@ -0,0 +1,53 @@
+package invoicetemplate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Update replaces a template's lines, tags and schedule. The template is
+// locked so a scheduled run cannot use a half-updated template.
+func (s *invoiceTemplateService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateInvoiceTemplateRequest) (*response.InvoiceTemplateResponse, error) {
+	var updated *entity.InvoiceTemplateEntity
+	err := s.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		existing, err := s.templateRepository.LockByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return ErrInvoiceTemplateNotFound
+		}
+
+		template, err := s.build(ctx, ownerID, templateInput(*req), *existing)
+		if err != nil {
+			return err
+		}
+		// Editing a template is the way to fix the cause of a failed run, so
+		// the next check retries it straight away
+		template.LastError = nil
+		template.FailureCount = 0
+		template.NextAttemptAt = nil
+
+		if err := s.templateRepository.Update(ctx, ownerID, id, template); err != nil {
+			return err
+		}
+
+		updated, err = s.templateRepository.FindByID(ctx, ownerID, id)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrInvoiceTemplateNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toResponse(updated), nil
+}
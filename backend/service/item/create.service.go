@@ -2,22 +2,24 @@ package item
 
 import (
 	"context"
-	"errors"
 
+	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
-// Create creates a new item
-func (s *itemService) Create(ctx context.Context, req *request.CreateItemRequest) (*response.ItemResponse, error) {
+// Create creates a new item owned by ownerID
+func (s *itemService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateItemRequest) (*response.ItemResponse, error) {
 	if req.Name == "" {
-		return nil, errors.New("name is required")
+		return nil, ErrNameRequired
 	}
 
 	item := entity.ItemEntity{
-		Name: req.Name,
-		Desc: req.Desc,
+		ID:      uuid.New(),
+		OwnerID: ownerID,
+		Name:    req.Name,
+		Desc:    req.Desc,
 	}
 
 	id, err := s.itemRepository.Create(ctx, item)
@@ -25,10 +27,13 @@ func (s *itemService) Create(ctx context.Context, req *request.CreateItemRequest
 		return nil, err
 	}
 
-	created, err := s.itemRepository.FindByID(ctx, *id)
+	created, err := s.itemRepository.FindByID(ctx, ownerID, *id)
 	if err != nil {
 		return nil, err
 	}
+	if created == nil {
+		return nil, ErrItemNotFound
+	}
 
 	return &response.ItemResponse{
 		ID:        created.ID,
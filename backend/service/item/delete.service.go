@@ -7,12 +7,15 @@ import (
 )
 
 // Delete deletes an item
-func (s *itemService) Delete(ctx context.Context, id uuid.UUID) error {
+func (s *itemService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	// Check if item exists
-	_, err := s.itemRepository.FindByID(ctx, id)
+	item, err := s.itemRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return err
 	}
+	if item == nil {
+		return ErrItemNotFound
+	}
 
-	return s.itemRepository.Delete(ctx, id)
+	return s.itemRepository.Delete(ctx, ownerID, id)
 }
@@ -0,0 +1,62 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestDelete(t *testing.T) {
+	ownerID := uuid.New()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name          string
+		storedItem    *entity.ItemEntity
+		expectDelete  bool
+		expectedError error
+	}{
+		{
+			name:         "should delete an item owned by the caller",
+			storedItem:   &entity.ItemEntity{ID: itemID, OwnerID: ownerID},
+			expectDelete: true,
+		},
+		{
+			name:          "should not delete another user's item",
+			storedItem:    nil,
+			expectDelete:  false,
+			expectedError: ErrItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockItemRepository(ctrl)
+			mockRepo.EXPECT().
+				FindByID(gomock.Any(), ownerID, itemID).
+				Return(tt.storedItem, nil).
+				Times(1)
+			if tt.expectDelete {
+				mockRepo.EXPECT().
+					Delete(gomock.Any(), ownerID, itemID).
+					Return(nil).
+					Times(1)
+			}
+
+			svc := NewItemService(mockRepo)
+			err := svc.Delete(context.Background(), ownerID, itemID)
+
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
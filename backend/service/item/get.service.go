@@ -5,15 +5,20 @@ import (
 	"math"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
 // GetByID gets an item by ID
-func (s *itemService) GetByID(ctx context.Context, id uuid.UUID) (*response.ItemResponse, error) {
-	item, err := s.itemRepository.FindByID(ctx, id)
+func (s *itemService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.ItemResponse, error) {
+	item, err := s.itemRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
 
 	return &response.ItemResponse{
 		ID:        item.ID,
@@ -24,8 +29,8 @@ func (s *itemService) GetByID(ctx context.Context, id uuid.UUID) (*response.Item
 	}, nil
 }
 
-// GetAll gets all items with pagination
-func (s *itemService) GetAll(ctx context.Context, page, limit int, search string) (*response.ItemPaginationResponse, error) {
+// GetAll gets all items matching spec, paged by offset or by spec.Cursor
+func (s *itemService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) (*response.ItemPaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -33,7 +38,15 @@ func (s *itemService) GetAll(ctx context.Context, page, limit int, search string
 		limit = 10
 	}
 
-	items, total, err := s.itemRepository.FindAll(ctx, page, limit, search)
+	var items []entity.ItemEntity
+	var total int64
+	var cursors query.Cursors
+	var err error
+	if spec.Cursor != nil {
+		items, cursors, err = s.itemRepository.FindByCursor(ctx, ownerID, limit, spec)
+	} else {
+		items, total, err = s.itemRepository.FindAll(ctx, ownerID, page, limit, spec)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -49,15 +62,18 @@ func (s *itemService) GetAll(ctx context.Context, page, limit int, search string
 		}
 	}
 
-	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+	meta := response.ItemPaginationMeta{Limit: limit}
+	if spec.Cursor != nil {
+		meta.NextCursor = query.Token(cursors.Next)
+		meta.PrevCursor = query.Token(cursors.Prev)
+	} else {
+		meta.TotalData = int(total)
+		meta.Page = page
+		meta.TotalPage = int(math.Ceil(float64(total) / float64(limit)))
+	}
 
 	return &response.ItemPaginationResponse{
 		Data: itemResponses,
-		Meta: response.ItemPaginationMeta{
-			TotalData: int(total),
-			Page:      page,
-			Limit:     limit,
-			TotalPage: totalPage,
-		},
+		Meta: meta,
 	}, nil
 }
@@ -4,18 +4,34 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 )
 
-// ItemService defines the interface for item operations
+// ErrItemNotFound is returned when an item does not exist or belongs to another user
+var ErrItemNotFound = apperror.New(apperror.ErrNotFound, "item_not_found", "item not found")
+
+// ErrNameRequired is returned when an item has no name
+var ErrNameRequired = apperror.New(apperror.ErrValidation, "name_required", "name is required")
+
+// QueryFields whitelists the fields items can be filtered and sorted by
+var QueryFields = query.Fields{
+	"name":       {Parse: query.String, Sortable: true},
+	"created_at": {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+	"updated_at": {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+}
+
+// ItemService defines the interface for item operations.
+// Every operation is scoped to the items owned by ownerID.
 type ItemService interface {
-	Create(ctx context.Context, req *request.CreateItemRequest) (*response.ItemResponse, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*response.ItemResponse, error)
-	Update(ctx context.Context, id uuid.UUID, req *request.UpdateItemRequest) (*response.ItemResponse, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int, search string) (*response.ItemPaginationResponse, error)
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateItemRequest) (*response.ItemResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.ItemResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateItemRequest) (*response.ItemResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) (*response.ItemPaginationResponse, error)
 }
 
 // itemService is the concrete implementation of ItemService
@@ -2,7 +2,6 @@ package item
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
@@ -11,30 +10,36 @@ import (
 )
 
 // Update updates an item
-func (s *itemService) Update(ctx context.Context, id uuid.UUID, req *request.UpdateItemRequest) (*response.ItemResponse, error) {
+func (s *itemService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateItemRequest) (*response.ItemResponse, error) {
 	if req.Name == "" {
-		return nil, errors.New("name is required")
+		return nil, ErrNameRequired
 	}
 
 	// Check if item exists
-	_, err := s.itemRepository.FindByID(ctx, id)
+	existing, err := s.itemRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if existing == nil {
+		return nil, ErrItemNotFound
+	}
 
 	item := entity.ItemEntity{
 		Name: req.Name,
 		Desc: req.Desc,
 	}
 
-	if err := s.itemRepository.Update(ctx, id, item); err != nil {
+	if err := s.itemRepository.Update(ctx, ownerID, id, item); err != nil {
 		return nil, err
 	}
 
-	updated, err := s.itemRepository.FindByID(ctx, id)
+	updated, err := s.itemRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if updated == nil {
+		return nil, ErrItemNotFound
+	}
 
 	return &response.ItemResponse{
 		ID:        updated.ID,
@@ -0,0 +1,64 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileMailer delivers email for local development: each message is written
+// to an .eml file in a directory, or logged when no directory is given
+type FileMailer struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+// NewFileMailer creates a FileMailer writing to dir, or logging when dir
+// is empty
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{
+		dir:  dir,
+		from: from,
+		now:  time.Now,
+	}
+}
+
+// Send writes msg to a new file named after the time it was sent, or logs it
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	now := m.now()
+	data, err := formatMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	if m.dir == "" {
+		log.Printf("mail: not sent, logged instead\n%s", data)
+		return nil
+	}
+
+	// Messages hold single-use tokens, so only the owner may read them
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000000000Z"), hex.EncodeToString(suffix))
+	if err := os.WriteFile(filepath.Join(m.dir, name), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailer(t *testing.T) {
+	msg := Message{To: "alice@example.com", Subject: "Verify your email", Body: "https://app.example.com/verify-email?token=abc"}
+
+	t.Run("should write each message to its own file", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mail")
+		mailer := NewFileMailer(dir, "no-reply@example.com")
+
+		for range 2 {
+			if err := mailer.Send(context.Background(), msg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		if err != nil || len(files) != 2 {
+			t.Fatalf("expected 2 messages, got %v (err %v)", files, err)
+		}
+		data, err := os.ReadFile(files[0])
+		if err != nil {
+			t.Fatalf("failed to read message: %v", err)
+		}
+		if !strings.Contains(string(data), "To: alice@example.com\r\n") || !strings.Contains(string(data), msg.Body) {
+			t.Errorf("expected the message, got %q", data)
+		}
+		info, err := os.Stat(files[0])
+		if err != nil || info.Mode().Perm() != 0o600 {
+			t.Errorf("expected mode 0600, got %v (err %v)", info.Mode().Perm(), err)
+		}
+	})
+
+	t.Run("should log messages without a directory", func(t *testing.T) {
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		defer log.SetOutput(os.Stderr)
+
+		if err := NewFileMailer("", "no-reply@example.com").Send(context.Background(), msg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(buf.String(), msg.Body) {
+			t.Errorf("expected the message to be logged, got %q", buf.String())
+		}
+	})
+
+	t.Run("should not send an invalid message", func(t *testing.T) {
+		dir := t.TempDir()
+		bad := Message{To: "alice@example.com", Subject: "Hi\nBcc: mallory@example.com"}
+		if err := NewFileMailer(dir, "no-reply@example.com").Send(context.Background(), bad); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+		if files, _ := os.ReadDir(dir); len(files) != 0 {
+			t.Errorf("expected no files, got %d", len(files))
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package mail
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatMessage(t *testing.T) {
+	date := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		from          string
+		msg           Message
+		expected      []string
+		expectedError error
+	}{
+		{
+			name: "should render headers and a CRLF body",
+			from: "Invoices <billing@example.com>",
+			msg:  Message{To: "alice@example.com", Subject: "Reset your password", Body: "Hello\nBye"},
+			expected: []string{
+				"From: Invoices <billing@example.com>\r\n",
+				"To: alice@example.com\r\n",
+				"Subject: Reset your password\r\n",
+				"Date: Fri, 02 Jan 2026 03:04:05 +0000\r\n",
+				"Content-Type: text/plain; charset=utf-8\r\n",
+				"\r\n\r\nHello\r\nBye\r\n",
+			},
+		},
+		{
+			name:     "should encode a non-ASCII subject",
+			from:     "billing@example.com",
+			msg:      Message{To: "alice@example.com", Subject: "Café", Body: "Hi\n"},
+			expected: []string{"Subject: =?utf-8?q?Caf=C3=A9?=\r\n", "\r\n\r\nHi\r\n"},
+		},
+		{
+			name:          "should reject a line break in the subject",
+			from:          "billing@example.com",
+			msg:           Message{To: "alice@example.com", Subject: "Hi\r\nBcc: mallory@example.com"},
+			expectedError: ErrInvalidHeader,
+		},
+		{
+			name:          "should reject a line break in the recipient",
+			from:          "billing@example.com",
+			msg:           Message{To: "alice@example.com\nBcc: mallory@example.com", Subject: "Hi"},
+			expectedError: ErrInvalidHeader,
+		},
+		{
+			name: "should reject an invalid recipient",
+			from: "billing@example.com",
+			msg:  Message{To: "not an address", Subject: "Hi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := formatMessage(tt.from, tt.msg, date)
+			if tt.expected == nil {
+				if err == nil {
+					t.Fatalf("expected error, got message %q", data)
+				}
+				if tt.expectedError != nil && !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.expected {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("expected message to contain %q, got %q", want, data)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package mail
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer accepts one SMTP session on localhost and records what
+// the client sent
+type fakeSMTPServer struct {
+	listener   net.Listener
+	advertise  []string
+	rejectRcpt bool
+	done       chan struct{}
+
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T, advertise ...string) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	return &fakeSMTPServer{listener: listener, advertise: advertise, done: make(chan struct{})}
+}
+
+func (s *fakeSMTPServer) config() SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return SMTPConfig{Host: "127.0.0.1", Port: addr.Port, From: "Invoices <billing@example.com>"}
+}
+
+// serve handles one session; start it before sending
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	tp.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			lines := append([]string{"fake"}, s.advertise...)
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				tp.PrintfLine("250%s%s", sep, l)
+			}
+		case "AUTH":
+			s.auth = arg
+			tp.PrintfLine("235 authenticated")
+		case "MAIL":
+			s.from = arg
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			if s.rejectRcpt {
+				tp.PrintfLine("550 no such user")
+				continue
+			}
+			s.to = append(s.to, arg)
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data = string(data)
+			tp.PrintfLine("250 queued")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 unknown command")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-s.done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("smtp session did not end")
+	}
+}
+
+func TestSMTPMailer(t *testing.T) {
+	msg := Message{To: "Alice <alice@example.com>", Subject: "Reset your password", Body: "Open the link\n"}
+
+	t.Run("should deliver a message", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		go server.serve()
+
+		if err := NewSMTPMailer(server.config()).Send(context.Background(), msg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server.wait(t)
+
+		if server.from != "FROM:<billing@example.com>" {
+			t.Errorf("expected envelope sender billing@example.com, got %q", server.from)
+		}
+		if len(server.to) != 1 || server.to[0] != "TO:<alice@example.com>" {
+			t.Errorf("expected envelope recipient alice@example.com, got %v", server.to)
+		}
+		if !strings.Contains(server.data, "Subject: Reset your password\n") || !strings.Contains(server.data, "\nOpen the link\n") {
+			t.Errorf("expected the message, got %q", server.data)
+		}
+		if server.auth != "" {
+			t.Errorf("expected no authentication, got %q", server.auth)
+		}
+	})
+
+	t.Run("should authenticate when given a username", func(t *testing.T) {
+		server := newFakeSMTPServer(t, "AUTH PLAIN")
+		go server.serve()
+
+		config := server.config()
+		config.Username = "mailer"
+		config.Password = "secret"
+		if err := NewSMTPMailer(config).Send(context.Background(), msg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server.wait(t)
+
+		want := "PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00mailer\x00secret"))
+		if server.auth != want {
+			t.Errorf("expected auth %q, got %q", want, server.auth)
+		}
+	})
+
+	t.Run("should fail when the recipient is rejected", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		server.rejectRcpt = true
+		go server.serve()
+
+		err := NewSMTPMailer(server.config()).Send(context.Background(), msg)
+		if err == nil || !strings.Contains(err.Error(), "550") {
+			t.Errorf("expected a 550 error, got %v", err)
+		}
+	})
+
+	t.Run("should fail when the server is unreachable", func(t *testing.T) {
+		server := newFakeSMTPServer(t)
+		config := server.config()
+		server.listener.Close()
+
+		if err := NewSMTPMailer(config).Send(context.Background(), msg); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("should give up when the context ends", func(t *testing.T) {
+		// Accept the connection but never greet the client
+		server := newFakeSMTPServer(t)
+		go func() {
+			conn, err := server.listener.Accept()
+			if err == nil {
+				defer conn.Close()
+				io.Copy(io.Discard, conn)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		if err := NewSMTPMailer(server.config()).Send(ctx, msg); err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("expected Send to give up promptly, took %v", elapsed)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Page size and margins in points (1/72 inch), A4 portrait
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+	Margin     = 50.0
+)
+
+// Font is one of the standard PDF fonts every reader provides, so nothing
+// has to be embedded in the file
+type Font int
+
+const (
+	FontRegular Font = iota
+	FontBold
+)
+
+// baseFonts are the PDF names of the fonts, in resource order
+var baseFonts = []string{"Helvetica", "Helvetica-Bold"}
+
+// Color is an RGB color with components from 0 to 1
+type Color struct {
+	R, G, B float64
+}
+
+// document accumulates the content streams of a PDF file page by page.
+// Coordinates passed to its methods are measured from the top left corner.
+type document struct {
+	pages   []*bytes.Buffer
+	current *bytes.Buffer
+}
+
+// newDocument creates an empty document with a first page
+func newDocument() *document {
+	d := &document{}
+	d.addPage()
+	return d
+}
+
+// addPage starts a new page and makes it current
+func (d *document) addPage() {
+	d.current = &bytes.Buffer{}
+	d.pages = append(d.pages, d.current)
+}
+
+// text draws s with its baseline starting at (x, y)
+func (d *document) text(x, y float64, font Font, size float64, color Color, s string) {
+	fmt.Fprintf(d.current, "BT /F%d %s Tf %s %s %s rg %s %s Td (%s) Tj ET\n",
+		font+1, num(size), num(color.R), num(color.G), num(color.B),
+		num(x), num(PageHeight-y), escape(encode(s)))
+}
+
+// line draws a straight line from (x1, y1) to (x2, y2)
+func (d *document) line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(d.current, "%s w %s %s %s RG %s %s m %s %s l S\n",
+		num(width), num(color.R), num(color.G), num(color.B),
+		num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// writeTo serialises the document as a PDF 1.4 file with an uncompressed
+// cross-reference table
+func (d *document) writeTo(w io.Writer) error {
+	var out bytes.Buffer
+	var offsets []int
+
+	// Objects are numbered from 1: catalog, page tree, fonts, then a page
+	// and its content stream for every page
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	firstPage := 3 + len(baseFonts)
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	fonts := make([]string, len(baseFonts))
+	for i := range baseFonts {
+		fonts[i] = fmt.Sprintf("/F%d %d 0 R", i+1, 3+i)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, name := range baseFonts {
+		object(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+	for i, content := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << %s >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), strings.Join(fonts, " "), firstPage+2*i+1))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// num formats a coordinate, size or color component with at most two decimals
+func num(value float64) string {
+	formatted := strconv.FormatFloat(value, 'f', 2, 64)
+	formatted = strings.TrimRight(formatted, "0")
+	return strings.TrimSuffix(formatted, ".")
+}
@@ -0,0 +1,316 @@
+package pdf
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Layout markup is line based. Every line that starts with a dot is a
+// directive changing the current style or drawing something; every other
+// non-blank line is a paragraph, word-wrapped to the page width. Leading
+// whitespace is ignored so templates can be indented, and a line starting
+// with ".." prints a paragraph that starts with a single dot.
+//
+//	.font regular|bold SIZE   switch font and size in points
+//	.color #RRGGBB            switch text and rule color
+//	.align left|center|right  align the following paragraphs
+//	.space POINTS             leave vertical space
+//	.rule                     draw a horizontal line across the page
+//	.columns 40 20:right ...  set table column widths in percent of the page
+//	                          width, each optionally followed by an alignment
+//	.row a | b | c            draw a table row using the current columns
+//	.page                     start a new page
+
+// lineSpacing is the line height as a multiple of the font size
+const lineSpacing = 1.3
+
+// cellPadding is the horizontal gap kept between table columns, split
+// evenly between both sides of a cell
+const cellPadding = 6.0
+
+type alignment int
+
+const (
+	alignLeft alignment = iota
+	alignCenter
+	alignRight
+)
+
+type column struct {
+	width float64
+	align alignment
+}
+
+// layout draws markup onto a document, moving a cursor down the page and
+// starting new pages as they fill up
+type layout struct {
+	doc     *document
+	font    Font
+	size    float64
+	color   Color
+	align   alignment
+	columns []column
+	y       float64
+}
+
+func newLayout(doc *document) *layout {
+	return &layout{doc: doc, font: FontRegular, size: 10, y: Margin}
+}
+
+// render interprets markup line by line. Errors report the markup line.
+func (l *layout) render(markup string) error {
+	scanner := bufio.NewScanner(strings.NewReader(markup))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	number := 0
+	for scanner.Scan() {
+		number++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := l.apply(line); err != nil {
+			return fmt.Errorf("layout line %d: %w", number, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (l *layout) apply(line string) error {
+	if strings.HasPrefix(line, "..") || !strings.HasPrefix(line, ".") {
+		l.paragraph(strings.TrimPrefix(line, "."))
+		return nil
+	}
+
+	name, args, _ := strings.Cut(line[1:], " ")
+	args = strings.TrimSpace(args)
+	switch name {
+	case "font":
+		return l.setFont(strings.Fields(args))
+	case "color":
+		color, err := parseColor(args)
+		if err != nil {
+			return err
+		}
+		l.color = color
+	case "align":
+		align, err := parseAlignment(args)
+		if err != nil {
+			return err
+		}
+		l.align = align
+	case "space":
+		points, err := strconv.ParseFloat(args, 64)
+		if err != nil || points < 0 {
+			return fmt.Errorf("invalid space %q", args)
+		}
+		l.y += points
+	case "rule":
+		l.ensure(l.size)
+		l.doc.line(Margin, l.y+l.size/2, PageWidth-Margin, l.y+l.size/2, 0.5, l.color)
+		l.y += l.size
+	case "columns":
+		return l.setColumns(strings.Fields(args))
+	case "row":
+		return l.row(strings.Split(args, "|"))
+	case "page":
+		l.newPage()
+	default:
+		return fmt.Errorf("unknown directive %q", "."+name)
+	}
+	return nil
+}
+
+func (l *layout) setFont(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected .font regular|bold SIZE")
+	}
+	switch args[0] {
+	case "regular":
+		l.font = FontRegular
+	case "bold":
+		l.font = FontBold
+	default:
+		return fmt.Errorf("unknown font %q", args[0])
+	}
+	size, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || size < 4 || size > 72 {
+		return fmt.Errorf("invalid font size %q", args[1])
+	}
+	l.size = size
+	return nil
+}
+
+func (l *layout) setColumns(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("expected at least one column")
+	}
+	columns := make([]column, len(args))
+	total := 0.0
+	for i, arg := range args {
+		width, align, hasAlign := strings.Cut(arg, ":")
+		percent, err := strconv.ParseFloat(width, 64)
+		if err != nil || percent <= 0 {
+			return fmt.Errorf("invalid column width %q", width)
+		}
+		total += percent
+		columns[i].width = percent / 100 * contentWidth()
+		if hasAlign {
+			if columns[i].align, err = parseAlignment(align); err != nil {
+				return err
+			}
+		}
+	}
+	if total > 100 {
+		return fmt.Errorf("column widths add up to %v%%", total)
+	}
+	l.columns = columns
+	return nil
+}
+
+// paragraph draws text wrapped to the page width
+func (l *layout) paragraph(text string) {
+	for _, line := range l.wrap(text, contentWidth()) {
+		l.ensure(l.lineHeight())
+		l.draw(Margin, contentWidth(), l.align, line)
+		l.y += l.lineHeight()
+	}
+}
+
+// row draws one table row, wrapping each cell within its column. Cells
+// beyond the declared columns are an error; missing cells are left empty.
+func (l *layout) row(cells []string) error {
+	if len(l.columns) == 0 {
+		return fmt.Errorf(".row used before .columns")
+	}
+	if len(cells) > len(l.columns) {
+		return fmt.Errorf("row has %d cells but %d columns are set", len(cells), len(l.columns))
+	}
+
+	wrapped := make([][]string, len(cells))
+	lines := 1
+	for i, cell := range cells {
+		wrapped[i] = l.wrap(strings.TrimSpace(cell), l.columns[i].width-cellPadding)
+		lines = max(lines, len(wrapped[i]))
+	}
+
+	l.ensure(float64(lines) * l.lineHeight())
+	x := Margin
+	for i, column := range l.columns {
+		if i < len(wrapped) {
+			for j, line := range wrapped[i] {
+				saved := l.y
+				l.y += float64(j) * l.lineHeight()
+				l.draw(x+cellPadding/2, column.width-cellPadding, column.align, line)
+				l.y = saved
+			}
+		}
+		x += column.width
+	}
+	l.y += float64(lines) * l.lineHeight()
+	return nil
+}
+
+// draw places a single line of text within a box starting at x, on the
+// line whose top is the cursor
+func (l *layout) draw(x, width float64, align alignment, text string) {
+	if text == "" {
+		return
+	}
+	switch align {
+	case alignCenter:
+		x += (width - textWidth(encode(text), l.font, l.size)) / 2
+	case alignRight:
+		x += width - textWidth(encode(text), l.font, l.size)
+	}
+	l.doc.text(x, l.y+l.size, l.font, l.size, l.color, text)
+}
+
+// wrap breaks text into lines no wider than width, splitting words that do
+// not fit on a line of their own
+func (l *layout) wrap(text string, width float64) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if l.fits(candidate, width) {
+			current = candidate
+			continue
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+		for !l.fits(word, width) && len([]rune(word)) > 1 {
+			runes := []rune(word)
+			cut := len(runes) - 1
+			for cut > 1 && !l.fits(string(runes[:cut]), width) {
+				cut--
+			}
+			lines = append(lines, string(runes[:cut]))
+			word = string(runes[cut:])
+		}
+		current = word
+	}
+	if current != "" || len(lines) == 0 {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+func (l *layout) fits(text string, width float64) bool {
+	return textWidth(encode(text), l.font, l.size) <= width
+}
+
+func (l *layout) lineHeight() float64 {
+	return l.size * lineSpacing
+}
+
+// ensure starts a new page when height no longer fits above the bottom margin
+func (l *layout) ensure(height float64) {
+	if l.y+height > PageHeight-Margin && l.y > Margin {
+		l.newPage()
+	}
+}
+
+func (l *layout) newPage() {
+	l.doc.addPage()
+	l.y = Margin
+}
+
+// contentWidth is the width between the left and right margins
+func contentWidth() float64 {
+	return PageWidth - 2*Margin
+}
+
+func parseAlignment(value string) (alignment, error) {
+	switch value {
+	case "left":
+		return alignLeft, nil
+	case "center":
+		return alignCenter, nil
+	case "right":
+		return alignRight, nil
+	}
+	return alignLeft, fmt.Errorf("unknown alignment %q", value)
+}
+
+// parseColor parses a #RRGGBB hex color
+func parseColor(value string) (Color, error) {
+	hex := strings.TrimPrefix(value, "#")
+	if len(hex) != 6 {
+		return Color{}, fmt.Errorf("invalid color %q", value)
+	}
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid color %q", value)
+	}
+	return Color{
+		R: float64(rgb>>16&0xFF) / 255,
+		G: float64(rgb>>8&0xFF) / 255,
+		B: float64(rgb&0xFF) / 255,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package pdf
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/currency"
+)
+
+//go:embed templates/invoice.tmpl
+var defaultInvoiceTemplate string
+
+// InvoiceRenderer renders an invoice as a printable PDF document
+type InvoiceRenderer interface {
+	Render(w io.Writer, invoice *response.InvoiceDetailResponse) error
+}
+
+// InvoiceTemplateData is what invoice templates are executed with
+type InvoiceTemplateData struct {
+	Invoice     *response.InvoiceDetailResponse
+	GeneratedAt time.Time
+}
+
+type invoiceRenderer struct {
+	template *template.Template
+	now      func() time.Time
+}
+
+// NewInvoiceRenderer creates a renderer from the layout template at
+// templatePath, or from the built-in template when the path is empty. The
+// template is a text/template producing layout markup, so branding can be
+// changed by pointing the path at another file.
+func NewInvoiceRenderer(templatePath string) (InvoiceRenderer, error) {
+	name, source := "invoice.tmpl", defaultInvoiceTemplate
+	if templatePath != "" {
+		content, err := os.ReadFile(templatePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read invoice template: %w", err)
+		}
+		name, source = filepath.Base(templatePath), string(content)
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse invoice template: %w", err)
+	}
+	return &invoiceRenderer{template: tmpl, now: time.Now}, nil
+}
+
+// Render executes the template for the invoice and writes the resulting PDF.
+// Nothing is written to w when the template or its markup fails.
+func (r *invoiceRenderer) Render(w io.Writer, invoice *response.InvoiceDetailResponse) error {
+	var markup bytes.Buffer
+	data := InvoiceTemplateData{Invoice: invoice, GeneratedAt: r.now().UTC()}
+	if err := r.template.Execute(&markup, data); err != nil {
+		return fmt.Errorf("failed to execute invoice template: %w", err)
+	}
+
+	doc := newDocument()
+	if err := newLayout(doc).render(markup.String()); err != nil {
+		return err
+	}
+	return doc.writeTo(w)
+}
+
+// templateFuncs are available to invoice templates in addition to the
+// text/template builtins
+var templateFuncs = template.FuncMap{
+	"money": formatMoney,
+	"date":  formatDate,
+	"bps":   formatBasisPoints,
+	"text":  sanitizeText,
+	"cell":  sanitizeCell,
+	"lines": splitLines,
+}
+
+// formatMoney formats an amount in minor units, e.g. "1,234.50 EUR"
+func formatMoney(amount int64, code string) string {
+	digits, err := currency.MinorUnits(code)
+	if err != nil {
+		digits = 2
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	raw := strconv.FormatInt(amount, 10)
+	if len(raw) <= digits {
+		raw = strings.Repeat("0", digits-len(raw)+1) + raw
+	}
+	whole, fraction := raw[:len(raw)-digits], raw[len(raw)-digits:]
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		grouped.WriteString("." + fraction)
+	}
+	return sign + grouped.String() + " " + code
+}
+
+// formatDate formats a time or a possibly nil time pointer as a calendar
+// date, returning "-" when there is none
+func formatDate(value any) string {
+	switch t := value.(type) {
+	case time.Time:
+		return t.Format("2006-01-02")
+	case *time.Time:
+		if t != nil {
+			return t.Format("2006-01-02")
+		}
+	}
+	return "-"
+}
+
+// formatBasisPoints formats a rate in basis points as a percentage, e.g. 1250
+// as "12.5%"
+func formatBasisPoints(bps int64) string {
+	return strconv.FormatFloat(float64(bps)/100, 'f', -1, 64) + "%"
+}
+
+// sanitizeText makes user-entered text safe to print as a paragraph: it is
+// kept on one line and cannot be mistaken for a directive
+func sanitizeText(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if strings.HasPrefix(value, ".") {
+		value = "." + value
+	}
+	return value
+}
+
+// splitLines splits multi-line user-entered text such as an address into its
+// non-blank lines, each still to be passed through "text" or "cell"
+func splitLines(value string) []string {
+	var lines []string
+	for _, line := range strings.Split(value, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// sanitizeCell makes user-entered text safe to print inside a table row
+func sanitizeCell(value string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(value), " "), "|", "/")
+}
@@ -0,0 +1,196 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+func sampleInvoice(items int) *response.InvoiceDetailResponse {
+	number := "INV-2024-000042"
+	base := "USD"
+	rate := "1.0845"
+	baseTotal := int64(10845)
+	issuedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	invoice := &response.InvoiceDetailResponse{
+		ID:             uuid.New(),
+		Number:         &number,
+		Status:         "issued",
+		Currency:       "EUR",
+		Subtotal:       10000,
+		TaxTotal:       0,
+		GrandPrice:     10000,
+		BaseCurrency:   &base,
+		ExchangeRate:   &rate,
+		BaseGrandPrice: &baseTotal,
+		IssuedAt:       &issuedAt,
+		Tags:           []response.TagResponse{{ID: uuid.New(), Name: "Consulting"}},
+		Customer: &response.InvoiceCustomerResponse{
+			Name:           "Acme Corp",
+			BillingAddress: "1 Main St\n\n.Springfield",
+			TaxID:          "GB123",
+		},
+		CreditedTotal:      2500,
+		OutstandingBalance: 7500,
+		CreditNotes:        []response.CreditNoteResponse{{Number: "CN-2024-000007", Total: 2500}},
+	}
+	for i := 0; i < items; i++ {
+		invoice.Items = append(invoice.Items, response.InvoiceItemResponse{
+			ID:         uuid.New(),
+			Item:       response.ItemResponse{Name: fmt.Sprintf("Widget (%d) | large", i)},
+			Quantity:   1,
+			UnitPrice:  10000,
+			TotalPrice: 10000,
+		})
+	}
+	return invoice
+}
+
+func TestRenderDefaultTemplate(t *testing.T) {
+	renderer, err := NewInvoiceRenderer("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		items int
+		pages int
+	}{
+		{name: "should render a short invoice on one page", items: 1, pages: 1},
+		{name: "should continue long invoices on further pages", items: 80, pages: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := renderer.Render(&out, sampleInvoice(tt.items)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			document := out.String()
+
+			if !strings.HasPrefix(document, "%PDF-1.4") || !strings.HasSuffix(document, "%%EOF\n") {
+				t.Fatalf("expected a complete PDF file")
+			}
+			for _, expected := range []string{"INV-2024-000042", `Widget \(0\) / large`, "Consulting", "(Acme Corp)", "(.Springfield)", "Tax ID: GB123", "(Credit note CN-2024-000007)", "(-25.00 EUR)", "(75.00 EUR)", "100.00 EUR", "108.45 USD"} {
+				if !strings.Contains(document, expected) {
+					t.Errorf("expected document to contain %q", expected)
+				}
+			}
+			if count := strings.Count(document, "/Type /Page "); count != tt.pages {
+				t.Errorf("expected %d pages, got %d", tt.pages, count)
+			}
+			assertCrossReferences(t, document)
+		})
+	}
+}
+
+// assertCrossReferences checks every xref entry points at its object
+func assertCrossReferences(t *testing.T, document string) {
+	t.Helper()
+
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(document)
+	if match == nil {
+		t.Fatalf("missing startxref")
+	}
+	xref, _ := strconv.Atoi(match[1])
+	if !strings.HasPrefix(document[xref:], "xref\n") {
+		t.Fatalf("startxref does not point at the xref table")
+	}
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(document[xref:], -1)
+	if len(entries) == 0 {
+		t.Fatalf("expected xref entries")
+	}
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		if !strings.HasPrefix(document[offset:], fmt.Sprintf("%d 0 obj\n", i+1)) {
+			t.Errorf("xref entry %d does not point at its object", i+1)
+		}
+	}
+}
+
+func TestRenderCustomTemplate(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write template: %v", err)
+		}
+		return path
+	}
+
+	t.Run("should render a custom template", func(t *testing.T) {
+		renderer, err := NewInvoiceRenderer(write("brand.tmpl", ".font bold 16\nACME Corp\n..dotted {{.Invoice.Currency}}\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var out bytes.Buffer
+		if err := renderer.Render(&out, sampleInvoice(1)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(out.String(), "(ACME Corp)") || !strings.Contains(out.String(), "(.dotted EUR)") {
+			t.Errorf("expected custom template content in document")
+		}
+	})
+
+	t.Run("should reject a template that does not parse", func(t *testing.T) {
+		if _, err := NewInvoiceRenderer(write("broken.tmpl", "{{if}}")); err == nil {
+			t.Errorf("expected parse error")
+		}
+	})
+
+	t.Run("should reject a missing template file", func(t *testing.T) {
+		if _, err := NewInvoiceRenderer(filepath.Join(dir, "missing.tmpl")); err == nil {
+			t.Errorf("expected read error")
+		}
+	})
+
+	t.Run("should report unknown directives without writing output", func(t *testing.T) {
+		renderer, err := NewInvoiceRenderer(write("unknown.tmpl", "Title\n.logo acme.png\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var out bytes.Buffer
+		err = renderer.Render(&out, sampleInvoice(1))
+		if err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("expected error on line 2, got %v", err)
+		}
+		if out.Len() != 0 {
+			t.Errorf("expected no output, got %d bytes", out.Len())
+		}
+	})
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		currency string
+		expected string
+	}{
+		{name: "should group thousands", amount: 123456789, currency: "USD", expected: "1,234,567.89 USD"},
+		{name: "should pad small amounts", amount: 5, currency: "EUR", expected: "0.05 EUR"},
+		{name: "should omit decimals for currencies without minor units", amount: 1500, currency: "JPY", expected: "1,500 JPY"},
+		{name: "should use three decimals where the currency does", amount: 1234, currency: "KWD", expected: "1.234 KWD"},
+		{name: "should keep the sign of negative amounts", amount: -250, currency: "USD", expected: "-2.50 USD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := formatMoney(tt.amount, tt.currency); result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
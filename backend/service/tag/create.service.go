@@ -2,23 +2,25 @@ package tag
 
 import (
 	"context"
-	"errors"
 
+	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
-// Create creates a new tag
-func (s *tagService) Create(ctx context.Context, req *request.CreateTagRequest) (*response.TagResponse, error) {
+// Create creates a new tag owned by ownerID
+func (s *tagService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateTagRequest) (*response.TagResponse, error) {
 	if req.Name == "" {
-		return nil, errors.New("name is required")
+		return nil, ErrNameRequired
 	}
 	if req.ColorHex == "" {
-		return nil, errors.New("color_hex is required")
+		return nil, ErrColorRequired
 	}
 
 	tag := entity.TagEntity{
+		ID:       uuid.New(),
+		OwnerID:  ownerID,
 		Name:     req.Name,
 		ColorHex: req.ColorHex,
 	}
@@ -28,10 +30,13 @@ func (s *tagService) Create(ctx context.Context, req *request.CreateTagRequest)
 		return nil, err
 	}
 
-	created, err := s.tagRepository.FindByID(ctx, *id)
+	created, err := s.tagRepository.FindByID(ctx, ownerID, *id)
 	if err != nil {
 		return nil, err
 	}
+	if created == nil {
+		return nil, ErrTagNotFound
+	}
 
 	return &response.TagResponse{
 		ID:        created.ID,
@@ -7,12 +7,15 @@ import (
 )
 
 // Delete deletes a tag
-func (s *tagService) Delete(ctx context.Context, id uuid.UUID) error {
+func (s *tagService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
 	// Check if tag exists
-	_, err := s.tagRepository.FindByID(ctx, id)
+	tag, err := s.tagRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return err
 	}
+	if tag == nil {
+		return ErrTagNotFound
+	}
 
-	return s.tagRepository.Delete(ctx, id)
+	return s.tagRepository.Delete(ctx, ownerID, id)
 }
@@ -0,0 +1,62 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestDelete(t *testing.T) {
+	ownerID := uuid.New()
+	tagID := uuid.New()
+
+	tests := []struct {
+		name          string
+		storedTag     *entity.TagEntity
+		expectDelete  bool
+		expectedError error
+	}{
+		{
+			name:         "should delete a tag owned by the caller",
+			storedTag:    &entity.TagEntity{ID: tagID, OwnerID: ownerID},
+			expectDelete: true,
+		},
+		{
+			name:          "should not delete another user's tag",
+			storedTag:     nil,
+			expectDelete:  false,
+			expectedError: ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockTagRepository(ctrl)
+			mockRepo.EXPECT().
+				FindByID(gomock.Any(), ownerID, tagID).
+				Return(tt.storedTag, nil).
+				Times(1)
+			if tt.expectDelete {
+				mockRepo.EXPECT().
+					Delete(gomock.Any(), ownerID, tagID).
+					Return(nil).
+					Times(1)
+			}
+
+			svc := NewTagService(mockRepo)
+			err := svc.Delete(context.Background(), ownerID, tagID)
+
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
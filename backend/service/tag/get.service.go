@@ -5,15 +5,20 @@ import (
 	"math"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
 // GetByID gets a tag by ID
-func (s *tagService) GetByID(ctx context.Context, id uuid.UUID) (*response.TagResponse, error) {
-	tag, err := s.tagRepository.FindByID(ctx, id)
+func (s *tagService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.TagResponse, error) {
+	tag, err := s.tagRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if tag == nil {
+		return nil, ErrTagNotFound
+	}
 
 	return &response.TagResponse{
 		ID:        tag.ID,
@@ -24,8 +29,8 @@ func (s *tagService) GetByID(ctx context.Context, id uuid.UUID) (*response.TagRe
 	}, nil
 }
 
-// GetAll gets all tags with pagination
-func (s *tagService) GetAll(ctx context.Context, page, limit int, search string) (*response.TagPaginationResponse, error) {
+// GetAll gets all tags matching spec, paged by offset or by spec.Cursor
+func (s *tagService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) (*response.TagPaginationResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -33,7 +38,15 @@ func (s *tagService) GetAll(ctx context.Context, page, limit int, search string)
 		limit = 10
 	}
 
-	tags, total, err := s.tagRepository.FindAll(ctx, page, limit, search)
+	var tags []entity.TagEntity
+	var total int64
+	var cursors query.Cursors
+	var err error
+	if spec.Cursor != nil {
+		tags, cursors, err = s.tagRepository.FindByCursor(ctx, ownerID, limit, spec)
+	} else {
+		tags, total, err = s.tagRepository.FindAll(ctx, ownerID, page, limit, spec)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -49,15 +62,18 @@ func (s *tagService) GetAll(ctx context.Context, page, limit int, search string)
 		}
 	}
 
-	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+	meta := response.TagPaginationMeta{Limit: limit}
+	if spec.Cursor != nil {
+		meta.NextCursor = query.Token(cursors.Next)
+		meta.PrevCursor = query.Token(cursors.Prev)
+	} else {
+		meta.TotalData = int(total)
+		meta.Page = page
+		meta.TotalPage = int(math.Ceil(float64(total) / float64(limit)))
+	}
 
 	return &response.TagPaginationResponse{
 		Data: tagResponses,
-		Meta: response.TagPaginationMeta{
-			TotalData: int(total),
-			Page:      page,
-			Limit:     limit,
-			TotalPage: totalPage,
-		},
+		Meta: meta,
 	}, nil
 }
@@ -0,0 +1,99 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestGetByID(t *testing.T) {
+	ownerID := uuid.New()
+	tagID := uuid.New()
+
+	tests := []struct {
+		name          string
+		storedTag     *entity.TagEntity
+		repoErr       error
+		expectedError error
+	}{
+		{
+			name: "should return a tag owned by the caller",
+			storedTag: &entity.TagEntity{
+				ID:      tagID,
+				OwnerID: ownerID,
+				Name:    "Urgent",
+			},
+		},
+		{
+			name:          "should return not found for another user's tag",
+			storedTag:     nil,
+			expectedError: ErrTagNotFound,
+		},
+		{
+			name:          "should return error when repository fails",
+			repoErr:       errors.New("database error"),
+			expectedError: errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockTagRepository(ctrl)
+			mockRepo.EXPECT().
+				FindByID(gomock.Any(), ownerID, tagID).
+				Return(tt.storedTag, tt.repoErr).
+				Times(1)
+
+			svc := NewTagService(mockRepo)
+			result, err := svc.GetByID(context.Background(), ownerID, tagID)
+
+			if tt.expectedError != nil {
+				if err == nil || err.Error() != tt.expectedError.Error() {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				if result != nil {
+					t.Errorf("expected nil result, got %+v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.ID != tagID {
+				t.Errorf("expected ID %v, got %v", tagID, result.ID)
+			}
+		})
+	}
+}
+
+func TestGetAllScopesToOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ownerID := uuid.New()
+	spec := query.Spec{Search: "Urg", Sort: []query.Sort{{Field: "name", Desc: true}}}
+	mockRepo := mock.NewMockTagRepository(ctrl)
+	mockRepo.EXPECT().
+		FindAll(gomock.Any(), ownerID, 1, 10, spec).
+		Return([]entity.TagEntity{{ID: uuid.New(), OwnerID: ownerID, Name: "Urgent"}}, int64(1), nil).
+		Times(1)
+
+	svc := NewTagService(mockRepo)
+	result, err := svc.GetAll(context.Background(), ownerID, 0, 0, spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Data) != 1 {
+		t.Errorf("expected 1 tag, got %d", len(result.Data))
+	}
+}
@@ -4,18 +4,37 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/query"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 )
 
-// TagService defines the interface for tag operations
+// ErrTagNotFound is returned when a tag does not exist or belongs to another user
+var ErrTagNotFound = apperror.New(apperror.ErrNotFound, "tag_not_found", "tag not found")
+
+// ErrNameRequired is returned when a tag has no name
+var ErrNameRequired = apperror.New(apperror.ErrValidation, "name_required", "name is required")
+
+// ErrColorRequired is returned when a tag has no color
+var ErrColorRequired = apperror.New(apperror.ErrValidation, "color_hex_required", "color_hex is required")
+
+// QueryFields whitelists the fields tags can be filtered and sorted by
+var QueryFields = query.Fields{
+	"name":       {Parse: query.String, Sortable: true},
+	"created_at": {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+	"updated_at": {Parse: query.Time, Ops: query.RangeOps, Sortable: true},
+}
+
+// TagService defines the interface for tag operations.
+// Every operation is scoped to the tags owned by ownerID.
 type TagService interface {
-	Create(ctx context.Context, req *request.CreateTagRequest) (*response.TagResponse, error)
-	GetByID(ctx context.Context, id uuid.UUID) (*response.TagResponse, error)
-	Update(ctx context.Context, id uuid.UUID, req *request.UpdateTagRequest) (*response.TagResponse, error)
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetAll(ctx context.Context, page, limit int, search string) (*response.TagPaginationResponse, error)
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateTagRequest) (*response.TagResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.TagResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateTagRequest) (*response.TagResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, spec query.Spec) (*response.TagPaginationResponse, error)
 }
 
 // tagService is the concrete implementation of TagService
@@ -2,7 +2,6 @@ package tag
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
@@ -11,33 +10,39 @@ import (
 )
 
 // Update updates a tag
-func (s *tagService) Update(ctx context.Context, id uuid.UUID, req *request.UpdateTagRequest) (*response.TagResponse, error) {
+func (s *tagService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateTagRequest) (*response.TagResponse, error) {
 	if req.Name == "" {
-		return nil, errors.New("name is required")
+		return nil, ErrNameRequired
 	}
 	if req.ColorHex == "" {
-		return nil, errors.New("color_hex is required")
+		return nil, ErrColorRequired
 	}
 
 	// Check if tag exists
-	_, err := s.tagRepository.FindByID(ctx, id)
+	existing, err := s.tagRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if existing == nil {
+		return nil, ErrTagNotFound
+	}
 
 	tag := entity.TagEntity{
 		Name:     req.Name,
 		ColorHex: req.ColorHex,
 	}
 
-	if err := s.tagRepository.Update(ctx, id, tag); err != nil {
+	if err := s.tagRepository.Update(ctx, ownerID, id, tag); err != nil {
 		return nil, err
 	}
 
-	updated, err := s.tagRepository.FindByID(ctx, id)
+	updated, err := s.tagRepository.FindByID(ctx, ownerID, id)
 	if err != nil {
 		return nil, err
 	}
+	if updated == nil {
+		return nil, ErrTagNotFound
+	}
 
 	return &response.TagResponse{
 		ID:        updated.ID,
@@ -0,0 +1,70 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestUpdate(t *testing.T) {
+	ownerID := uuid.New()
+	tagID := uuid.New()
+
+	tests := []struct {
+		name          string
+		storedTag     *entity.TagEntity
+		expectUpdate  bool
+		expectedError error
+	}{
+		{
+			name:         "should update a tag owned by the caller",
+			storedTag:    &entity.TagEntity{ID: tagID, OwnerID: ownerID, Name: "Urgent", ColorHex: "#ff0000"},
+			expectUpdate: true,
+		},
+		{
+			name:          "should not update another user's tag",
+			storedTag:     nil,
+			expectUpdate:  false,
+			expectedError: ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockTagRepository(ctrl)
+			mockRepo.EXPECT().
+				FindByID(gomock.Any(), ownerID, tagID).
+				Return(tt.storedTag, nil).
+				Times(1)
+			if tt.expectUpdate {
+				mockRepo.EXPECT().
+					Update(gomock.Any(), ownerID, tagID, entity.TagEntity{Name: "Later", ColorHex: "#00ff00"}).
+					Return(nil).
+					Times(1)
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, tagID).
+					Return(&entity.TagEntity{ID: tagID, OwnerID: ownerID, Name: "Later", ColorHex: "#00ff00"}, nil).
+					Times(1)
+			}
+
+			svc := NewTagService(mockRepo)
+			result, err := svc.Update(context.Background(), ownerID, tagID, &request.UpdateTagRequest{Name: "Later", ColorHex: "#00ff00"})
+
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+			if tt.expectUpdate && (result == nil || result.Name != "Later") {
+				t.Errorf("expected the updated tag, got %+v", result)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Create creates a new tax rate owned by ownerID
+func (s *taxRateService) Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateTaxRateRequest) (*response.TaxRateResponse, error) {
+	if err := validate(req.Name, req.Rate); err != nil {
+		return nil, err
+	}
+
+	taxRate := entity.TaxRateEntity{
+		ID:      uuid.New(),
+		OwnerID: ownerID,
+		Name:    req.Name,
+		Rate:    req.Rate,
+	}
+
+	id, err := s.taxRateRepository.Create(ctx, taxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.taxRateRepository.FindByID(ctx, ownerID, *id)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrTaxRateNotFound
+	}
+
+	return toResponse(created), nil
+}
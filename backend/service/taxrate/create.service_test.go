@@ -0,0 +1,93 @@
+package taxrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+)
+
+func TestCreate(t *testing.T) {
+	ownerID := uuid.New()
+
+	tests := []struct {
+		name          string
+		req           request.CreateTaxRateRequest
+		expectCreate  bool
+		expectedError bool
+	}{
+		{
+			name:         "should create a tax rate",
+			req:          request.CreateTaxRateRequest{Name: "VAT", Rate: 2000},
+			expectCreate: true,
+		},
+		{
+			name:         "should allow a zero rate",
+			req:          request.CreateTaxRateRequest{Name: "Exempt", Rate: 0},
+			expectCreate: true,
+		},
+		{
+			name:          "should reject a missing name",
+			req:           request.CreateTaxRateRequest{Rate: 2000},
+			expectedError: true,
+		},
+		{
+			name:          "should reject a negative rate",
+			req:           request.CreateTaxRateRequest{Name: "VAT", Rate: -1},
+			expectedError: true,
+		},
+		{
+			name:          "should reject a rate above 100%",
+			req:           request.CreateTaxRateRequest{Name: "VAT", Rate: MaxRate + 1},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockTaxRateRepository(ctrl)
+			if tt.expectCreate {
+				var stored entity.TaxRateEntity
+				mockRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, taxRate entity.TaxRateEntity) (*uuid.UUID, error) {
+						if taxRate.OwnerID != ownerID {
+							t.Errorf("expected owner %v, got %v", ownerID, taxRate.OwnerID)
+						}
+						stored = taxRate
+						return &taxRate.ID, nil
+					}).
+					Times(1)
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), ownerID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ uuid.UUID) (*entity.TaxRateEntity, error) {
+						return &stored, nil
+					}).
+					Times(1)
+			}
+
+			svc := NewTaxRateService(mockRepo)
+			result, err := svc.Create(context.Background(), ownerID, &tt.req)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Name != tt.req.Name || result.Rate != tt.req.Rate {
+				t.Errorf("expected %s at %d, got %s at %d", tt.req.Name, tt.req.Rate, result.Name, result.Rate)
+			}
+		})
+	}
+}
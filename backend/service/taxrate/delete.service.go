@@ -0,0 +1,21 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes a tax rate
+func (s *taxRateService) Delete(ctx context.Context, ownerID, id uuid.UUID) error {
+	// Check if tax rate exists
+	taxRate, err := s.taxRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if taxRate == nil {
+		return ErrTaxRateNotFound
+	}
+
+	return s.taxRateRepository.Delete(ctx, ownerID, id)
+}
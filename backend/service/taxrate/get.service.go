@@ -0,0 +1,54 @@
+package taxrate
+
+import (
+	"context"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// GetByID gets a tax rate by ID
+func (s *taxRateService) GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.TaxRateResponse, error) {
+	taxRate, err := s.taxRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if taxRate == nil {
+		return nil, ErrTaxRateNotFound
+	}
+
+	return toResponse(taxRate), nil
+}
+
+// GetAll gets all tax rates with pagination
+func (s *taxRateService) GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) (*response.TaxRatePaginationResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	taxRates, total, err := s.taxRateRepository.FindAll(ctx, ownerID, page, limit, search)
+	if err != nil {
+		return nil, err
+	}
+
+	taxRateResponses := make([]response.TaxRateResponse, len(taxRates))
+	for i := range taxRates {
+		taxRateResponses[i] = *toResponse(&taxRates[i])
+	}
+
+	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+
+	return &response.TaxRatePaginationResponse{
+		Data: taxRateResponses,
+		Meta: response.TaxRatePaginationMeta{
+			TotalData: int(total),
+			Page:      page,
+			Limit:     limit,
+			TotalPage: totalPage,
+		},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
+)
+
+// MaxRate is the highest accepted tax rate in basis points (100%)
+const MaxRate = 10000
+
+// ErrTaxRateNotFound is returned when a tax rate does not exist or belongs to another user
+var ErrTaxRateNotFound = apperror.New(apperror.ErrNotFound, "tax_rate_not_found", "tax rate not found")
+
+// TaxRateService defines the interface for tax rate operations.
+// Every operation is scoped to the tax rates owned by ownerID.
+type TaxRateService interface {
+	Create(ctx context.Context, ownerID uuid.UUID, req *request.CreateTaxRateRequest) (*response.TaxRateResponse, error)
+	GetByID(ctx context.Context, ownerID, id uuid.UUID) (*response.TaxRateResponse, error)
+	Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateTaxRateRequest) (*response.TaxRateResponse, error)
+	Delete(ctx context.Context, ownerID, id uuid.UUID) error
+	GetAll(ctx context.Context, ownerID uuid.UUID, page, limit int, search string) (*response.TaxRatePaginationResponse, error)
+}
+
+// taxRateService is the concrete implementation of TaxRateService
+type taxRateService struct {
+	taxRateRepository interfaces.TaxRateRepository
+}
+
+// NewTaxRateService creates a new instance of TaxRateService
+func NewTaxRateService(taxRateRepository interfaces.TaxRateRepository) TaxRateService {
+	return &taxRateService{
+		taxRateRepository: taxRateRepository,
+	}
+}
+
+// validate checks the user-supplied fields of a tax rate
+func validate(name string, rate int64) error {
+	if name == "" {
+		return apperror.New(apperror.ErrValidation, "name_required", "name is required")
+	}
+	if rate < 0 || rate > MaxRate {
+		return apperror.New(apperror.ErrValidation, "invalid_tax_rate", "rate must be between 0 and 10000 basis points")
+	}
+
+	return nil
+}
+
+// toResponse maps a tax rate to its API representation
+func toResponse(taxRate *entity.TaxRateEntity) *response.TaxRateResponse {
+	return &response.TaxRateResponse{
+		ID:        taxRate.ID,
+		Name:      taxRate.Name,
+		Rate:      taxRate.Rate,
+		CreatedAt: taxRate.CreatedAt,
+		UpdatedAt: taxRate.UpdatedAt,
+	}
+}
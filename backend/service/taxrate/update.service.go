@@ -0,0 +1,45 @@
+package taxrate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// Update updates a tax rate. Invoices already priced with it are unaffected.
+func (s *taxRateService) Update(ctx context.Context, ownerID, id uuid.UUID, req *request.UpdateTaxRateRequest) (*response.TaxRateResponse, error) {
+	if err := validate(req.Name, req.Rate); err != nil {
+		return nil, err
+	}
+
+	// Check if tax rate exists
+	existing, err := s.taxRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrTaxRateNotFound
+	}
+
+	taxRate := entity.TaxRateEntity{
+		Name: req.Name,
+		Rate: req.Rate,
+	}
+
+	if err := s.taxRateRepository.Update(ctx, ownerID, id, taxRate); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.taxRateRepository.FindByID(ctx, ownerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrTaxRateNotFound
+	}
+
+	return toResponse(updated), nil
+}
@@ -1,17 +1,20 @@
 package token
 
 import (
+	"errors"
+	"time"
+
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
-	"time"
 )
 
 // GenerateAccessToken generates a new access token
-func (s *tokenService) GenerateAccessToken(userID uuid.UUID, email, name string) (string, error) {
+func (s *tokenService) GenerateAccessToken(userID uuid.UUID, email, name string, roles []string) (string, error) {
 	claims := TokenClaims{
 		UserID: userID,
 		Email:  email,
 		Name:   name,
+		Roles:  roles,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(s.config.AccessTokenExpiry).Unix(),
 			IssuedAt:  time.Now().Unix(),
@@ -19,6 +22,16 @@ func (s *tokenService) GenerateAccessToken(userID uuid.UUID, email, name string)
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.config.AccessTokenSecret))
+	if len(s.config.AccessTokenKeys) == 0 {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(s.config.AccessTokenSecret))
+	}
+
+	key := s.config.AccessTokenKeys[0]
+	if key.PrivateKey == nil {
+		return "", errors.New("the first signing key has no private key")
+	}
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
 }
@@ -12,6 +12,8 @@ func (s *tokenService) GenerateRefreshToken(userID uuid.UUID) (string, error) {
 	claims := TokenClaims{
 		UserID: userID,
 		StandardClaims: jwt.StandardClaims{
+			// A unique ID keeps tokens issued within the same second distinct
+			Id:        uuid.NewString(),
 			ExpiresAt: time.Now().Add(s.config.RefreshTokenExpiry).Unix(),
 			IssuedAt:  time.Now().Unix(),
 			Issuer:    "go-vite-react",
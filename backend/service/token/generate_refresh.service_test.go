@@ -156,3 +156,26 @@ func TestGenerateRefreshTokenIssuer(t *testing.T) {
 		t.Errorf("expected issuer 'go-vite-react', got %s", claims.Issuer)
 	}
 }
+
+func TestGenerateRefreshTokenUniqueness(t *testing.T) {
+	service := NewTokenService(TokenConfig{
+		AccessTokenSecret:  "access-secret",
+		AccessTokenExpiry:  15 * time.Minute,
+		RefreshTokenSecret: "refresh-secret",
+		RefreshTokenExpiry: 7 * 24 * time.Hour,
+	})
+	userID := uuid.New()
+
+	token1, err := service.GenerateRefreshToken(userID)
+	if err != nil {
+		t.Fatalf("failed to generate first token: %v", err)
+	}
+	token2, err := service.GenerateRefreshToken(userID)
+	if err != nil {
+		t.Fatalf("failed to generate second token: %v", err)
+	}
+
+	if token1 == token2 {
+		t.Errorf("expected tokens issued in the same second to differ")
+	}
+}
@@ -0,0 +1,13 @@
+package token
+
+import "github.com/kamil5b/clean-go-vite-react/backend/model/response"
+
+// JWKS returns the public keys access tokens can be verified with. It is
+// empty when they are signed with AccessTokenSecret, which cannot be shared.
+func (s *tokenService) JWKS() response.JWKSResponse {
+	keys := make([]response.JWK, 0, len(s.config.AccessTokenKeys))
+	for _, key := range s.config.AccessTokenKeys {
+		keys = append(keys, key.JWK())
+	}
+	return response.JWKSResponse{Keys: keys}
+}
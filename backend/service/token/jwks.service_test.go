@@ -0,0 +1,50 @@
+package token
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestJWKS(t *testing.T) {
+	public, private := newEd25519Key(t)
+	current, err := ParseSigningKey(pemKey(t, private))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, otherPrivate := newEd25519Key(t)
+	previous, err := ParseSigningKey(pemKey(t, otherPrivate))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("should publish every signing key", func(t *testing.T) {
+		service := NewTokenService(TokenConfig{
+			AccessTokenKeys:   []SigningKey{current, previous},
+			AccessTokenExpiry: 15 * time.Minute,
+		})
+
+		jwks := service.JWKS()
+		if len(jwks.Keys) != 2 {
+			t.Fatalf("expected 2 keys, got %d", len(jwks.Keys))
+		}
+		jwk := jwks.Keys[0]
+		if jwk.KeyID != current.ID || jwk.KeyType != "OKP" || jwk.Curve != "Ed25519" || jwk.Algorithm != "EdDSA" || jwk.Use != "sig" {
+			t.Errorf("unexpected key %+v", jwk)
+		}
+		if jwk.X != base64.RawURLEncoding.EncodeToString(public) {
+			t.Errorf("expected the public key, got %q", jwk.X)
+		}
+		if jwks.Keys[1].KeyID != previous.ID {
+			t.Errorf("expected the previous key second, got %s", jwks.Keys[1].KeyID)
+		}
+	})
+
+	t.Run("should publish nothing for a secret", func(t *testing.T) {
+		service := NewTokenService(TokenConfig{AccessTokenSecret: "test-access-secret"})
+
+		if jwks := service.JWKS(); jwks.Keys == nil || len(jwks.Keys) != 0 {
+			t.Errorf("expected an empty key list, got %v", jwks.Keys)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// minRSABits is the smallest RSA key accepted for signing
+const minRSABits = 2048
+
+// SigningKey is an RS256 or EdDSA key access tokens are signed or verified with
+type SigningKey struct {
+	// ID is the kid tokens name the key by, its RFC 7638 thumbprint
+	ID     string
+	Method jwt.SigningMethod
+	// PrivateKey is nil for a key that only verifies tokens
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+// LoadSigningKeys reads PEM files of signing keys. The first must hold a
+// private key, as it signs new tokens.
+func LoadSigningKeys(paths []string) ([]SigningKey, error) {
+	keys := make([]SigningKey, 0, len(paths))
+	seen := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := ParseSigningKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if other, ok := seen[key.ID]; ok {
+			return nil, fmt.Errorf("%s: same key as %s", path, other)
+		}
+		seen[key.ID] = path
+		keys = append(keys, key)
+	}
+
+	if len(keys) > 0 && keys[0].PrivateKey == nil {
+		return nil, fmt.Errorf("%s: the first signing key must be a private key", paths[0])
+	}
+	return keys, nil
+}
+
+// ParseSigningKey parses a PEM encoded RSA or Ed25519 key, private or public
+func ParseSigningKey(data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errors.New("no PEM data found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	var key SigningKey
+	if signer, ok := parsed.(crypto.Signer); ok {
+		key.PrivateKey = signer
+		parsed = signer.Public()
+	}
+	key.PublicKey = parsed
+
+	switch public := parsed.(type) {
+	case *rsa.PublicKey:
+		if public.N.BitLen() < minRSABits {
+			return SigningKey{}, fmt.Errorf("RSA key has %d bits, at least %d are required", public.N.BitLen(), minRSABits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return SigningKey{}, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", parsed)
+	}
+
+	key.ID = thumbprint(key.JWK())
+	return key, nil
+}
+
+// JWK returns the public half of the key as a JSON Web Key
+func (k SigningKey) JWK() response.JWK {
+	jwk := response.JWK{
+		KeyID:     k.ID,
+		Use:       "sig",
+		Algorithm: k.Method.Alg(),
+	}
+	switch public := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
+
+// thumbprint hashes the required members of jwk in lexicographic order
+// (RFC 7638). Their values are base64url, so need no JSON escaping.
+func thumbprint(jwk response.JWK) string {
+	var members string
+	switch jwk.KeyType {
+	case "RSA":
+		members = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)
+	case "OKP":
+		members = fmt.Sprintf(`{"crv":"%s","kty":"OKP","x":"%s"}`, jwk.Curve, jwk.X)
+	}
+	sum := sha256.Sum256([]byte(members))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,209 @@
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// pemKey encodes a private key as PKCS #8, or a public key as PKIX
+func pemKey(t *testing.T, key any) []byte {
+	t.Helper()
+	if public, ok := key.(ed25519.PublicKey); ok {
+		der, err := x509.MarshalPKIXPublicKey(public)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func newEd25519Key(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return public, private
+}
+
+func TestParseSigningKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smallRSAKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edPrivate := newEd25519Key(t)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectedAlg string
+		private     bool
+		errorMsg    string
+	}{
+		{
+			name:        "should parse a PKCS #1 RSA private key",
+			data:        pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			expectedAlg: "RS256",
+			private:     true,
+		},
+		{
+			name:        "should parse a PKCS #8 RSA private key",
+			data:        pemKey(t, rsaKey),
+			expectedAlg: "RS256",
+			private:     true,
+		},
+		{
+			name:        "should parse a PKCS #1 RSA public key",
+			data:        pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}),
+			expectedAlg: "RS256",
+		},
+		{
+			name:        "should parse an Ed25519 private key",
+			data:        pemKey(t, edPrivate),
+			expectedAlg: "EdDSA",
+			private:     true,
+		},
+		{
+			name:        "should parse an Ed25519 public key",
+			data:        pemKey(t, edPublic),
+			expectedAlg: "EdDSA",
+		},
+		{
+			name:     "should reject an RSA key under 2048 bits",
+			data:     pemKey(t, smallRSAKey),
+			errorMsg: "RSA key has 1024 bits, at least 2048 are required",
+		},
+		{
+			name:     "should reject an ECDSA key",
+			data:     pemKey(t, ecKey),
+			errorMsg: "unsupported key type *ecdsa.PublicKey, use RSA or Ed25519",
+		},
+		{
+			name:     "should reject a certificate",
+			data:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")}),
+			errorMsg: `unsupported PEM block "CERTIFICATE"`,
+		},
+		{
+			name:     "should reject data that is not PEM",
+			data:     []byte("not a key"),
+			errorMsg: "no PEM data found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParseSigningKey(tt.data)
+
+			if tt.errorMsg != "" {
+				if err == nil || err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if key.Method.Alg() != tt.expectedAlg {
+				t.Errorf("expected %s, got %s", tt.expectedAlg, key.Method.Alg())
+			}
+			if (key.PrivateKey != nil) != tt.private {
+				t.Errorf("expected private key %v, got %v", tt.private, key.PrivateKey != nil)
+			}
+			if key.ID == "" {
+				t.Error("expected a key ID")
+			}
+		})
+	}
+}
+
+func TestParseSigningKeyID(t *testing.T) {
+	public, private := newEd25519Key(t)
+	_, other := newEd25519Key(t)
+
+	privateKey, err := ParseSigningKey(pemKey(t, private))
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := ParseSigningKey(pemKey(t, public))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ParseSigningKey(pemKey(t, other))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if privateKey.ID != publicKey.ID {
+		t.Errorf("expected both halves of a key to share an ID, got %s and %s", privateKey.ID, publicKey.ID)
+	}
+	if privateKey.ID == otherKey.ID {
+		t.Errorf("expected different keys to have different IDs, got %s", privateKey.ID)
+	}
+}
+
+func TestLoadSigningKeys(t *testing.T) {
+	dir := t.TempDir()
+	current, previous := filepath.Join(dir, "current.pem"), filepath.Join(dir, "previous.pem")
+	_, currentKey := newEd25519Key(t)
+	previousKey, _ := newEd25519Key(t)
+	if err := os.WriteFile(current, pemKey(t, currentKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(previous, pemKey(t, previousKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		paths         []string
+		expectedCount int
+		errorContains string
+	}{
+		{name: "should load nothing without files", paths: nil},
+		{name: "should load a private key then a public key", paths: []string{current, previous}, expectedCount: 2},
+		{name: "should require the first key to be private", paths: []string{previous, current}, errorContains: "the first signing key must be a private key"},
+		{name: "should reject the same key twice", paths: []string{current, current}, errorContains: "same key as"},
+		{name: "should report a missing file", paths: []string{filepath.Join(dir, "missing.pem")}, errorContains: "no such file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := LoadSigningKeys(tt.paths)
+
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("expected error containing %q, got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(keys) != tt.expectedCount {
+				t.Errorf("expected %d keys, got %d", tt.expectedCount, len(keys))
+			}
+		})
+	}
+}
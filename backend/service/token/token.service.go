@@ -5,11 +5,18 @@ import (
 
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
 // TokenConfig holds JWT configuration
 type TokenConfig struct {
-	AccessTokenSecret  string
+	// AccessTokenSecret signs access tokens with HS256 when there are no
+	// AccessTokenKeys
+	AccessTokenSecret string
+	// AccessTokenKeys sign access tokens with the first key and verify
+	// them with any, found by kid, so keys can rotate without
+	// invalidating tokens already issued
+	AccessTokenKeys    []SigningKey
 	AccessTokenExpiry  time.Duration
 	RefreshTokenSecret string
 	RefreshTokenExpiry time.Duration
@@ -20,15 +27,21 @@ type TokenClaims struct {
 	UserID uuid.UUID `json:"sub"`
 	Email  string    `json:"email"`
 	Name   string    `json:"name"`
+	Roles  []string  `json:"roles"`
+	// Scopes limit the claims of an API key to these permissions. They
+	// are never part of an access token.
+	Scopes []string `json:"-"`
 	jwt.StandardClaims
 }
 
 // TokenService handles token generation and validation
 type TokenService interface {
-	GenerateAccessToken(userID uuid.UUID, email, name string) (string, error)
+	GenerateAccessToken(userID uuid.UUID, email, name string, roles []string) (string, error)
 	GenerateRefreshToken(userID uuid.UUID) (string, error)
 	ValidateAccessToken(tokenString string) (*TokenClaims, error)
 	ValidateRefreshToken(tokenString string) (*TokenClaims, error)
+	RefreshTokenExpiry() time.Duration
+	JWKS() response.JWKSResponse
 }
 
 // tokenService implements TokenService
@@ -42,3 +55,8 @@ func NewTokenService(config TokenConfig) TokenService {
 		config: config,
 	}
 }
+
+// RefreshTokenExpiry returns how long newly issued refresh tokens stay valid
+func (s *tokenService) RefreshTokenExpiry() time.Duration {
+	return s.config.RefreshTokenExpiry
+}
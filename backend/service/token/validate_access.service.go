@@ -13,12 +13,7 @@ func (s *tokenService) ValidateAccessToken(tokenString string) (*TokenClaims, er
 	}
 
 	claims := &TokenClaims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return []byte(s.config.AccessTokenSecret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.accessTokenKey)
 
 	if err != nil {
 		return nil, err
@@ -30,3 +25,25 @@ func (s *tokenService) ValidateAccessToken(tokenString string) (*TokenClaims, er
 
 	return claims, nil
 }
+
+// accessTokenKey returns the key token must be signed with: the key its
+// kid names, which must match its algorithm, or else AccessTokenSecret
+func (s *tokenService) accessTokenKey(token *jwt.Token) (interface{}, error) {
+	if len(s.config.AccessTokenKeys) == 0 {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(s.config.AccessTokenSecret), nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	for _, key := range s.config.AccessTokenKeys {
+		if key.ID == kid {
+			if token.Method.Alg() != key.Method.Alg() {
+				return nil, errors.New("invalid signing method")
+			}
+			return key.PublicKey, nil
+		}
+	}
+	return nil, errors.New("unknown signing key")
+}
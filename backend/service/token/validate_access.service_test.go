@@ -1,9 +1,14 @@
 package token
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 )
 
@@ -26,7 +31,7 @@ func TestValidateAccessToken(t *testing.T) {
 		{
 			name: "should validate access token successfully",
 			tokenFunc: func(svc TokenService) string {
-				token, _ := svc.GenerateAccessToken(testUserID, "test@example.com", "Test User")
+				token, _ := svc.GenerateAccessToken(testUserID, "test@example.com", "Test User", nil)
 				return token
 			},
 			expectedError: false,
@@ -50,7 +55,7 @@ func TestValidateAccessToken(t *testing.T) {
 		{
 			name: "should return error for tampered token",
 			tokenFunc: func(svc TokenService) string {
-				token, _ := svc.GenerateAccessToken(testUserID, "test@example.com", "Test User")
+				token, _ := svc.GenerateAccessToken(testUserID, "test@example.com", "Test User", nil)
 				return token + "tampered"
 			},
 			expectedError: true,
@@ -65,7 +70,7 @@ func TestValidateAccessToken(t *testing.T) {
 					RefreshTokenExpiry: 7 * 24 * time.Hour,
 				}
 				wrongSvc := NewTokenService(wrongConfig)
-				token, _ := wrongSvc.GenerateAccessToken(testUserID, "test@example.com", "Test User")
+				token, _ := wrongSvc.GenerateAccessToken(testUserID, "test@example.com", "Test User", nil)
 				return token
 			},
 			expectedError: true,
@@ -116,7 +121,7 @@ func TestValidateAccessTokenExpired(t *testing.T) {
 	}
 
 	service := NewTokenService(config)
-	tokenString, _ := service.GenerateAccessToken(testUserID, "test@example.com", "Test User")
+	tokenString, _ := service.GenerateAccessToken(testUserID, "test@example.com", "Test User", nil)
 
 	time.Sleep(100 * time.Millisecond)
 
@@ -134,6 +139,7 @@ func TestValidateAccessTokenClaimsIntegrity(t *testing.T) {
 	testUserID := uuid.New()
 	testEmail := "test@example.com"
 	testName := "Test User"
+	testRoles := []string{"accountant", "viewer"}
 
 	config := TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
@@ -143,7 +149,7 @@ func TestValidateAccessTokenClaimsIntegrity(t *testing.T) {
 	}
 
 	service := NewTokenService(config)
-	tokenString, _ := service.GenerateAccessToken(testUserID, testEmail, testName)
+	tokenString, _ := service.GenerateAccessToken(testUserID, testEmail, testName, testRoles)
 
 	claims, err := service.ValidateAccessToken(tokenString)
 
@@ -160,7 +166,118 @@ func TestValidateAccessTokenClaimsIntegrity(t *testing.T) {
 	if claims.Name != testName {
 		t.Errorf("Name mismatch: expected %s, got %s", testName, claims.Name)
 	}
+	if !slices.Equal(claims.Roles, testRoles) {
+		t.Errorf("Roles mismatch: expected %v, got %v", testRoles, claims.Roles)
+	}
 	if claims.Issuer != "go-vite-react" {
 		t.Errorf("Issuer mismatch: expected go-vite-react, got %s", claims.Issuer)
 	}
 }
+
+func TestValidateAccessTokenWithSigningKeys(t *testing.T) {
+	testUserID := uuid.New()
+	signingKey := func(private any) SigningKey {
+		key, err := ParseSigningKey(pemKey(t, private))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edPrivate := newEd25519Key(t)
+	_, otherPrivate := newEd25519Key(t)
+	rsaKey, edKey, otherKey := signingKey(rsaPrivate), signingKey(edPrivate), signingKey(otherPrivate)
+
+	service := func(keys ...SigningKey) TokenService {
+		return NewTokenService(TokenConfig{
+			AccessTokenSecret: "test-access-secret",
+			AccessTokenKeys:   keys,
+			AccessTokenExpiry: 15 * time.Minute,
+		})
+	}
+	generate := func(svc TokenService) string {
+		token, err := svc.GenerateAccessToken(testUserID, "test@example.com", "Test User", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		validator     TokenService
+		expectedError bool
+	}{
+		{
+			name:      "should validate a token signed with RS256",
+			token:     generate(service(rsaKey)),
+			validator: service(rsaKey),
+		},
+		{
+			name:      "should validate a token signed with EdDSA",
+			token:     generate(service(edKey)),
+			validator: service(edKey),
+		},
+		{
+			name:      "should validate a token signed with a key rotated out of signing",
+			token:     generate(service(edKey)),
+			validator: service(otherKey, edKey),
+		},
+		{
+			name:          "should reject a token signed with a removed key",
+			token:         generate(service(edKey)),
+			validator:     service(otherKey),
+			expectedError: true,
+		},
+		{
+			name:          "should reject a token signed with the secret",
+			token:         generate(service()),
+			validator:     service(edKey),
+			expectedError: true,
+		},
+		{
+			name:          "should reject a token signed with a key when only the secret is configured",
+			token:         generate(service(edKey)),
+			validator:     service(),
+			expectedError: true,
+		},
+		{
+			name: "should reject a token whose algorithm does not match its key",
+			token: func() string {
+				// The public key is no secret, so must never verify HS256
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, TokenClaims{UserID: testUserID})
+				token.Header["kid"] = edKey.ID
+				signed, err := token.SignedString([]byte(edKey.PublicKey.(ed25519.PublicKey)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return signed
+			}(),
+			validator:     service(edKey),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := tt.validator.ValidateAccessToken(tt.token)
+
+			if tt.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims.UserID != testUserID {
+				t.Errorf("expected UserID %v, got %v", testUserID, claims.UserID)
+			}
+		})
+	}
+}
@@ -2,58 +2,68 @@ package user
 
 import (
 	"context"
-	"errors"
 
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// Login authenticates a user and returns tokens
-func (s *userService) Login(ctx context.Context, req *request.LoginRequest) (*response.LoginResponse, error) {
+// Login authenticates a user and returns tokens. Failed sign-ins are
+// throttled per account and per clientIP, which may be empty.
+func (s *userService) Login(ctx context.Context, req *request.LoginRequest, clientIP string) (*response.LoginResponse, error) {
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	default:
 	}
 
+	limits := s.loginLimits(req.Email, clientIP)
+	if err := s.checkLoginLimits(ctx, limits, s.now().UTC()); err != nil {
+		return nil, err
+	}
+
 	// Find user by email
 	user, err := s.userRepository.FindByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, err
 	}
 
-	if user == nil {
-		return nil, errors.New("invalid email or password")
+	// Compare password, against a dummy hash for unknown emails so they
+	// cannot be told apart by timing
+	hashedPassword := dummyPasswordHash()
+	if user != nil {
+		hashedPassword = user.Password
+	}
+	err = bcrypt.CompareHashAndPassword(hashedPassword, []byte(req.Password))
+	if err != nil || user == nil {
+		// Backoff starts once the failure is known, not when it began
+		if err := s.recordLoginFailure(ctx, limits, s.now().UTC()); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
 	}
 
-	// Compare password
-	err = bcrypt.CompareHashAndPassword(user.Password, []byte(req.Password))
-	if err != nil {
-		return nil, errors.New("invalid email or password")
+	// Forget the account's failures; the client IP's are forgotten once
+	// LockoutDuration has passed since its last
+	if err := s.loginAttemptRepository.Reset(ctx, limits[0].key); err != nil {
+		return nil, err
 	}
 
 	// Generate access token
-	accessToken, err := s.tokenService.GenerateAccessToken(user.ID, user.Email, user.Name)
+	accessToken, err := s.tokenService.GenerateAccessToken(user.ID, user.Email, user.Name, roleNames(user))
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate refresh token
-	refreshToken, err := s.tokenService.GenerateRefreshToken(user.ID)
+	// Generate and store refresh token so it can be rotated and revoked
+	refreshToken, err := s.issueRefreshToken(ctx, user.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Store refresh token in database/cache for revocation
-	_ = refreshToken
-
 	return &response.LoginResponse{
-		User: response.GetUser{
-			ID:    user.ID,
-			Email: user.Email,
-			Name:  user.Name,
-		},
-		Token: accessToken,
+		User:         toGetUser(user),
+		Token:        accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -20,12 +20,12 @@ func TestLogin(t *testing.T) {
 	testUserID := uuid.New()
 
 	tests := []struct {
-		name              string
-		request           *request.LoginRequest
-		mockFindByEmail   *entity.UserEntity
+		name               string
+		request            *request.LoginRequest
+		mockFindByEmail    *entity.UserEntity
 		mockFindByEmailErr error
-		expectedError     bool
-		expectedErrorMsg  string
+		expectedError      bool
+		expectedErrorMsg   string
 	}{
 		{
 			name: "should login user successfully",
@@ -88,6 +88,27 @@ func TestLogin(t *testing.T) {
 
 			// Setup mock repository
 			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			mockAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+
+			// Setup throttle expectations: no earlier failures, and this
+			// one counted or the account's failures forgotten
+			mockAttemptRepo.EXPECT().
+				Find(gomock.Any(), "email:"+tt.request.Email).
+				Return(nil, nil).
+				Times(1)
+			if tt.expectedErrorMsg == "invalid email or password" {
+				mockAttemptRepo.EXPECT().
+					RecordFailure(gomock.Any(), "email:"+tt.request.Email, gomock.Any(), gomock.Any()).
+					Return(&entity.LoginAttemptEntity{Failures: 1}, nil).
+					Times(1)
+			}
+			if !tt.expectedError {
+				mockAttemptRepo.EXPECT().
+					Reset(gomock.Any(), "email:"+tt.request.Email).
+					Return(nil).
+					Times(1)
+			}
 
 			// Setup FindByEmail expectation
 			mockRepo.EXPECT().
@@ -95,6 +116,22 @@ func TestLogin(t *testing.T) {
 				Return(tt.mockFindByEmail, tt.mockFindByEmailErr).
 				Times(1)
 
+			// Setup refresh token storage expectation only on successful login
+			if !tt.expectedError {
+				mockRefreshRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, stored entity.RefreshTokenEntity) (*uuid.UUID, error) {
+						if stored.UserID != testUserID {
+							t.Errorf("expected refresh token for user %v, got %v", testUserID, stored.UserID)
+						}
+						if stored.TokenHash == "" || stored.FamilyID == uuid.Nil {
+							t.Errorf("expected hashed token with a family, got %+v", stored)
+						}
+						return &stored.ID, nil
+					}).
+					Times(1)
+			}
+
 			// Setup token service
 			tokenConfig := token.TokenConfig{
 				AccessTokenSecret:  "test-access-secret",
@@ -103,10 +140,10 @@ func TestLogin(t *testing.T) {
 			tokenSvc := token.NewTokenService(tokenConfig)
 
 			// Create service with mocked repository
-			svc := NewUserService(mockRepo, tokenSvc)
+			svc := NewUserService(mockRepo, mockRefreshRepo, mockAttemptRepo, tokenSvc, LoginThrottleConfig{MaxFailures: 5})
 
 			// Call the method being tested
-			result, err := svc.Login(context.Background(), tt.request)
+			result, err := svc.Login(context.Background(), tt.request, "")
 
 			// Assert results
 			if tt.expectedError {
@@ -130,6 +167,9 @@ func TestLogin(t *testing.T) {
 					if result.Token == "" {
 						t.Errorf("expected non-empty token")
 					}
+					if result.RefreshToken == "" {
+						t.Errorf("expected non-empty refresh token")
+					}
 				}
 			}
 		})
@@ -141,13 +181,14 @@ func TestLoginContextCancellation(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
-	svc := NewUserService(mockRepo, tokenSvc)
+	svc := NewUserService(mockRepo, mockRefreshRepo, mock.NewMockLoginAttemptRepository(ctrl), tokenSvc, LoginThrottleConfig{})
 
 	// Create a cancelled context
 	ctx, cancel := context.WithCancel(context.Background())
@@ -158,7 +199,7 @@ func TestLoginContextCancellation(t *testing.T) {
 		Password: "password123",
 	}
 
-	result, err := svc.Login(ctx, req)
+	result, err := svc.Login(ctx, req, "")
 
 	if err == nil {
 		t.Errorf("expected context.Canceled error, got nil")
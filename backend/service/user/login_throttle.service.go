@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// LoginThrottleConfig limits failed sign-ins per account and per client IP
+type LoginThrottleConfig struct {
+	// MaxFailures locks an account after this many failed sign-ins
+	MaxFailures int
+	// IPMaxFailures blocks a client IP after this many failed sign-ins,
+	// whichever accounts they were for
+	IPMaxFailures int
+	// BaseDelay is the wait after a failed sign-in to an account. It doubles
+	// with each further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LockoutDuration is how long a lockout lasts. Failures are forgotten
+	// once this long has passed since the last.
+	LockoutDuration time.Duration
+}
+
+// loginLimit is a key failed sign-ins are counted under, the failures
+// that lock it and the error returned while it is locked. Only limits with
+// backoff make each failure wait before the next attempt.
+type loginLimit struct {
+	key         string
+	maxFailures int
+	lockedErr   *apperror.Error
+	backoff     bool
+}
+
+// dummyPasswordHash is compared against for unknown emails, so they take
+// as long to reject as a wrong password
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// accountKey is the key failed sign-ins to an email are counted under,
+// whether or not a user has it
+func accountKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// loginLimits returns the limits a sign-in to email from clientIP is
+// subject to. The client IP is left out when unknown, and only blocked once
+// locked, so users sharing it through a NAT or proxy do not wait on each
+// other's typos.
+func (s *userService) loginLimits(email, clientIP string) []loginLimit {
+	limits := []loginLimit{{
+		key:         accountKey(email),
+		maxFailures: s.loginThrottle.MaxFailures,
+		lockedErr:   ErrAccountLocked,
+		backoff:     true,
+	}}
+	if clientIP != "" {
+		limits = append(limits, loginLimit{
+			key:         "ip:" + clientIP,
+			maxFailures: s.loginThrottle.IPMaxFailures,
+			lockedErr:   ErrTooManyLoginAttempts,
+		})
+	}
+	return limits
+}
+
+// checkLoginLimits refuses a sign-in made before the backoff of an earlier
+// failure has passed or while a limit is locked
+func (s *userService) checkLoginLimits(ctx context.Context, limits []loginLimit, now time.Time) error {
+	for _, limit := range limits {
+		attempt, err := s.loginAttemptRepository.Find(ctx, limit.key)
+		if err != nil {
+			return err
+		}
+		if attempt == nil {
+			continue
+		}
+
+		retryAt, locked := s.loginThrottle.retryAt(attempt, limit.maxFailures)
+		if !now.Before(retryAt) || (!locked && !limit.backoff) {
+			continue
+		}
+		if locked {
+			return retryLoginAfter(limit.lockedErr, retryAt.Sub(now))
+		}
+		return retryLoginAfter(ErrTooManyLoginAttempts, retryAt.Sub(now))
+	}
+	return nil
+}
+
+// recordLoginFailure counts a failed sign-in against every limit
+func (s *userService) recordLoginFailure(ctx context.Context, limits []loginLimit, now time.Time) error {
+	for _, limit := range limits {
+		if _, err := s.loginAttemptRepository.RecordFailure(ctx, limit.key, now, now.Add(-s.loginThrottle.LockoutDuration)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryAt returns when the next sign-in is allowed after attempt's
+// failures, and whether maxFailures of them lock it until then
+func (c LoginThrottleConfig) retryAt(attempt *entity.LoginAttemptEntity, maxFailures int) (time.Time, bool) {
+	if attempt.Failures >= maxFailures {
+		return attempt.LastFailedAt.Add(c.LockoutDuration), true
+	}
+
+	delay := c.BaseDelay
+	for i := 1; i < attempt.Failures && delay < c.MaxDelay; i++ {
+		delay *= 2
+	}
+	return attempt.LastFailedAt.Add(min(delay, c.MaxDelay)), false
+}
+
+// retryLoginAfter returns a copy of err telling the client to wait
+func retryLoginAfter(err *apperror.Error, wait time.Duration) error {
+	retry := *err
+	retry.RetryAfter = wait
+	return &retry
+}
@@ -0,0 +1,204 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	user := &entity.UserEntity{ID: uuid.New(), Email: "test@example.com", Password: hashedPassword, Name: "Test User"}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	throttle := LoginThrottleConfig{
+		MaxFailures:     5,
+		IPMaxFailures:   20,
+		BaseDelay:       time.Second,
+		MaxDelay:        5 * time.Second,
+		LockoutDuration: 15 * time.Minute,
+	}
+	failures := func(n int, ago time.Duration) *entity.LoginAttemptEntity {
+		return &entity.LoginAttemptEntity{Failures: n, LastFailedAt: now.Add(-ago)}
+	}
+
+	tests := []struct {
+		name               string
+		password           string
+		accountAttempt     *entity.LoginAttemptEntity
+		ipAttempt          *entity.LoginAttemptEntity
+		expectedCode       string
+		expectedRetryAfter time.Duration
+		expectFailure      bool
+	}{
+		{
+			name:               "should refuse a sign-in before the backoff has passed",
+			password:           "password123",
+			accountAttempt:     failures(2, time.Second),
+			expectedCode:       "too_many_login_attempts",
+			expectedRetryAfter: time.Second,
+		},
+		{
+			name:           "should allow a sign-in once the backoff has passed",
+			password:       "password123",
+			accountAttempt: failures(2, 2*time.Second),
+		},
+		{
+			name:               "should cap the backoff",
+			password:           "password123",
+			accountAttempt:     failures(4, 0),
+			expectedCode:       "too_many_login_attempts",
+			expectedRetryAfter: 5 * time.Second,
+		},
+		{
+			name:               "should refuse a locked account even with the right password",
+			password:           "password123",
+			accountAttempt:     failures(5, time.Minute),
+			expectedCode:       "account_locked",
+			expectedRetryAfter: 14 * time.Minute,
+		},
+		{
+			name:           "should allow a sign-in once the lockout has passed",
+			password:       "password123",
+			accountAttempt: failures(5, 15*time.Minute),
+		},
+		{
+			name:               "should refuse a blocked client IP",
+			password:           "password123",
+			ipAttempt:          failures(20, 10*time.Minute),
+			expectedCode:       "too_many_login_attempts",
+			expectedRetryAfter: 5 * time.Minute,
+		},
+		{
+			name:      "should not delay a client IP shared by other failed sign-ins",
+			password:  "password123",
+			ipAttempt: failures(19, 0),
+		},
+		{
+			name:          "should count a wrong password against the account and the client IP",
+			password:      "wrongpassword",
+			ipAttempt:     failures(3, time.Minute),
+			expectedCode:  "invalid_credentials",
+			expectFailure: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+			mockAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+
+			mockAttemptRepo.EXPECT().Find(gomock.Any(), "email:test@example.com").Return(tt.accountAttempt, nil).AnyTimes()
+			mockAttemptRepo.EXPECT().Find(gomock.Any(), "ip:203.0.113.7").Return(tt.ipAttempt, nil).AnyTimes()
+			if tt.expectedCode == "" || tt.expectFailure {
+				mockRepo.EXPECT().FindByEmail(gomock.Any(), "test@example.com").Return(user, nil).Times(1)
+			}
+			if tt.expectFailure {
+				for _, key := range []string{"email:test@example.com", "ip:203.0.113.7"} {
+					mockAttemptRepo.EXPECT().
+						RecordFailure(gomock.Any(), key, now, now.Add(-15*time.Minute)).
+						Return(&entity.LoginAttemptEntity{Key: key, Failures: 1}, nil).
+						Times(1)
+				}
+			}
+			if tt.expectedCode == "" {
+				mockAttemptRepo.EXPECT().Reset(gomock.Any(), "email:test@example.com").Return(nil).Times(1)
+				mockRefreshRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&user.ID, nil).Times(1)
+			}
+
+			tokenSvc := token.NewTokenService(token.TokenConfig{AccessTokenSecret: "test-access-secret", RefreshTokenSecret: "test-refresh-secret"})
+			svc := NewUserService(mockRepo, mockRefreshRepo, mockAttemptRepo, tokenSvc, throttle)
+			svc.(*userService).now = func() time.Time { return now }
+
+			result, err := svc.Login(context.Background(), &request.LoginRequest{Email: "test@example.com", Password: tt.password}, "203.0.113.7")
+
+			if tt.expectedCode == "" {
+				if err != nil || result == nil {
+					t.Fatalf("expected a sign-in, got %v", err)
+				}
+				return
+			}
+			var appErr *apperror.Error
+			if !errors.As(err, &appErr) || appErr.Code != tt.expectedCode {
+				t.Fatalf("expected %s, got %v", tt.expectedCode, err)
+			}
+			if appErr.RetryAfter != tt.expectedRetryAfter {
+				t.Errorf("expected to retry after %v, got %v", tt.expectedRetryAfter, appErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestLoginUnknownEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+	mockRepo.EXPECT().FindByEmail(gomock.Any(), "Nobody@Example.com").Return(nil, nil).Times(1)
+	mockAttemptRepo.EXPECT().Find(gomock.Any(), "email:nobody@example.com").Return(nil, nil).Times(1)
+	mockAttemptRepo.EXPECT().
+		RecordFailure(gomock.Any(), "email:nobody@example.com", gomock.Any(), gomock.Any()).
+		Return(&entity.LoginAttemptEntity{Failures: 1}, nil).
+		Times(1)
+
+	svc := NewUserService(mockRepo, mock.NewMockRefreshTokenRepository(ctrl), mockAttemptRepo, token.NewTokenService(token.TokenConfig{}), LoginThrottleConfig{MaxFailures: 5})
+
+	// Unknown emails are locked out like real ones, so lockouts reveal nothing
+	_, err := svc.Login(context.Background(), &request.LoginRequest{Email: "Nobody@Example.com", Password: "password123"}, "")
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected %v, got %v", ErrInvalidCredentials, err)
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		user          *entity.UserEntity
+		expectedError error
+	}{
+		{
+			name: "should forget the failed sign-ins of the user's account",
+			user: &entity.UserEntity{ID: userID, Email: "Test@Example.com"},
+		},
+		{
+			name:          "should return not found for an unknown user",
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockAttemptRepo := mock.NewMockLoginAttemptRepository(ctrl)
+			mockRepo.EXPECT().FindByID(gomock.Any(), userID).Return(tt.user, nil).Times(1)
+			if tt.user != nil {
+				mockAttemptRepo.EXPECT().Reset(gomock.Any(), "email:test@example.com").Return(nil).Times(1)
+			}
+
+			svc := NewUserService(mockRepo, mock.NewMockRefreshTokenRepository(ctrl), mockAttemptRepo, token.NewTokenService(token.TokenConfig{}), LoginThrottleConfig{})
+
+			if err := svc.Unlock(context.Background(), userID); !errors.Is(err, tt.expectedError) {
+				t.Errorf("expected %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+)
+
+// Logout revokes the token family of the given refresh token.
+// Unknown or invalid tokens are ignored since there is nothing to revoke.
+func (s *userService) Logout(ctx context.Context, refreshToken string) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	claims, err := s.tokenService.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		return nil
+	}
+
+	stored, err := s.refreshTokenRepository.FindByTokenHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.UserID != claims.UserID {
+		return nil
+	}
+
+	return s.refreshTokenRepository.RevokeFamily(ctx, stored.FamilyID)
+}
@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+)
+
+func TestLogout(t *testing.T) {
+	testUserID := uuid.New()
+	familyID := uuid.New()
+	tokenSvc := token.NewTokenService(token.TokenConfig{
+		AccessTokenSecret:  "test-access-secret",
+		RefreshTokenSecret: "test-refresh-secret",
+		RefreshTokenExpiry: time.Hour,
+	})
+	validRefreshToken, _ := tokenSvc.GenerateRefreshToken(testUserID)
+
+	tests := []struct {
+		name          string
+		refreshToken  string
+		expectLookup  bool
+		storedToken   *entity.RefreshTokenEntity
+		lookupErr     error
+		expectRevoke  bool
+		expectedError bool
+	}{
+		{
+			name:         "should revoke the token family",
+			refreshToken: validRefreshToken,
+			expectLookup: true,
+			storedToken: &entity.RefreshTokenEntity{
+				ID:       uuid.New(),
+				UserID:   testUserID,
+				FamilyID: familyID,
+			},
+			expectRevoke:  true,
+			expectedError: false,
+		},
+		{
+			name:          "should ignore an invalid token",
+			refreshToken:  "invalid-token",
+			expectedError: false,
+		},
+		{
+			name:          "should ignore a token that is not stored",
+			refreshToken:  validRefreshToken,
+			expectLookup:  true,
+			storedToken:   nil,
+			expectedError: false,
+		},
+		{
+			name:          "should return error when repository fails",
+			refreshToken:  validRefreshToken,
+			expectLookup:  true,
+			lookupErr:     errors.New("database error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+
+			if tt.expectLookup {
+				mockRefreshRepo.EXPECT().
+					FindByTokenHash(gomock.Any(), hashRefreshToken(tt.refreshToken)).
+					Return(tt.storedToken, tt.lookupErr).
+					Times(1)
+			}
+			if tt.expectRevoke {
+				mockRefreshRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(nil).
+					Times(1)
+			}
+
+			svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
+			err := svc.Logout(context.Background(), tt.refreshToken)
+
+			if tt.expectedError && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.expectedError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -2,13 +2,16 @@ package user
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 )
 
-// Refresh generates a new access token from a refresh token
+// Refresh rotates a refresh token and issues a new access token.
+// Presenting a token that has already been rotated is treated as theft:
+// the whole token family is revoked and the caller must log in again.
 func (s *userService) Refresh(ctx context.Context, refreshToken string) (*response.RefreshResponse, error) {
 	select {
 	case <-ctx.Done():
@@ -19,17 +22,65 @@ func (s *userService) Refresh(ctx context.Context, refreshToken string) (*respon
 	// Validate refresh token
 	claims, err := s.tokenService.ValidateRefreshToken(refreshToken)
 	if err != nil {
-		return nil, errors.New("invalid refresh token")
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Look up the stored token
+	stored, err := s.refreshTokenRepository.FindByTokenHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil || stored.UserID != claims.UserID {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// A revoked token being presented again means it was reused
+	if stored.RevokedAt != nil {
+		if err := s.refreshTokenRepository.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepository.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Rotate the refresh token within the same family
+	nextToken, next, err := s.newRefreshToken(user.ID, stored.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := s.refreshTokenRepository.Rotate(ctx, stored.ID, next)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// Another request rotated this token first
+		if err := s.refreshTokenRepository.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
 	}
 
 	// Generate new access token
-	accessToken, err := s.tokenService.GenerateAccessToken(claims.UserID, claims.Email, claims.Name)
+	accessToken, err := s.tokenService.GenerateAccessToken(user.ID, user.Email, user.Name, roleNames(user))
 	if err != nil {
 		return nil, err
 	}
 
 	return &response.RefreshResponse{
-		Token: accessToken,
+		Token:        accessToken,
+		RefreshToken: nextToken,
 	}, nil
 }
 
@@ -43,7 +94,7 @@ func (s *userService) GetUser(ctx context.Context, userID string) (*response.Get
 
 	id, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, errors.New("invalid user id")
+		return nil, apperror.New(apperror.ErrUnauthorized, "invalid_user_id", "invalid user id")
 	}
 
 	user, err := s.userRepository.FindByID(ctx, id)
@@ -51,12 +102,9 @@ func (s *userService) GetUser(ctx context.Context, userID string) (*response.Get
 		return nil, err
 	}
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, ErrUserNotFound
 	}
 
-	return &response.GetUser{
-		ID:    user.ID,
-		Email: user.Email,
-		Name:  user.Name,
-	}, nil
+	getUser := toGetUser(user)
+	return &getUser, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -14,24 +15,49 @@ import (
 
 func TestRefresh(t *testing.T) {
 	testUserID := uuid.New()
+	familyID := uuid.New()
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
+		RefreshTokenExpiry: time.Hour,
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
 	// Generate a valid refresh token for testing
 	validRefreshToken, _ := tokenSvc.GenerateRefreshToken(testUserID)
+	revokedAt := time.Now().Add(-time.Minute)
+
+	activeToken := &entity.RefreshTokenEntity{
+		ID:        uuid.New(),
+		UserID:    testUserID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(validRefreshToken),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	testUser := &entity.UserEntity{
+		ID:    testUserID,
+		Email: "test@example.com",
+		Name:  "Test User",
+	}
 
 	tests := []struct {
 		name             string
 		refreshToken     string
+		storedToken      *entity.RefreshTokenEntity
+		expectLookup     bool
+		expectRotate     bool
+		rotated          bool
+		expectRevoke     bool
 		expectedError    bool
 		expectedErrorMsg string
 	}{
 		{
-			name:          "should refresh token successfully",
+			name:          "should rotate refresh token successfully",
 			refreshToken:  validRefreshToken,
+			storedToken:   activeToken,
+			expectLookup:  true,
+			expectRotate:  true,
+			rotated:       true,
 			expectedError: false,
 		},
 		{
@@ -46,6 +72,55 @@ func TestRefresh(t *testing.T) {
 			expectedError:    true,
 			expectedErrorMsg: "invalid refresh token",
 		},
+		{
+			name:             "should return error when refresh token is not stored",
+			refreshToken:     validRefreshToken,
+			storedToken:      nil,
+			expectLookup:     true,
+			expectedError:    true,
+			expectedErrorMsg: "invalid refresh token",
+		},
+		{
+			name:         "should revoke family when a rotated token is reused",
+			refreshToken: validRefreshToken,
+			storedToken: &entity.RefreshTokenEntity{
+				ID:        activeToken.ID,
+				UserID:    testUserID,
+				FamilyID:  familyID,
+				TokenHash: activeToken.TokenHash,
+				ExpiresAt: activeToken.ExpiresAt,
+				RevokedAt: &revokedAt,
+			},
+			expectLookup:     true,
+			expectRevoke:     true,
+			expectedError:    true,
+			expectedErrorMsg: "refresh token reuse detected",
+		},
+		{
+			name:             "should revoke family when a concurrent refresh rotated first",
+			refreshToken:     validRefreshToken,
+			storedToken:      activeToken,
+			expectLookup:     true,
+			expectRotate:     true,
+			rotated:          false,
+			expectRevoke:     true,
+			expectedError:    true,
+			expectedErrorMsg: "refresh token reuse detected",
+		},
+		{
+			name:         "should return error when stored token has expired",
+			refreshToken: validRefreshToken,
+			storedToken: &entity.RefreshTokenEntity{
+				ID:        activeToken.ID,
+				UserID:    testUserID,
+				FamilyID:  familyID,
+				TokenHash: activeToken.TokenHash,
+				ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			expectLookup:     true,
+			expectedError:    true,
+			expectedErrorMsg: "invalid refresh token",
+		},
 	}
 
 	for _, tt := range tests {
@@ -53,11 +128,43 @@ func TestRefresh(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			// Setup mock repository
+			// Setup mock repositories
 			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+
+			if tt.expectLookup {
+				mockRefreshRepo.EXPECT().
+					FindByTokenHash(gomock.Any(), hashRefreshToken(tt.refreshToken)).
+					Return(tt.storedToken, nil).
+					Times(1)
+			}
+			if tt.expectRotate {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), testUserID).
+					Return(testUser, nil).
+					Times(1)
+				mockRefreshRepo.EXPECT().
+					Rotate(gomock.Any(), tt.storedToken.ID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, next entity.RefreshTokenEntity) (bool, error) {
+						if next.FamilyID != familyID {
+							t.Errorf("expected rotated token to stay in family %v, got %v", familyID, next.FamilyID)
+						}
+						if next.TokenHash == tt.storedToken.TokenHash {
+							t.Errorf("expected rotated token to differ from the presented one")
+						}
+						return tt.rotated, nil
+					}).
+					Times(1)
+			}
+			if tt.expectRevoke {
+				mockRefreshRepo.EXPECT().
+					RevokeFamily(gomock.Any(), familyID).
+					Return(nil).
+					Times(1)
+			}
 
 			// Create service with same token config
-			svc := NewUserService(mockRepo, tokenSvc)
+			svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 			// Call refresh
 			result, err := svc.Refresh(context.Background(), tt.refreshToken)
@@ -67,7 +174,7 @@ func TestRefresh(t *testing.T) {
 				if err == nil {
 					t.Errorf("expected error, got nil")
 				}
-				if tt.expectedErrorMsg != "" && err.Error() != tt.expectedErrorMsg {
+				if tt.expectedErrorMsg != "" && err != nil && err.Error() != tt.expectedErrorMsg {
 					t.Errorf("expected error message '%s', got '%s'", tt.expectedErrorMsg, err.Error())
 				}
 			} else {
@@ -80,6 +187,9 @@ func TestRefresh(t *testing.T) {
 				if result != nil && result.Token == "" {
 					t.Errorf("expected non-empty token")
 				}
+				if result != nil && (result.RefreshToken == "" || result.RefreshToken == tt.refreshToken) {
+					t.Errorf("expected a new refresh token")
+				}
 			}
 		})
 	}
@@ -90,13 +200,14 @@ func TestRefreshContextCancellation(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
-	svc := NewUserService(mockRepo, tokenSvc)
+	svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 	// Create a cancelled context
 	ctx, cancel := context.WithCancel(context.Background())
@@ -165,6 +276,7 @@ func TestGetUser(t *testing.T) {
 
 			// Setup mock repository
 			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 
 			// Setup FindByID expectation only if valid UUID
 			if _, err := uuid.Parse(tt.userIDString); err == nil {
@@ -182,7 +294,7 @@ func TestGetUser(t *testing.T) {
 			tokenSvc := token.NewTokenService(tokenConfig)
 
 			// Create service
-			svc := NewUserService(mockRepo, tokenSvc)
+			svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 			// Call getuser
 			result, err := svc.GetUser(context.Background(), tt.userIDString)
@@ -212,13 +324,14 @@ func TestGetUserContextCancellation(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
-	svc := NewUserService(mockRepo, tokenSvc)
+	svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 	// Create a cancelled context
 	ctx, cancel := context.WithCancel(context.Background())
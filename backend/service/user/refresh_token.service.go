@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+)
+
+// newRefreshToken generates a refresh token for the user within the given
+// token family and returns it together with the record to persist.
+func (s *userService) newRefreshToken(userID, familyID uuid.UUID) (string, entity.RefreshTokenEntity, error) {
+	refreshToken, err := s.tokenService.GenerateRefreshToken(userID)
+	if err != nil {
+		return "", entity.RefreshTokenEntity{}, err
+	}
+
+	return refreshToken, entity.RefreshTokenEntity{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.tokenService.RefreshTokenExpiry()),
+	}, nil
+}
+
+// issueRefreshToken starts a new token family for the user and stores its first token
+func (s *userService) issueRefreshToken(ctx context.Context, userID uuid.UUID) (string, error) {
+	refreshToken, record, err := s.newRefreshToken(userID, uuid.New())
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.refreshTokenRepository.Create(ctx, record); err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+// hashRefreshToken returns the SHA-256 digest stored in place of the raw token
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,7 +2,6 @@ package user
 
 import (
 	"context"
-	"errors"
 
 	"github.com/google/uuid"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
@@ -25,7 +24,7 @@ func (s *userService) Register(ctx context.Context, req *request.RegisterUserReq
 		return nil, err
 	}
 	if existingUser != nil {
-		return nil, errors.New("user already exists")
+		return nil, ErrUserExists
 	}
 
 	// Hash password
@@ -34,12 +33,25 @@ func (s *userService) Register(ctx context.Context, req *request.RegisterUserReq
 		return nil, err
 	}
 
+	// The first user administers the instance; everyone after starts as
+	// an accountant until an admin changes their roles
+	role := entity.RoleAccountant
+	admins, err := s.userRepository.CountByRole(ctx, entity.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if admins == 0 {
+		role = entity.RoleAdmin
+	}
+
 	// Create user entity
+	userID := uuid.New()
 	userEntity := entity.UserEntity{
-		ID:       uuid.New(),
+		ID:       userID,
 		Email:    req.Email,
 		Password: hashedPassword,
 		Name:     req.Name,
+		Roles:    []entity.UserRoleEntity{{UserID: userID, Role: role}},
 	}
 
 	// Save to repository
@@ -49,18 +61,21 @@ func (s *userService) Register(ctx context.Context, req *request.RegisterUserReq
 	}
 
 	// Generate access token
-	accessToken, err := s.tokenService.GenerateAccessToken(userEntity.ID, userEntity.Email, userEntity.Name)
+	accessToken, err := s.tokenService.GenerateAccessToken(userEntity.ID, userEntity.Email, userEntity.Name, roleNames(&userEntity))
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate and store refresh token so it can be rotated and revoked
+	refreshToken, err := s.issueRefreshToken(ctx, userEntity.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Return response with user and token
+	// Return response with user and tokens
 	return &response.RegisterResponse{
-		User: response.GetUser{
-			ID:    userEntity.ID,
-			Email: userEntity.Email,
-			Name:  userEntity.Name,
-		},
-		Token: accessToken,
+		User:         toGetUser(&userEntity),
+		Token:        accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
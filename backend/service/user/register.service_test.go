@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -15,13 +16,15 @@ import (
 
 func TestRegister(t *testing.T) {
 	tests := []struct {
-		name              string
-		request           *request.RegisterUserRequest
-		mockFindByEmail   *entity.UserEntity
+		name               string
+		request            *request.RegisterUserRequest
+		mockFindByEmail    *entity.UserEntity
 		mockFindByEmailErr error
-		mockCreateErr     error
-		expectedError     bool
-		expectedErrorMsg  string
+		mockAdmins         int64
+		mockCreateErr      error
+		expectedRoles      []string
+		expectedError      bool
+		expectedErrorMsg   string
 	}{
 		{
 			name: "should register user successfully",
@@ -32,7 +35,22 @@ func TestRegister(t *testing.T) {
 			},
 			mockFindByEmail:    nil,
 			mockFindByEmailErr: nil,
+			mockAdmins:         1,
 			mockCreateErr:      nil,
+			expectedRoles:      []string{"accountant"},
+			expectedError:      false,
+		},
+		{
+			name: "should make the first user an admin",
+			request: &request.RegisterUserRequest{
+				Email:    "first@example.com",
+				Password: "password123",
+				Name:     "First User",
+			},
+			mockFindByEmail:    nil,
+			mockFindByEmailErr: nil,
+			mockAdmins:         0,
+			expectedRoles:      []string{"admin"},
 			expectedError:      false,
 		},
 		{
@@ -83,6 +101,7 @@ func TestRegister(t *testing.T) {
 
 			// Setup mock repository
 			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 
 			// Setup FindByEmail expectation
 			mockRepo.EXPECT().
@@ -90,14 +109,26 @@ func TestRegister(t *testing.T) {
 				Return(tt.mockFindByEmail, tt.mockFindByEmailErr).
 				Times(1)
 
-			// Setup Create expectation only if FindByEmail succeeds and user doesn't exist
+			// Setup CountByRole and Create expectations only if FindByEmail succeeds and user doesn't exist
 			if tt.mockFindByEmailErr == nil && tt.mockFindByEmail == nil {
+				mockRepo.EXPECT().
+					CountByRole(gomock.Any(), entity.RoleAdmin).
+					Return(tt.mockAdmins, nil).
+					Times(1)
 				mockRepo.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					Return(&uuid.UUID{}, tt.mockCreateErr).
 					Times(1)
 			}
 
+			// Setup refresh token storage expectation only when the user was created
+			if tt.mockFindByEmailErr == nil && tt.mockFindByEmail == nil && tt.mockCreateErr == nil {
+				mockRefreshRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(&uuid.UUID{}, nil).
+					Times(1)
+			}
+
 			// Setup token service
 			tokenConfig := token.TokenConfig{
 				AccessTokenSecret:  "test-access-secret",
@@ -106,7 +137,7 @@ func TestRegister(t *testing.T) {
 			tokenSvc := token.NewTokenService(tokenConfig)
 
 			// Create service with mocked repository
-			svc := NewUserService(mockRepo, tokenSvc)
+			svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 			// Call the method being tested
 			result, err := svc.Register(context.Background(), tt.request)
@@ -132,9 +163,15 @@ func TestRegister(t *testing.T) {
 				if result.User.Name != tt.request.Name {
 					t.Errorf("expected name %s, got %s", tt.request.Name, result.User.Name)
 				}
+				if !slices.Equal(result.User.Roles, tt.expectedRoles) {
+					t.Errorf("expected roles %v, got %v", tt.expectedRoles, result.User.Roles)
+				}
 				if result.Token == "" {
 					t.Errorf("expected non-empty token")
 				}
+				if result.RefreshToken == "" {
+					t.Errorf("expected non-empty refresh token")
+				}
 			}
 		})
 	}
@@ -145,13 +182,14 @@ func TestRegisterContextCancellation(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
-	svc := NewUserService(mockRepo, tokenSvc)
+	svc := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 	// Create a cancelled context
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
+)
+
+// ListUsers lists every user and their roles with pagination
+func (s *userService) ListUsers(ctx context.Context, page, limit int) (*response.UserPaginationResponse, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+
+	users, total, err := s.userRepository.FindAll(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	userResponses := make([]response.UserResponse, len(users))
+	for i := range users {
+		userResponses[i] = toUserResponse(&users[i], roleNames(&users[i]))
+	}
+
+	totalPage := int(math.Ceil(float64(total) / float64(limit)))
+
+	return &response.UserPaginationResponse{
+		Data: userResponses,
+		Meta: response.UserPaginationMeta{
+			TotalData: int(total),
+			Page:      page,
+			Limit:     limit,
+			TotalPage: totalPage,
+		},
+	}, nil
+}
+
+// UpdateRoles replaces the roles granted to a user. Access tokens already
+// issued keep the old roles until they are refreshed.
+func (s *userService) UpdateRoles(ctx context.Context, userID uuid.UUID, req *request.UpdateUserRolesRequest) (*response.UserResponse, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	var roles []entity.Role
+	for _, role := range req.Roles {
+		if !rbac.IsRole(role) {
+			return nil, ErrInvalidRole
+		}
+		if !slices.Contains(roles, entity.Role(role)) {
+			roles = append(roles, entity.Role(role))
+		}
+	}
+	if len(roles) == 0 {
+		return nil, ErrInvalidRole
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// Someone must be left who can assign roles
+	if slices.Contains(roleNames(user), string(entity.RoleAdmin)) && !slices.Contains(roles, entity.RoleAdmin) {
+		admins, err := s.userRepository.CountByRole(ctx, entity.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 {
+			return nil, ErrLastAdmin
+		}
+	}
+
+	if err := s.userRepository.SetRoles(ctx, userID, roles); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+	userResponse := toUserResponse(user, names)
+	return &userResponse, nil
+}
+
+// toUserResponse maps a user granted roles to its API representation
+func toUserResponse(user *entity.UserEntity, roles []string) response.UserResponse {
+	return response.UserResponse{
+		ID:         user.ID,
+		Email:      user.Email,
+		Name:       user.Name,
+		Roles:      roles,
+		VerifiedAt: user.VerifiedAt,
+		CreatedAt:  user.CreatedAt,
+	}
+}
@@ -0,0 +1,156 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/mock"
+	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
+)
+
+func TestUpdateRoles(t *testing.T) {
+	userID := uuid.New()
+	withRoles := func(roles ...entity.Role) *entity.UserEntity {
+		user := &entity.UserEntity{ID: userID, Email: "test@example.com", Name: "Test User"}
+		for _, role := range roles {
+			user.Roles = append(user.Roles, entity.UserRoleEntity{UserID: userID, Role: role})
+		}
+		return user
+	}
+
+	tests := []struct {
+		name          string
+		roles         []string
+		mockUser      *entity.UserEntity
+		mockAdmins    int64
+		expectCount   bool
+		expectSet     []entity.Role
+		expectedRoles []string
+		expectedError error
+	}{
+		{
+			name:          "should replace the user's roles",
+			roles:         []string{"viewer"},
+			mockUser:      withRoles(entity.RoleAccountant),
+			expectSet:     []entity.Role{entity.RoleViewer},
+			expectedRoles: []string{"viewer"},
+		},
+		{
+			name:          "should assign a role listed twice once",
+			roles:         []string{"admin", "admin", "accountant"},
+			mockUser:      withRoles(entity.RoleViewer),
+			expectSet:     []entity.Role{entity.RoleAdmin, entity.RoleAccountant},
+			expectedRoles: []string{"admin", "accountant"},
+		},
+		{
+			name:          "should demote an admin while another admin remains",
+			roles:         []string{"accountant"},
+			mockUser:      withRoles(entity.RoleAdmin),
+			mockAdmins:    2,
+			expectCount:   true,
+			expectSet:     []entity.Role{entity.RoleAccountant},
+			expectedRoles: []string{"accountant"},
+		},
+		{
+			name:          "should not demote the last admin",
+			roles:         []string{"accountant"},
+			mockUser:      withRoles(entity.RoleAdmin),
+			mockAdmins:    1,
+			expectCount:   true,
+			expectedError: ErrLastAdmin,
+		},
+		{
+			name:          "should reject an unknown role",
+			roles:         []string{"owner"},
+			expectedError: ErrInvalidRole,
+		},
+		{
+			name:          "should return not found for an unknown user",
+			roles:         []string{"viewer"},
+			expectedError: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mock.NewMockUserRepository(ctrl)
+			mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+
+			if tt.expectedError != ErrInvalidRole {
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), userID).
+					Return(tt.mockUser, nil).
+					Times(1)
+			}
+			if tt.expectCount {
+				mockRepo.EXPECT().
+					CountByRole(gomock.Any(), entity.RoleAdmin).
+					Return(tt.mockAdmins, nil).
+					Times(1)
+			}
+			if tt.expectSet != nil {
+				mockRepo.EXPECT().
+					SetRoles(gomock.Any(), userID, tt.expectSet).
+					Return(nil).
+					Times(1)
+			}
+
+			svc := NewUserService(mockRepo, mockRefreshRepo, nil, token.NewTokenService(token.TokenConfig{}), LoginThrottleConfig{})
+
+			result, err := svc.UpdateRoles(context.Background(), userID, &request.UpdateUserRolesRequest{Roles: tt.roles})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(result.Roles, tt.expectedRoles) {
+				t.Errorf("expected roles %v, got %v", tt.expectedRoles, result.Roles)
+			}
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
+
+	userID := uuid.New()
+	mockRepo.EXPECT().
+		FindAll(gomock.Any(), 2, 1).
+		Return([]entity.UserEntity{{
+			ID:    userID,
+			Email: "test@example.com",
+			Roles: []entity.UserRoleEntity{{UserID: userID, Role: entity.RoleViewer}},
+		}}, int64(3), nil).
+		Times(1)
+
+	svc := NewUserService(mockRepo, mockRefreshRepo, nil, token.NewTokenService(token.TokenConfig{}), LoginThrottleConfig{})
+
+	result, err := svc.ListUsers(context.Background(), 2, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Data) != 1 || !slices.Equal(result.Data[0].Roles, []string{"viewer"}) {
+		t.Errorf("expected one viewer, got %+v", result.Data)
+	}
+	if result.Meta.TotalData != 3 || result.Meta.TotalPage != 3 {
+		t.Errorf("expected 3 users on 3 pages, got %+v", result.Meta)
+	}
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Unlock forgets the failed sign-ins of a user's account, lifting its
+// lockout. Failures counted against client IPs are left alone.
+func (s *userService) Unlock(ctx context.Context, userID uuid.UUID) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	return s.loginAttemptRepository.Reset(ctx, accountKey(user.Email))
+}
@@ -2,31 +2,101 @@ package user
 
 import (
 	"context"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/apperror"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/entity"
+	"github.com/kamil5b/clean-go-vite-react/backend/model/rbac"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/request"
 	"github.com/kamil5b/clean-go-vite-react/backend/model/response"
 	"github.com/kamil5b/clean-go-vite-react/backend/repository/interfaces"
 	"github.com/kamil5b/clean-go-vite-react/backend/service/token"
 )
 
+// ErrUserExists is returned when registering an email that is already taken
+var ErrUserExists = apperror.New(apperror.ErrConflict, "user_exists", "user already exists")
+
+// ErrInvalidCredentials is returned when an email and password do not match a user
+var ErrInvalidCredentials = apperror.New(apperror.ErrUnauthorized, "invalid_credentials", "invalid email or password")
+
+// ErrInvalidRefreshToken is returned for a refresh token that is unknown, expired or revoked
+var ErrInvalidRefreshToken = apperror.New(apperror.ErrUnauthorized, "invalid_refresh_token", "invalid refresh token")
+
+// ErrRefreshTokenReused is returned when a rotated refresh token is used again,
+// which revokes its whole family
+var ErrRefreshTokenReused = apperror.New(apperror.ErrUnauthorized, "refresh_token_reused", "refresh token reuse detected")
+
+// ErrTooManyLoginAttempts is returned for a sign-in made too soon after
+// failed ones, or from a client IP blocked after too many
+var ErrTooManyLoginAttempts = apperror.New(apperror.ErrTooManyRequests, "too_many_login_attempts", "too many failed sign-ins, try again later")
+
+// ErrAccountLocked is returned for a sign-in to an account locked after too
+// many failed ones, even with the right password
+var ErrAccountLocked = apperror.New(apperror.ErrTooManyRequests, "account_locked", "account is temporarily locked after too many failed sign-ins")
+
+// ErrUserNotFound is returned when a user does not exist
+var ErrUserNotFound = apperror.New(apperror.ErrNotFound, "user_not_found", "user not found")
+
+// ErrInvalidRole is returned when assigning a role that does not exist
+var ErrInvalidRole = apperror.New(apperror.ErrValidation, "invalid_role", "role must be one of admin, accountant or viewer")
+
+// ErrLastAdmin is returned when taking the admin role from the only admin,
+// which would leave nobody able to assign roles
+var ErrLastAdmin = apperror.New(apperror.ErrConflict, "last_admin", "cannot remove the admin role from the last admin")
+
 // UserService defines the interface for user operations
 type UserService interface {
 	Register(ctx context.Context, req *request.RegisterUserRequest) (*response.RegisterResponse, error)
-	Login(ctx context.Context, req *request.LoginRequest) (*response.LoginResponse, error)
-	Refresh(ctx context.Context, userID string) (*response.RefreshResponse, error)
+	Login(ctx context.Context, req *request.LoginRequest, clientIP string) (*response.LoginResponse, error)
+	Refresh(ctx context.Context, refreshToken string) (*response.RefreshResponse, error)
+	Logout(ctx context.Context, refreshToken string) error
 	GetUser(ctx context.Context, userID string) (*response.GetUser, error)
+	ListUsers(ctx context.Context, page, limit int) (*response.UserPaginationResponse, error)
+	UpdateRoles(ctx context.Context, userID uuid.UUID, req *request.UpdateUserRolesRequest) (*response.UserResponse, error)
+	Unlock(ctx context.Context, userID uuid.UUID) error
 }
 
 // userService is the concrete implementation of UserService
 type userService struct {
-	userRepository interfaces.UserRepository
-	tokenService   token.TokenService
+	userRepository         interfaces.UserRepository
+	refreshTokenRepository interfaces.RefreshTokenRepository
+	loginAttemptRepository interfaces.LoginAttemptRepository
+	tokenService           token.TokenService
+	loginThrottle          LoginThrottleConfig
+	now                    func() time.Time
 }
 
 // NewUserService creates a new instance of UserService
-func NewUserService(userRepository interfaces.UserRepository, tokenService token.TokenService) UserService {
+func NewUserService(userRepository interfaces.UserRepository, refreshTokenRepository interfaces.RefreshTokenRepository, loginAttemptRepository interfaces.LoginAttemptRepository, tokenService token.TokenService, loginThrottle LoginThrottleConfig) UserService {
 	return &userService{
-		userRepository: userRepository,
-		tokenService:   tokenService,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		tokenService:           tokenService,
+		loginThrottle:          loginThrottle,
+		now:                    time.Now,
+	}
+}
+
+// roleNames returns the names of the roles granted to user
+func roleNames(user *entity.UserEntity) []string {
+	roles := make([]string, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = string(role.Role)
+	}
+	return roles
+}
+
+// toGetUser maps a user to the API representation of the signed-in user
+func toGetUser(user *entity.UserEntity) response.GetUser {
+	roles := roleNames(user)
+	return response.GetUser{
+		ID:          user.ID,
+		Email:       user.Email,
+		Name:        user.Name,
+		Roles:       roles,
+		Permissions: rbac.Permissions(roles),
+		VerifiedAt:  user.VerifiedAt,
 	}
 }
@@ -13,13 +13,14 @@ func TestNewUserService(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mock.NewMockUserRepository(ctrl)
+	mockRefreshRepo := mock.NewMockRefreshTokenRepository(ctrl)
 	tokenConfig := token.TokenConfig{
 		AccessTokenSecret:  "test-access-secret",
 		RefreshTokenSecret: "test-refresh-secret",
 	}
 	tokenSvc := token.NewTokenService(tokenConfig)
 
-	service := NewUserService(mockRepo, tokenSvc)
+	service := NewUserService(mockRepo, mockRefreshRepo, nil, tokenSvc, LoginThrottleConfig{})
 
 	if service == nil {
 		t.Errorf("expected non-nil service, got nil")
@@ -25,6 +25,15 @@ func main() {
 	// Load configuration
 	cfg := platform.NewConfig()
 
+	// `server migrate ...` manages the database schema instead of serving
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Create dependency container
 	container := di.NewContainer(cfg)
 	e := container.Echo
@@ -43,6 +52,10 @@ func main() {
 	// Register frontend handlers (dev proxy or static assets)
 	e.Any("/*", echo.WrapHandler(web.Handler()))
 
+	// Create invoices from recurring templates in the background
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	container.Scheduler.Start(schedulerCtx)
+
 	// Start server in a goroutine
 	go func() {
 		addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
@@ -58,6 +71,10 @@ func main() {
 
 	<-sigChan
 
+	// Let a scheduled run in progress finish before closing connections
+	stopScheduler()
+	container.Scheduler.Wait()
+
 	// Graceful shutdown with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
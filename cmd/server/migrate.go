@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kamil5b/clean-go-vite-react/backend/platform"
+	"github.com/kamil5b/clean-go-vite-react/backend/repository/implementations/migration"
+)
+
+var errMigrateUsage = errors.New("usage: server migrate up | down [steps] | status")
+
+// runMigrate runs `server migrate up`, which applies every pending
+// migration, `server migrate down [steps]`, which reverts the last steps
+// migrations (one unless given), or `server migrate status`
+func runMigrate(cfg *platform.Config, args []string) error {
+	if len(args) == 0 {
+		return errMigrateUsage
+	}
+
+	migrator, err := migration.NewGORMMigrator(platform.InitializeDatabase(cfg))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Println("applied", m)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("steps must be a whole number of at least 1, got %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Println("reverted", m)
+		}
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("no migrations to revert")
+		}
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", status.Migration, appliedAt)
+		}
+		return w.Flush()
+	}
+
+	return errMigrateUsage
+}
@@ -0,0 +1,28 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import { UpdateUserRolesRequest } from "@/types/request/user";
+import { UserPaginationResponse, UserResponse } from "@/types/response/user";
+
+// User administration; every call needs the user:manage permission
+export const adminApi = {
+  getUsers: async (page: number = 1, limit: number = 10): Promise<UserPaginationResponse> => {
+    const params = new URLSearchParams({
+      page: page.toString(),
+      limit: limit.toString(),
+    });
+    return apiClientJson<UserPaginationResponse>(`/admin/users?${params.toString()}`);
+  },
+
+  updateRoles: async (id: string, data: UpdateUserRolesRequest): Promise<UserResponse> => {
+    return apiClientJson<UserResponse>(`/admin/users/${id}/roles`, {
+      method: "PUT",
+      body: JSON.stringify(data),
+    });
+  },
+
+  // Lift a sign-in lockout
+  unlock: async (id: string): Promise<void> => {
+    await apiClient(`/admin/users/${id}/unlock`, {
+      method: "POST",
+    });
+  },
+};
@@ -0,0 +1,23 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import { CreateAPIKeyRequest } from "@/types/request/api_key";
+import { APIKeyListResponse, CreateAPIKeyResponse } from "@/types/response/api_key";
+
+// Personal API keys for scripts and integrations
+export const apiKeyApi = {
+  create: async (data: CreateAPIKeyRequest): Promise<CreateAPIKeyResponse> => {
+    return apiClientJson<CreateAPIKeyResponse>("/auth/api-keys", {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  getAll: async (): Promise<APIKeyListResponse> => {
+    return apiClientJson<APIKeyListResponse>("/auth/api-keys");
+  },
+
+  revoke: async (id: string): Promise<void> => {
+    await apiClient(`/auth/api-keys/${id}`, {
+      method: "DELETE",
+    });
+  },
+};
@@ -4,7 +4,13 @@ import {
     RefreshResponse,
     CSRFTokenResponse,
     GetUser,
+    AccountMessageResponse,
 } from "@/types/response/user";
+import {
+    ForgotPasswordRequest,
+    ResetPasswordRequest,
+    VerifyEmailRequest,
+} from "@/types/request/user";
 import { apiClient, apiClientJson } from "@/lib/apiClient";
 
 export const authApi = {
@@ -55,6 +61,42 @@ export const authApi = {
         });
     },
 
+    forgotPassword: async (
+        data: ForgotPasswordRequest,
+    ): Promise<AccountMessageResponse> => {
+        return apiClientJson<AccountMessageResponse>("/auth/password/forgot", {
+            method: "POST",
+            body: JSON.stringify(data),
+        });
+    },
+
+    resetPassword: async (
+        data: ResetPasswordRequest,
+    ): Promise<AccountMessageResponse> => {
+        return apiClientJson<AccountMessageResponse>("/auth/password/reset", {
+            method: "POST",
+            body: JSON.stringify(data),
+        });
+    },
+
+    verifyEmail: async (
+        data: VerifyEmailRequest,
+    ): Promise<AccountMessageResponse> => {
+        return apiClientJson<AccountMessageResponse>("/auth/verify-email", {
+            method: "POST",
+            body: JSON.stringify(data),
+        });
+    },
+
+    resendVerification: async (): Promise<AccountMessageResponse> => {
+        return apiClientJson<AccountMessageResponse>(
+            "/auth/verify-email/resend",
+            {
+                method: "POST",
+            },
+        );
+    },
+
     getCsrfToken: async (): Promise<CSRFTokenResponse> => {
         return apiClientJson<CSRFTokenResponse>("/csrf");
     },
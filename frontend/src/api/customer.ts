@@ -0,0 +1,44 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import { CreateCustomerRequest, UpdateCustomerRequest } from "@/types/request/customer";
+import { CustomerResponse, CustomerPaginationResponse } from "@/types/response/customer";
+
+export const customerApi = {
+  create: async (data: CreateCustomerRequest): Promise<CustomerResponse> => {
+    return apiClientJson<CustomerResponse>("/customers", {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  getById: async (id: number): Promise<CustomerResponse> => {
+    return apiClientJson<CustomerResponse>(`/customers/${id}`);
+  },
+
+  update: async (id: number, data: UpdateCustomerRequest): Promise<CustomerResponse> => {
+    return apiClientJson<CustomerResponse>(`/customers/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(data),
+    });
+  },
+
+  delete: async (id: number): Promise<void> => {
+    await apiClient(`/customers/${id}`, {
+      method: "DELETE",
+    });
+  },
+
+  getAll: async (
+    page: number = 1,
+    limit: number = 10,
+    search: string = ""
+  ): Promise<CustomerPaginationResponse> => {
+    const params = new URLSearchParams({
+      page: page.toString(),
+      limit: limit.toString(),
+    });
+    if (search) {
+      params.append("search", search);
+    }
+    return apiClientJson<CustomerPaginationResponse>(`/customers?${params.toString()}`);
+  },
+};
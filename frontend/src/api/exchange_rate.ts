@@ -0,0 +1,55 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import { CreateExchangeRateRequest } from "@/types/request/exchange_rate";
+import {
+  ExchangeRateImportResponse,
+  ExchangeRatePaginationResponse,
+  ExchangeRateResponse,
+} from "@/types/response/exchange_rate";
+
+export const exchangeRateApi = {
+  create: async (data: CreateExchangeRateRequest): Promise<ExchangeRateResponse> => {
+    return apiClientJson<ExchangeRateResponse>("/exchange-rates", {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  // CSV with base_currency, quote_currency, rate and effective_date columns
+  import: async (file: File): Promise<ExchangeRateImportResponse> => {
+    const body = new FormData();
+    body.append("file", file);
+    return apiClientJson<ExchangeRateImportResponse>("/exchange-rates/import", {
+      method: "POST",
+      body,
+    });
+  },
+
+  getById: async (id: number): Promise<ExchangeRateResponse> => {
+    return apiClientJson<ExchangeRateResponse>(`/exchange-rates/${id}`);
+  },
+
+  delete: async (id: number): Promise<void> => {
+    await apiClient(`/exchange-rates/${id}`, {
+      method: "DELETE",
+    });
+  },
+
+  getAll: async (
+    page: number = 1,
+    limit: number = 10,
+    baseCurrency: string = "",
+    quoteCurrency: string = ""
+  ): Promise<ExchangeRatePaginationResponse> => {
+    const params = new URLSearchParams({
+      page: page.toString(),
+      limit: limit.toString(),
+    });
+    if (baseCurrency) {
+      params.append("base_currency", baseCurrency);
+    }
+    if (quoteCurrency) {
+      params.append("quote_currency", quoteCurrency);
+    }
+    return apiClientJson<ExchangeRatePaginationResponse>(`/exchange-rates?${params.toString()}`);
+  },
+};
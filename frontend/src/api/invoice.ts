@@ -1,6 +1,18 @@
 import { apiClient, apiClientJson } from "@/lib/apiClient";
-import { CreateInvoiceRequest, UpdateInvoiceRequest } from "@/types/request/invoice";
-import { InvoiceDetailResponse, InvoicePaginationResponse } from "@/types/response/invoice";
+import {
+  CreateCreditNoteRequest,
+  CreateInvoiceRequest,
+  CreatePaymentRequest,
+  UpdateInvoiceRequest,
+} from "@/types/request/invoice";
+import {
+  CreditNoteResponse,
+  InvoiceDetailResponse,
+  InvoicePaginationResponse,
+  InvoiceStatus,
+  InvoiceSummaryResponse,
+  PaymentResponse,
+} from "@/types/response/invoice";
 
 export const invoiceApi = {
   create: async (data: CreateInvoiceRequest): Promise<InvoiceDetailResponse> => {
@@ -14,6 +26,17 @@ export const invoiceApi = {
     return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}`);
   },
 
+  // Fetches the printable PDF through apiClient so an expired session is
+  // refreshed like any other request
+  getPdf: async (id: number): Promise<Blob> => {
+    const response = await apiClient(`/invoices/${id}/pdf`);
+    if (!response.ok) {
+      const error = await response.json().catch(() => ({}));
+      throw new Error(error.detail || `Request failed: ${response.status}`);
+    }
+    return response.blob();
+  },
+
   update: async (id: number, data: UpdateInvoiceRequest): Promise<InvoiceDetailResponse> => {
     return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}`, {
       method: "PUT",
@@ -30,7 +53,11 @@ export const invoiceApi = {
   getAll: async (
     page: number = 1,
     limit: number = 10,
-    search: string = ""
+    search: string = "",
+    status?: InvoiceStatus,
+    customerId?: number,
+    currency?: string,
+    baseCurrency?: string
   ): Promise<InvoicePaginationResponse> => {
     const params = new URLSearchParams({
       page: page.toString(),
@@ -39,6 +66,68 @@ export const invoiceApi = {
     if (search) {
       params.append("search", search);
     }
+    if (status) {
+      params.append("status", status);
+    }
+    if (customerId) {
+      params.append("customer_id", customerId.toString());
+    }
+    if (currency) {
+      params.append("currency", currency);
+    }
+    if (baseCurrency) {
+      params.append("base_currency", baseCurrency);
+    }
     return apiClientJson<InvoicePaginationResponse>(`/invoices?${params.toString()}`);
   },
+
+  // Totals issued invoices, or those with the given status, in baseCurrency
+  // (the server's base currency by default)
+  getSummary: async (
+    status?: InvoiceStatus,
+    baseCurrency?: string
+  ): Promise<InvoiceSummaryResponse> => {
+    const params = new URLSearchParams();
+    if (status) {
+      params.append("status", status);
+    }
+    if (baseCurrency) {
+      params.append("base_currency", baseCurrency);
+    }
+    return apiClientJson<InvoiceSummaryResponse>(`/invoices/summary?${params.toString()}`);
+  },
+
+  issue: async (id: number): Promise<InvoiceDetailResponse> => {
+    return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}/issue`, {
+      method: "POST",
+    });
+  },
+
+  getPayments: async (id: number): Promise<PaymentResponse[]> => {
+    return apiClientJson<PaymentResponse[]>(`/invoices/${id}/payments`);
+  },
+
+  recordPayment: async (id: number, data: CreatePaymentRequest): Promise<InvoiceDetailResponse> => {
+    return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}/payments`, {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  getCreditNotes: async (id: number): Promise<CreditNoteResponse[]> => {
+    return apiClientJson<CreditNoteResponse[]>(`/invoices/${id}/credit-notes`);
+  },
+
+  createCreditNote: async (id: number, data: CreateCreditNoteRequest): Promise<InvoiceDetailResponse> => {
+    return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}/credit-notes`, {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  void: async (id: number): Promise<InvoiceDetailResponse> => {
+    return apiClientJson<InvoiceDetailResponse>(`/invoices/${id}/void`, {
+      method: "POST",
+    });
+  },
 };
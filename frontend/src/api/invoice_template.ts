@@ -0,0 +1,43 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import {
+  CreateInvoiceTemplateRequest,
+  UpdateInvoiceTemplateRequest,
+} from "@/types/request/invoice_template";
+import {
+  InvoiceTemplateResponse,
+  InvoiceTemplatePaginationResponse,
+} from "@/types/response/invoice_template";
+
+export const invoiceTemplateApi = {
+  create: async (data: CreateInvoiceTemplateRequest): Promise<InvoiceTemplateResponse> => {
+    return apiClientJson<InvoiceTemplateResponse>("/invoice-templates", {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  getById: async (id: number): Promise<InvoiceTemplateResponse> => {
+    return apiClientJson<InvoiceTemplateResponse>(`/invoice-templates/${id}`);
+  },
+
+  update: async (id: number, data: UpdateInvoiceTemplateRequest): Promise<InvoiceTemplateResponse> => {
+    return apiClientJson<InvoiceTemplateResponse>(`/invoice-templates/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(data),
+    });
+  },
+
+  delete: async (id: number): Promise<void> => {
+    await apiClient(`/invoice-templates/${id}`, {
+      method: "DELETE",
+    });
+  },
+
+  getAll: async (page: number = 1, limit: number = 10): Promise<InvoiceTemplatePaginationResponse> => {
+    const params = new URLSearchParams({
+      page: page.toString(),
+      limit: limit.toString(),
+    });
+    return apiClientJson<InvoiceTemplatePaginationResponse>(`/invoice-templates?${params.toString()}`);
+  },
+};
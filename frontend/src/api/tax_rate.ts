@@ -0,0 +1,44 @@
+import { apiClient, apiClientJson } from "@/lib/apiClient";
+import { CreateTaxRateRequest, UpdateTaxRateRequest } from "@/types/request/tax_rate";
+import { TaxRateResponse, TaxRatePaginationResponse } from "@/types/response/tax_rate";
+
+export const taxRateApi = {
+  create: async (data: CreateTaxRateRequest): Promise<TaxRateResponse> => {
+    return apiClientJson<TaxRateResponse>("/tax-rates", {
+      method: "POST",
+      body: JSON.stringify(data),
+    });
+  },
+
+  getById: async (id: number): Promise<TaxRateResponse> => {
+    return apiClientJson<TaxRateResponse>(`/tax-rates/${id}`);
+  },
+
+  update: async (id: number, data: UpdateTaxRateRequest): Promise<TaxRateResponse> => {
+    return apiClientJson<TaxRateResponse>(`/tax-rates/${id}`, {
+      method: "PUT",
+      body: JSON.stringify(data),
+    });
+  },
+
+  delete: async (id: number): Promise<void> => {
+    await apiClient(`/tax-rates/${id}`, {
+      method: "DELETE",
+    });
+  },
+
+  getAll: async (
+    page: number = 1,
+    limit: number = 10,
+    search: string = ""
+  ): Promise<TaxRatePaginationResponse> => {
+    const params = new URLSearchParams({
+      page: page.toString(),
+      limit: limit.toString(),
+    });
+    if (search) {
+      params.append("search", search);
+    }
+    return apiClientJson<TaxRatePaginationResponse>(`/tax-rates?${params.toString()}`);
+  },
+};
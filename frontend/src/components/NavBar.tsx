@@ -21,6 +21,7 @@ export function NavBar() {
                   { label: "Counter", href: "/counter" },
                   { label: "Items", href: "/items" },
                   { label: "Tags", href: "/tags" },
+                  { label: "Customers", href: "/customers" },
                   { label: "Invoices", href: "/invoices" },
               ]
             : []),
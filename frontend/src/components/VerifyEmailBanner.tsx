@@ -0,0 +1,47 @@
+import { useState } from "react";
+import { useAuth } from "@/contexts/AuthContext";
+import { authApi } from "@/api/auth";
+
+// Reminds a signed-in user whose email is not verified yet, and lets them
+// ask for a new verification link
+export function VerifyEmailBanner() {
+    const { user } = useAuth();
+    const [message, setMessage] = useState("");
+    const [sending, setSending] = useState(false);
+
+    if (!user || user.verified_at) {
+        return null;
+    }
+
+    const resend = async () => {
+        setSending(true);
+        try {
+            const response = await authApi.resendVerification();
+            setMessage(response.message);
+        } catch (err) {
+            setMessage(
+                err instanceof Error
+                    ? err.message
+                    : "Failed to send verification link",
+            );
+        } finally {
+            setSending(false);
+        }
+    };
+
+    return (
+        <div className="px-4 py-2 bg-yellow-50 border-b border-yellow-200 text-yellow-800 text-sm text-center">
+            {message || "Please verify your email with the link we sent you."}{" "}
+            {!message && (
+                <button
+                    type="button"
+                    onClick={resend}
+                    disabled={sending}
+                    className="font-medium underline disabled:opacity-50"
+                >
+                    {sending ? "Sending..." : "Resend link"}
+                </button>
+            )}
+        </div>
+    );
+}
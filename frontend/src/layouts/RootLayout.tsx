@@ -1,10 +1,12 @@
 import { Outlet } from "react-router-dom";
 import { NavBar } from "../components/NavBar";
+import { VerifyEmailBanner } from "../components/VerifyEmailBanner";
 
 export function RootLayout() {
     return (
         <div className="flex flex-col min-h-screen">
             <NavBar />
+            <VerifyEmailBanner />
             <main className="flex-1">
                 <Outlet />
             </main>
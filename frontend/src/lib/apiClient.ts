@@ -8,6 +8,8 @@
  * - Prevents refresh loops on auth endpoints
  */
 
+import { ProblemResponseSchema } from "@/types/response/common";
+
 const API_BASE_URL = "/api";
 
 // Track if we're currently refreshing to prevent multiple refresh attempts
@@ -83,11 +85,13 @@ export async function apiClient(
     // Ensure URL is properly formatted
     const fullUrl = url.startsWith("http") ? url : `${API_BASE_URL}${url}`;
 
-    // Default options
+    // Default options; multipart bodies set their own Content-Type boundary
     const defaultOptions: RequestInit = {
         credentials: "include",
         headers: {
-            "Content-Type": "application/json",
+            ...(options.body instanceof FormData
+                ? {}
+                : { "Content-Type": "application/json" }),
             ...(options.headers || {}),
         },
     };
@@ -131,7 +135,14 @@ export async function apiClient(
                 response = await fetch(fullUrl, mergedOptions);
             } catch {
                 // Refresh failed, only redirect if not on public pages
-                const publicPaths = ["/", "/login", "/register"];
+                const publicPaths = [
+                    "/",
+                    "/login",
+                    "/register",
+                    "/forgot-password",
+                    "/reset-password",
+                    "/verify-email",
+                ];
                 if (!publicPaths.includes(window.location.pathname)) {
                     window.location.href = "/login";
                 }
@@ -157,7 +168,14 @@ export async function apiClient(
                 response = await fetch(fullUrl, mergedOptions);
             } catch {
                 // Refresh failed, only redirect if not on public pages
-                const publicPaths = ["/", "/login", "/register"];
+                const publicPaths = [
+                    "/",
+                    "/login",
+                    "/register",
+                    "/forgot-password",
+                    "/reset-password",
+                    "/verify-email",
+                ];
                 if (!publicPaths.includes(window.location.pathname)) {
                     window.location.href = "/login";
                 }
@@ -179,10 +197,21 @@ export async function apiClientJson<T>(
     const response = await apiClient(url, options);
 
     if (!response.ok) {
-        const error = await response.json().catch(() => ({
-            error: response.statusText,
-        }));
-        throw new Error(error.error || `Request failed: ${response.status}`);
+        const error = await response.json().catch(() => null);
+        const parsed = ProblemResponseSchema.safeParse(error);
+        if (!parsed.success) {
+            throw new Error(`Request failed: ${response.status}`);
+        }
+        const problem = parsed.data;
+        const message = problem.detail || problem.title;
+        // 422 bodies list the fields that failed validation
+        if (problem.fields?.length) {
+            const fields = problem.fields
+                .map((f) => `${f.field} (${f.rule})`)
+                .join(", ");
+            throw new Error(`${message}: ${fields}`);
+        }
+        throw new Error(message);
     }
 
     return response.json();
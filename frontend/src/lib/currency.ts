@@ -0,0 +1,28 @@
+// ISO 4217 currencies whose minor unit is not 1/100, mirroring the backend
+const MINOR_UNITS: Record<string, number> = {
+  BHD: 3, IQD: 3, JOD: 3, KWD: 3, LYD: 3, OMR: 3, TND: 3,
+  CLF: 4, UYW: 4,
+  BIF: 0, CLP: 0, DJF: 0, GNF: 0, ISK: 0, JPY: 0, KMF: 0, KRW: 0, PYG: 0,
+  RWF: 0, UGX: 0, UYI: 0, VND: 0, VUV: 0, XAF: 0, XOF: 0, XPF: 0,
+};
+
+/**
+ * Number of decimal digits in a currency's minor unit (2 for USD cents)
+ */
+export function minorUnits(currency: string): number {
+  return MINOR_UNITS[currency] ?? 2;
+}
+
+/**
+ * Format an amount given in the currency's minor units, e.g. 12345 USD as $123.45
+ * and 12345 JPY as ¥12,345
+ */
+export function formatMoney(amount: number, currency: string): string {
+  const digits = minorUnits(currency);
+  return new Intl.NumberFormat("en-US", {
+    style: "currency",
+    currency,
+    minimumFractionDigits: digits,
+    maximumFractionDigits: digits,
+  }).format(amount / 10 ** digits);
+}
@@ -0,0 +1,386 @@
+import { useState, useEffect } from "react";
+import { customerApi } from "@/api/customer";
+import { CustomerResponse } from "@/types/response/customer";
+import { Button } from "@/components/ui/button";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableHead,
+  TableHeader,
+  TableRow,
+} from "@/components/ui/table";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Textarea } from "@/components/ui/textarea";
+import {
+  Pagination,
+  PaginationContent,
+  PaginationItem,
+  PaginationLink,
+  PaginationNext,
+  PaginationPrevious,
+} from "@/components/ui/pagination";
+
+export default function CustomersPage() {
+  const [customers, setCustomers] = useState<CustomerResponse[]>([]);
+  const [loading, setLoading] = useState(false);
+  const [page, setPage] = useState(1);
+  const [totalPages, setTotalPages] = useState(1);
+  const [totalData, setTotalData] = useState(0);
+  const [search, setSearch] = useState("");
+  const [searchInput, setSearchInput] = useState("");
+  const limit = 10;
+
+  // Modal state
+  const [isModalOpen, setIsModalOpen] = useState(false);
+  const [modalMode, setModalMode] = useState<"create" | "edit">("create");
+  const [editingCustomer, setEditingCustomer] = useState<CustomerResponse | null>(null);
+  const [formData, setFormData] = useState({ name: "", email: "", billing_address: "", tax_id: "" });
+  const [formError, setFormError] = useState("");
+  const [submitting, setSubmitting] = useState(false);
+
+  // Delete confirmation
+  const [deleteConfirmOpen, setDeleteConfirmOpen] = useState(false);
+  const [deletingCustomer, setDeletingCustomer] = useState<CustomerResponse | null>(null);
+
+  useEffect(() => {
+    fetchCustomers();
+  }, [page, search]);
+
+  const fetchCustomers = async () => {
+    setLoading(true);
+    try {
+      const response = await customerApi.getAll(page, limit, search);
+      setCustomers(response.data);
+      setTotalPages(response.meta.totalPage);
+      setTotalData(response.meta.totalData);
+    } catch (error) {
+      console.error("Failed to fetch customers:", error);
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  const handleSearch = (e: React.FormEvent) => {
+    e.preventDefault();
+    setSearch(searchInput);
+    setPage(1);
+  };
+
+  const openCreateModal = () => {
+    setModalMode("create");
+    setEditingCustomer(null);
+    setFormData({ name: "", email: "", billing_address: "", tax_id: "" });
+    setFormError("");
+    setIsModalOpen(true);
+  };
+
+  const openEditModal = (customer: CustomerResponse) => {
+    setModalMode("edit");
+    setEditingCustomer(customer);
+    setFormData({
+      name: customer.name,
+      email: customer.email,
+      billing_address: customer.billing_address,
+      tax_id: customer.tax_id,
+    });
+    setFormError("");
+    setIsModalOpen(true);
+  };
+
+  const handleModalSubmit = async (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!formData.name.trim()) {
+      setFormError("Name is required");
+      return;
+    }
+
+    setSubmitting(true);
+    setFormError("");
+
+    try {
+      if (modalMode === "create") {
+        await customerApi.create(formData);
+      } else if (editingCustomer) {
+        await customerApi.update(editingCustomer.id, formData);
+      }
+      setIsModalOpen(false);
+      fetchCustomers();
+    } catch (error: any) {
+      setFormError(error.message || "Failed to save customer");
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  const openDeleteConfirm = (customer: CustomerResponse) => {
+    setDeletingCustomer(customer);
+    setDeleteConfirmOpen(true);
+  };
+
+  const handleDelete = async () => {
+    if (!deletingCustomer) return;
+
+    setSubmitting(true);
+    try {
+      await customerApi.delete(deletingCustomer.id);
+      setDeleteConfirmOpen(false);
+      setDeletingCustomer(null);
+      fetchCustomers();
+    } catch (error) {
+      console.error("Failed to delete customer:", error);
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  return (
+    <div className="container mx-auto py-8">
+      <Card>
+        <CardHeader>
+          <div className="flex justify-between items-center">
+            <CardTitle>Customers</CardTitle>
+            <Button onClick={openCreateModal}>Create Customer</Button>
+          </div>
+        </CardHeader>
+        <CardContent>
+          <form onSubmit={handleSearch} className="mb-6">
+            <div className="flex gap-2">
+              <Input
+                type="text"
+                placeholder="Search by name or email..."
+                value={searchInput}
+                onChange={(e) => setSearchInput(e.target.value)}
+                className="max-w-sm"
+              />
+              <Button type="submit">Search</Button>
+              {search && (
+                <Button
+                  type="button"
+                  variant="outline"
+                  onClick={() => {
+                    setSearchInput("");
+                    setSearch("");
+                    setPage(1);
+                  }}
+                >
+                  Clear
+                </Button>
+              )}
+            </div>
+          </form>
+
+          {loading ? (
+            <div className="text-center py-8">Loading...</div>
+          ) : customers.length === 0 ? (
+            <div className="text-center py-8 text-gray-500">No customers found</div>
+          ) : (
+            <>
+              <Table>
+                <TableHeader>
+                  <TableRow>
+                    <TableHead>ID</TableHead>
+                    <TableHead>Name</TableHead>
+                    <TableHead>Email</TableHead>
+                    <TableHead>Tax ID</TableHead>
+                    <TableHead className="text-right">Actions</TableHead>
+                  </TableRow>
+                </TableHeader>
+                <TableBody>
+                  {customers.map((customer) => (
+                    <TableRow key={customer.id}>
+                      <TableCell>{customer.id}</TableCell>
+                      <TableCell className="font-medium">{customer.name}</TableCell>
+                      <TableCell>{customer.email || "-"}</TableCell>
+                      <TableCell>{customer.tax_id || "-"}</TableCell>
+                      <TableCell className="text-right">
+                        <div className="flex justify-end gap-2">
+                          <Button
+                            variant="outline"
+                            size="sm"
+                            onClick={() => openEditModal(customer)}
+                          >
+                            Edit
+                          </Button>
+                          <Button
+                            variant="destructive"
+                            size="sm"
+                            onClick={() => openDeleteConfirm(customer)}
+                          >
+                            Delete
+                          </Button>
+                        </div>
+                      </TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+
+              <div className="mt-6 flex items-center justify-between">
+                <div className="text-sm text-gray-600">
+                  Showing {(page - 1) * limit + 1} to{" "}
+                  {Math.min(page * limit, totalData)} of {totalData} customers
+                </div>
+                <Pagination>
+                  <PaginationContent>
+                    <PaginationItem>
+                      <PaginationPrevious
+                        onClick={() => setPage((p) => Math.max(1, p - 1))}
+                        className={
+                          page === 1 ? "pointer-events-none opacity-50" : "cursor-pointer"
+                        }
+                      />
+                    </PaginationItem>
+                    {Array.from({ length: totalPages }, (_, i) => i + 1).map((p) => (
+                      <PaginationItem key={p}>
+                        <PaginationLink
+                          onClick={() => setPage(p)}
+                          isActive={page === p}
+                          className="cursor-pointer"
+                        >
+                          {p}
+                        </PaginationLink>
+                      </PaginationItem>
+                    ))}
+                    <PaginationItem>
+                      <PaginationNext
+                        onClick={() => setPage((p) => Math.min(totalPages, p + 1))}
+                        className={
+                          page === totalPages
+                            ? "pointer-events-none opacity-50"
+                            : "cursor-pointer"
+                        }
+                      />
+                    </PaginationItem>
+                  </PaginationContent>
+                </Pagination>
+              </div>
+            </>
+          )}
+        </CardContent>
+      </Card>
+
+      {/* Create/Edit Modal */}
+      <Dialog open={isModalOpen} onOpenChange={setIsModalOpen}>
+        <DialogContent>
+          <DialogHeader>
+            <DialogTitle>
+              {modalMode === "create" ? "Create Customer" : "Edit Customer"}
+            </DialogTitle>
+            <DialogDescription>
+              {modalMode === "create"
+                ? "Add a new customer to bill"
+                : "Update the customer details"}
+            </DialogDescription>
+          </DialogHeader>
+          <form onSubmit={handleModalSubmit}>
+            <div className="space-y-4 py-4">
+              <div className="space-y-2">
+                <Label htmlFor="name">Name *</Label>
+                <Input
+                  id="name"
+                  value={formData.name}
+                  onChange={(e) =>
+                    setFormData({ ...formData, name: e.target.value })
+                  }
+                  placeholder="Enter customer name"
+                  required
+                />
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="email">Email</Label>
+                <Input
+                  id="email"
+                  type="email"
+                  value={formData.email}
+                  onChange={(e) =>
+                    setFormData({ ...formData, email: e.target.value })
+                  }
+                  placeholder="billing@example.com"
+                />
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="billing_address">Billing address</Label>
+                <Textarea
+                  id="billing_address"
+                  value={formData.billing_address}
+                  onChange={(e) =>
+                    setFormData({ ...formData, billing_address: e.target.value })
+                  }
+                  placeholder="Street, city, postal code, country"
+                  rows={3}
+                />
+              </div>
+              <div className="space-y-2">
+                <Label htmlFor="tax_id">Tax ID</Label>
+                <Input
+                  id="tax_id"
+                  value={formData.tax_id}
+                  onChange={(e) =>
+                    setFormData({ ...formData, tax_id: e.target.value })
+                  }
+                  placeholder="e.g. VAT number"
+                />
+              </div>
+              {formError && (
+                <div className="text-sm text-red-500">{formError}</div>
+              )}
+            </div>
+            <DialogFooter>
+              <Button
+                type="button"
+                variant="outline"
+                onClick={() => setIsModalOpen(false)}
+                disabled={submitting}
+              >
+                Cancel
+              </Button>
+              <Button type="submit" disabled={submitting}>
+                {submitting ? "Saving..." : modalMode === "create" ? "Create" : "Save"}
+              </Button>
+            </DialogFooter>
+          </form>
+        </DialogContent>
+      </Dialog>
+
+      {/* Delete Confirmation Dialog */}
+      <Dialog open={deleteConfirmOpen} onOpenChange={setDeleteConfirmOpen}>
+        <DialogContent>
+          <DialogHeader>
+            <DialogTitle>Delete Customer</DialogTitle>
+            <DialogDescription>
+              Are you sure you want to delete "{deletingCustomer?.name}"? Issued
+              invoices keep their billing details.
+            </DialogDescription>
+          </DialogHeader>
+          <DialogFooter>
+            <Button
+              variant="outline"
+              onClick={() => setDeleteConfirmOpen(false)}
+              disabled={submitting}
+            >
+              Cancel
+            </Button>
+            <Button
+              variant="destructive"
+              onClick={handleDelete}
+              disabled={submitting}
+            >
+              {submitting ? "Deleting..." : "Delete"}
+            </Button>
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+    </div>
+  );
+}
@@ -0,0 +1,96 @@
+import { useState } from "react";
+import { Link } from "react-router-dom";
+import { authApi } from "@/api/auth";
+import { Button } from "@/components/ui/button";
+import { Card } from "@/components/ui/card";
+import {
+    Field,
+    FieldGroup,
+    FieldLabel,
+    FieldContent,
+} from "@/components/ui/field";
+
+export function ForgotPasswordPage() {
+    const [email, setEmail] = useState("");
+    const [message, setMessage] = useState("");
+    const [error, setError] = useState("");
+    const [loading, setLoading] = useState(false);
+
+    const handleSubmit = async (e: React.FormEvent) => {
+        e.preventDefault();
+        setError("");
+        setMessage("");
+        setLoading(true);
+
+        try {
+            const response = await authApi.forgotPassword({ email });
+            setMessage(response.message);
+        } catch (err) {
+            setError(
+                err instanceof Error ? err.message : "Failed to send reset link",
+            );
+        } finally {
+            setLoading(false);
+        }
+    };
+
+    return (
+        <div className="flex items-center justify-center min-h-screen bg-gray-50">
+            <Card className="w-full max-w-md p-8">
+                <h1 className="text-2xl font-bold mb-6 text-center">
+                    Forgot Password
+                </h1>
+
+                {error && (
+                    <div className="mb-4 p-4 bg-red-50 border border-red-200 rounded-md text-red-700 text-sm">
+                        {error}
+                    </div>
+                )}
+
+                {message && (
+                    <div className="mb-4 p-4 bg-green-50 border border-green-200 rounded-md text-green-700 text-sm">
+                        {message}
+                    </div>
+                )}
+
+                <form onSubmit={handleSubmit}>
+                    <FieldGroup>
+                        <Field>
+                            <FieldLabel htmlFor="email">Email</FieldLabel>
+                            <FieldContent>
+                                <input
+                                    id="email"
+                                    type="email"
+                                    value={email}
+                                    onChange={(e) => setEmail(e.target.value)}
+                                    required
+                                    disabled={loading}
+                                    className="w-full px-3 py-2 border border-gray-300 rounded-md shadow-sm focus:outline-none focus:ring-1 focus:ring-blue-500 focus:border-blue-500 disabled:bg-gray-100 disabled:cursor-not-allowed"
+                                    placeholder="you@example.com"
+                                />
+                            </FieldContent>
+                        </Field>
+                    </FieldGroup>
+
+                    <Button
+                        type="submit"
+                        disabled={loading}
+                        className="w-full mt-6 mb-4"
+                    >
+                        {loading ? "Sending..." : "Send reset link"}
+                    </Button>
+                </form>
+
+                <p className="text-center text-sm text-gray-600">
+                    Remembered it?{" "}
+                    <Link
+                        to="/login"
+                        className="text-blue-600 hover:text-blue-700 font-medium"
+                    >
+                        Back to login
+                    </Link>
+                </p>
+            </Card>
+        </div>
+    );
+}
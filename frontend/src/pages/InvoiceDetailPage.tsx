@@ -2,6 +2,7 @@ import { useState, useEffect } from "react";
 import { useNavigate, useParams } from "react-router-dom";
 import { invoiceApi } from "@/api/invoice";
 import { InvoiceDetailResponse } from "@/types/response/invoice";
+import { formatMoney } from "@/lib/currency";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import {
@@ -13,7 +14,7 @@ import {
   TableRow,
 } from "@/components/ui/table";
 import { Badge } from "@/components/ui/badge";
-import { ArrowLeft, Edit, Trash2 } from "lucide-react";
+import { ArrowLeft, Edit, FileDown, Trash2 } from "lucide-react";
 import {
   Dialog,
   DialogContent,
@@ -31,6 +32,7 @@ export default function InvoiceDetailPage() {
   const [error, setError] = useState("");
   const [deleteDialogOpen, setDeleteDialogOpen] = useState(false);
   const [deleting, setDeleting] = useState(false);
+  const [downloading, setDownloading] = useState(false);
 
   useEffect(() => {
     if (id) {
@@ -65,11 +67,26 @@ export default function InvoiceDetailPage() {
     }
   };
 
+  const handleDownloadPdf = async () => {
+    if (!invoice) return;
+
+    setDownloading(true);
+    try {
+      const blob = await invoiceApi.getPdf(invoice.id);
+      const url = URL.createObjectURL(blob);
+      window.open(url, "_blank");
+      // Give the new tab time to load the document before releasing it
+      setTimeout(() => URL.revokeObjectURL(url), 60_000);
+    } catch (err: any) {
+      setError(err.message || "Failed to download PDF");
+    } finally {
+      setDownloading(false);
+    }
+  };
+
+  // Amounts come from the API in the invoice currency's minor units
   const formatCurrency = (amount: number) => {
-    return new Intl.NumberFormat("en-US", {
-      style: "currency",
-      currency: "USD",
-    }).format(amount);
+    return formatMoney(amount, invoice?.currency ?? "USD");
   };
 
   const formatDate = (dateString: string) => {
@@ -121,6 +138,14 @@ export default function InvoiceDetailPage() {
           Back to Invoices
         </Button>
         <div className="flex gap-2">
+          <Button
+            variant="outline"
+            onClick={handleDownloadPdf}
+            disabled={downloading}
+          >
+            <FileDown className="h-4 w-4 mr-2" />
+            {downloading ? "Preparing..." : "Download PDF"}
+          </Button>
           <Button
             variant="outline"
             onClick={() => navigate(`/invoices/${invoice.id}/edit`)}
@@ -153,10 +178,41 @@ export default function InvoiceDetailPage() {
               <div className="text-3xl font-bold">
                 {formatCurrency(invoice.grand_price)}
               </div>
+              {invoice.base_currency &&
+                invoice.base_grand_price !== null &&
+                invoice.base_currency !== invoice.currency && (
+                  <div className="text-sm text-muted-foreground mt-1">
+                    {formatMoney(invoice.base_grand_price, invoice.base_currency)} at{" "}
+                    {invoice.exchange_rate}
+                  </div>
+                )}
             </div>
           </div>
         </CardHeader>
         <CardContent className="space-y-6">
+          {/* Bill To Section */}
+          {invoice.customer && (
+            <div>
+              <h3 className="font-semibold mb-2">Bill To</h3>
+              <div className="text-sm space-y-1">
+                <div className="font-medium">{invoice.customer.name}</div>
+                {invoice.customer.billing_address && (
+                  <div className="whitespace-pre-line text-muted-foreground">
+                    {invoice.customer.billing_address}
+                  </div>
+                )}
+                {invoice.customer.email && (
+                  <div className="text-muted-foreground">{invoice.customer.email}</div>
+                )}
+                {invoice.customer.tax_id && (
+                  <div className="text-muted-foreground">
+                    Tax ID: {invoice.customer.tax_id}
+                  </div>
+                )}
+              </div>
+            </div>
+          )}
+
           {/* Tags Section */}
           {invoice.tags.length > 0 && (
             <div>
@@ -209,6 +265,32 @@ export default function InvoiceDetailPage() {
                       </TableCell>
                     </TableRow>
                   ))}
+                  <TableRow>
+                    <TableCell colSpan={4} className="text-right">
+                      Subtotal:
+                    </TableCell>
+                    <TableCell className="text-right">
+                      {formatCurrency(invoice.subtotal)}
+                    </TableCell>
+                  </TableRow>
+                  {invoice.discount_total > 0 && (
+                    <TableRow>
+                      <TableCell colSpan={4} className="text-right">
+                        Discount:
+                      </TableCell>
+                      <TableCell className="text-right">
+                        -{formatCurrency(invoice.discount_total)}
+                      </TableCell>
+                    </TableRow>
+                  )}
+                  <TableRow>
+                    <TableCell colSpan={4} className="text-right">
+                      Tax:
+                    </TableCell>
+                    <TableCell className="text-right">
+                      {formatCurrency(invoice.tax_total)}
+                    </TableCell>
+                  </TableRow>
                   <TableRow>
                     <TableCell colSpan={4} className="text-right font-bold">
                       Grand Total:
@@ -222,6 +304,49 @@ export default function InvoiceDetailPage() {
             </div>
           </div>
 
+          {/* Credit Notes Section */}
+          {invoice.credit_notes.length > 0 && (
+            <div>
+              <h3 className="font-semibold mb-3">Credit Notes</h3>
+              <div className="border rounded-lg overflow-hidden">
+                <Table>
+                  <TableHeader>
+                    <TableRow>
+                      <TableHead>Number</TableHead>
+                      <TableHead>Reason</TableHead>
+                      <TableHead>Issued</TableHead>
+                      <TableHead className="text-right">Total</TableHead>
+                    </TableRow>
+                  </TableHeader>
+                  <TableBody>
+                    {invoice.credit_notes.map((note) => (
+                      <TableRow key={note.id}>
+                        <TableCell className="font-medium">
+                          {note.number}
+                        </TableCell>
+                        <TableCell className="text-muted-foreground">
+                          {note.reason}
+                        </TableCell>
+                        <TableCell>{formatDate(note.issued_at)}</TableCell>
+                        <TableCell className="text-right">
+                          -{formatCurrency(note.total)}
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                    <TableRow>
+                      <TableCell colSpan={3} className="text-right">
+                        Outstanding:
+                      </TableCell>
+                      <TableCell className="text-right font-bold">
+                        {formatCurrency(invoice.outstanding_balance)}
+                      </TableCell>
+                    </TableRow>
+                  </TableBody>
+                </Table>
+              </div>
+            </div>
+          )}
+
           {/* Summary */}
           <div className="bg-muted/50 rounded-lg p-4">
             <div className="grid grid-cols-3 gap-4 text-center">
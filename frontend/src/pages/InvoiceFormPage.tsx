@@ -2,11 +2,21 @@ import { useState, useEffect } from "react";
 import { useNavigate, useParams } from "react-router-dom";
 import { invoiceApi } from "@/api/invoice";
 import { itemApi } from "@/api/item";
+import { customerApi } from "@/api/customer";
 import { InvoiceItemInput } from "@/types/request/invoice";
+import { CustomerResponse } from "@/types/response/customer";
+import { formatMoney, minorUnits } from "@/lib/currency";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
+import {
+    Select,
+    SelectContent,
+    SelectItem,
+    SelectTrigger,
+    SelectValue,
+} from "@/components/ui/select";
 import { ItemMultiSelect } from "@/components/ItemMultiSelect";
 import { TagMultiSelect } from "@/components/TagMultiSelect";
 import {
@@ -37,9 +47,23 @@ export default function InvoiceFormPage() {
     const [error, setError] = useState("");
 
     // Form state
+    const [customers, setCustomers] = useState<CustomerResponse[]>([]);
+    const [customerId, setCustomerId] = useState("");
     const [selectedItemIds, setSelectedItemIds] = useState<number[]>([]);
     const [selectedTagIds, setSelectedTagIds] = useState<number[]>([]);
     const [invoiceItems, setInvoiceItems] = useState<InvoiceItemForm[]>([]);
+    // Empty means the server's base currency
+    const [currency, setCurrency] = useState("");
+
+    // Prices are edited in major units (e.g. dollars) and sent in minor units
+    const scale = 10 ** minorUnits(currency || "USD");
+
+    useEffect(() => {
+        customerApi
+            .getAll(1, 100)
+            .then((response) => setCustomers(response.data))
+            .catch((err) => console.error("Failed to fetch customers:", err));
+    }, []);
 
     // Fetch invoice data if editing
     useEffect(() => {
@@ -52,14 +76,17 @@ export default function InvoiceFormPage() {
         setLoading(true);
         try {
             const invoice = await invoiceApi.getById(invoiceId);
+            const invoiceScale = 10 ** minorUnits(invoice.currency);
+            setCurrency(invoice.currency);
+            setCustomerId(invoice.customer_id ? String(invoice.customer_id) : "");
 
             // Set items
             const itemForms: InvoiceItemForm[] = invoice.items.map((item) => ({
                 item_id: item.item_id,
                 itemName: item.item.name,
                 quantity: item.quantity,
-                unit_price: item.unit_price,
-                total_price: item.total_price,
+                unit_price: item.unit_price / invoiceScale,
+                total_price: item.total_price / invoiceScale,
             }));
             setInvoiceItems(itemForms);
             setSelectedItemIds(itemForms.map((item) => item.item_id));
@@ -157,11 +184,26 @@ export default function InvoiceFormPage() {
         return invoiceItems.reduce((sum, item) => sum + item.total_price, 0);
     };
 
+    // Format a major-unit amount in the invoice currency
+    const formatPrice = (amount: number) => {
+        const code = currency.length === 3 ? currency : "USD";
+        try {
+            return formatMoney(Math.round(amount * scale), code);
+        } catch {
+            return amount.toFixed(2);
+        }
+    };
+
     // Handle form submission
     const handleSubmit = async (e: React.FormEvent) => {
         e.preventDefault();
         setError("");
 
+        if (!customerId) {
+            setError("Please select a customer");
+            return;
+        }
+
         if (invoiceItems.length === 0) {
             setError("Please add at least one item");
             return;
@@ -178,15 +220,16 @@ export default function InvoiceFormPage() {
             return;
         }
 
-        const grandTotal = calculateGrandTotal();
-
+        // The server derives the grand total from the lines; prices are
+        // sent in the currency's minor units
         const invoiceData = {
-            grand_price: grandTotal,
+            customer_id: Number(customerId),
+            currency: currency || undefined,
             items: invoiceItems.map(
                 (item): InvoiceItemInput => ({
                     item_id: item.item_id,
                     quantity: item.quantity,
-                    unit_price: item.unit_price,
+                    unit_price: Math.round(item.unit_price * scale),
                 }),
             ),
             tags: selectedTagIds,
@@ -241,6 +284,46 @@ export default function InvoiceFormPage() {
                             </div>
                         )}
 
+                        {/* Customer */}
+                        <div className="space-y-2">
+                            <Label>Customer *</Label>
+                            <Select
+                                value={customerId}
+                                onValueChange={setCustomerId}
+                                disabled={submitting}
+                            >
+                                <SelectTrigger className="w-72">
+                                    <SelectValue placeholder="Select a customer..." />
+                                </SelectTrigger>
+                                <SelectContent>
+                                    {customers.map((customer) => (
+                                        <SelectItem
+                                            key={customer.id}
+                                            value={String(customer.id)}
+                                        >
+                                            {customer.name}
+                                        </SelectItem>
+                                    ))}
+                                </SelectContent>
+                            </Select>
+                        </div>
+
+                        {/* Currency */}
+                        <div className="space-y-2">
+                            <Label htmlFor="currency">Currency</Label>
+                            <Input
+                                id="currency"
+                                className="w-32"
+                                maxLength={3}
+                                placeholder="USD"
+                                value={currency}
+                                onChange={(e) =>
+                                    setCurrency(e.target.value.toUpperCase())
+                                }
+                                disabled={submitting}
+                            />
+                        </div>
+
                         {/* Items Selection */}
                         <div className="space-y-2">
                             <Label>Items *</Label>
@@ -300,7 +383,7 @@ export default function InvoiceFormPage() {
                                                     <Input
                                                         type="number"
                                                         min="0"
-                                                        step="0.01"
+                                                        step={1 / scale}
                                                         value={item.unit_price}
                                                         onChange={(e) =>
                                                             updateItemUnitPrice(
@@ -316,9 +399,8 @@ export default function InvoiceFormPage() {
                                                     />
                                                 </TableCell>
                                                 <TableCell>
-                                                    $
-                                                    {item.total_price.toFixed(
-                                                        2,
+                                                    {formatPrice(
+                                                        item.total_price,
                                                     )}
                                                 </TableCell>
                                                 <TableCell>
@@ -346,9 +428,8 @@ export default function InvoiceFormPage() {
                                                 Grand Total:
                                             </TableCell>
                                             <TableCell className="font-bold">
-                                                $
-                                                {calculateGrandTotal().toFixed(
-                                                    2,
+                                                {formatPrice(
+                                                    calculateGrandTotal(),
                                                 )}
                                             </TableCell>
                                             <TableCell></TableCell>
@@ -1,7 +1,10 @@
 import { useState, useEffect } from "react";
 import { useNavigate } from "react-router-dom";
 import { invoiceApi } from "@/api/invoice";
+import { customerApi } from "@/api/customer";
 import { InvoiceListItem } from "@/types/response/invoice";
+import { CustomerResponse } from "@/types/response/customer";
+import { formatMoney } from "@/lib/currency";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import {
@@ -21,6 +24,13 @@ import {
   DialogTitle,
 } from "@/components/ui/dialog";
 import { Input } from "@/components/ui/input";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
 import {
   Pagination,
   PaginationContent,
@@ -39,6 +49,9 @@ export default function InvoicesPage() {
   const [totalData, setTotalData] = useState(0);
   const [search, setSearch] = useState("");
   const [searchInput, setSearchInput] = useState("");
+  const [customers, setCustomers] = useState<CustomerResponse[]>([]);
+  // "all" or the id of the customer to filter by
+  const [customerFilter, setCustomerFilter] = useState("all");
   const limit = 10;
 
   // Delete confirmation
@@ -46,14 +59,22 @@ export default function InvoicesPage() {
   const [deletingInvoice, setDeletingInvoice] = useState<InvoiceListItem | null>(null);
   const [submitting, setSubmitting] = useState(false);
 
+  useEffect(() => {
+    customerApi
+      .getAll(1, 100)
+      .then((response) => setCustomers(response.data))
+      .catch((error) => console.error("Failed to fetch customers:", error));
+  }, []);
+
   useEffect(() => {
     fetchInvoices();
-  }, [page, search]);
+  }, [page, search, customerFilter]);
 
   const fetchInvoices = async () => {
     setLoading(true);
     try {
-      const response = await invoiceApi.getAll(page, limit, search);
+      const customerId = customerFilter === "all" ? undefined : Number(customerFilter);
+      const response = await invoiceApi.getAll(page, limit, search, undefined, customerId);
       setInvoices(response.data);
       setTotalPages(response.meta.totalPage);
       setTotalData(response.meta.totalData);
@@ -91,13 +112,6 @@ export default function InvoicesPage() {
     }
   };
 
-  const formatCurrency = (amount: number) => {
-    return new Intl.NumberFormat("en-US", {
-      style: "currency",
-      currency: "USD",
-    }).format(amount);
-  };
-
   return (
     <div className="container mx-auto py-8">
       <Card>
@@ -120,6 +134,25 @@ export default function InvoicesPage() {
                 className="max-w-sm"
               />
               <Button type="submit">Search</Button>
+              <Select
+                value={customerFilter}
+                onValueChange={(value) => {
+                  setCustomerFilter(value);
+                  setPage(1);
+                }}
+              >
+                <SelectTrigger className="w-48">
+                  <SelectValue placeholder="All customers" />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value="all">All customers</SelectItem>
+                  {customers.map((customer) => (
+                    <SelectItem key={customer.id} value={String(customer.id)}>
+                      {customer.name}
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
               {search && (
                 <Button
                   type="button"
@@ -146,6 +179,7 @@ export default function InvoicesPage() {
                 <TableHeader>
                   <TableRow>
                     <TableHead>ID</TableHead>
+                    <TableHead>Customer</TableHead>
                     <TableHead>Grand Price</TableHead>
                     <TableHead>Tags</TableHead>
                     <TableHead>Total Items</TableHead>
@@ -156,7 +190,8 @@ export default function InvoicesPage() {
                   {invoices.map((invoice) => (
                     <TableRow key={invoice.id}>
                       <TableCell className="font-medium">{invoice.id}</TableCell>
-                      <TableCell>{formatCurrency(invoice.grand_price)}</TableCell>
+                      <TableCell>{invoice.customer?.name ?? "-"}</TableCell>
+                      <TableCell>{formatMoney(invoice.grand_price, invoice.currency)}</TableCell>
                       <TableCell>
                         <div className="flex gap-1 flex-wrap">
                           {invoice.tags.length === 0 ? (
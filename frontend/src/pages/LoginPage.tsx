@@ -75,6 +75,15 @@ export function LoginPage() {
                     </Button>
                 </form>
 
+                <p className="text-center text-sm text-gray-600 mb-2">
+                    <Link
+                        to="/forgot-password"
+                        className="text-blue-600 hover:text-blue-700 font-medium"
+                    >
+                        Forgot your password?
+                    </Link>
+                </p>
+
                 <p className="text-center text-sm text-gray-600">
                     Don't have an account?{" "}
                     <Link
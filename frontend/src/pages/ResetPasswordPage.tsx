@@ -0,0 +1,136 @@
+import { useState } from "react";
+import { Link, useSearchParams } from "react-router-dom";
+import { authApi } from "@/api/auth";
+import { Button } from "@/components/ui/button";
+import { Card } from "@/components/ui/card";
+import {
+    Field,
+    FieldGroup,
+    FieldLabel,
+    FieldContent,
+} from "@/components/ui/field";
+import { PasswordInput } from "@/components/PasswordInput";
+
+export function ResetPasswordPage() {
+    const [searchParams] = useSearchParams();
+    const token = searchParams.get("token") ?? "";
+    const [password, setPassword] = useState("");
+    const [confirmPassword, setConfirmPassword] = useState("");
+    const [done, setDone] = useState(false);
+    const [error, setError] = useState("");
+    const [loading, setLoading] = useState(false);
+
+    const handleSubmit = async (e: React.FormEvent) => {
+        e.preventDefault();
+        setError("");
+
+        if (password !== confirmPassword) {
+            setError("Passwords do not match");
+            return;
+        }
+
+        if (password.length < 8) {
+            setError("Password must be at least 8 characters");
+            return;
+        }
+
+        setLoading(true);
+
+        try {
+            await authApi.resetPassword({ token, password });
+            setDone(true);
+        } catch (err) {
+            setError(
+                err instanceof Error ? err.message : "Failed to reset password",
+            );
+        } finally {
+            setLoading(false);
+        }
+    };
+
+    return (
+        <div className="flex items-center justify-center min-h-screen bg-gray-50">
+            <Card className="w-full max-w-md p-8">
+                <h1 className="text-2xl font-bold mb-6 text-center">
+                    Reset Password
+                </h1>
+
+                {!token && (
+                    <div className="mb-4 p-4 bg-red-50 border border-red-200 rounded-md text-red-700 text-sm">
+                        This link is missing its token. Open the link from the
+                        email again, or ask for a new one.
+                    </div>
+                )}
+
+                {error && (
+                    <div className="mb-4 p-4 bg-red-50 border border-red-200 rounded-md text-red-700 text-sm">
+                        {error}
+                    </div>
+                )}
+
+                {done ? (
+                    <div className="mb-4 p-4 bg-green-50 border border-green-200 rounded-md text-green-700 text-sm">
+                        Your password has been reset and every session signed
+                        out. You can now log in with the new password.
+                    </div>
+                ) : (
+                    <form onSubmit={handleSubmit}>
+                        <FieldGroup>
+                            <Field>
+                                <FieldLabel htmlFor="password">
+                                    New password
+                                </FieldLabel>
+                                <FieldContent>
+                                    <PasswordInput
+                                        id="password"
+                                        value={password}
+                                        onChange={(e) =>
+                                            setPassword(e.target.value)
+                                        }
+                                        required
+                                        disabled={loading || !token}
+                                    />
+                                </FieldContent>
+                            </Field>
+
+                            <Field>
+                                <FieldLabel htmlFor="confirmPassword">
+                                    Confirm new password
+                                </FieldLabel>
+                                <FieldContent>
+                                    <PasswordInput
+                                        id="confirmPassword"
+                                        value={confirmPassword}
+                                        onChange={(e) =>
+                                            setConfirmPassword(e.target.value)
+                                        }
+                                        placeholder="Confirm your password"
+                                        required
+                                        disabled={loading || !token}
+                                    />
+                                </FieldContent>
+                            </Field>
+                        </FieldGroup>
+
+                        <Button
+                            type="submit"
+                            disabled={loading || !token}
+                            className="w-full mt-6 mb-4"
+                        >
+                            {loading ? "Resetting..." : "Reset password"}
+                        </Button>
+                    </form>
+                )}
+
+                <p className="text-center text-sm text-gray-600">
+                    <Link
+                        to={done ? "/login" : "/forgot-password"}
+                        className="text-blue-600 hover:text-blue-700 font-medium"
+                    >
+                        {done ? "Go to login" : "Ask for a new link"}
+                    </Link>
+                </p>
+            </Card>
+        </div>
+    );
+}
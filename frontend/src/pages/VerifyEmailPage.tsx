@@ -0,0 +1,76 @@
+import { useEffect, useRef, useState } from "react";
+import { Link, useSearchParams } from "react-router-dom";
+import { authApi } from "@/api/auth";
+import { Card } from "@/components/ui/card";
+
+type Status = "verifying" | "verified" | "failed";
+
+export function VerifyEmailPage() {
+    const [searchParams] = useSearchParams();
+    const token = searchParams.get("token") ?? "";
+    const [status, setStatus] = useState<Status>(
+        token ? "verifying" : "failed",
+    );
+    const [error, setError] = useState(
+        token ? "" : "This link is missing its token.",
+    );
+    // Tokens work once, so never post the same one twice (StrictMode
+    // runs effects twice in development)
+    const submitted = useRef(false);
+
+    useEffect(() => {
+        if (!token || submitted.current) {
+            return;
+        }
+        submitted.current = true;
+
+        authApi
+            .verifyEmail({ token })
+            .then(() => setStatus("verified"))
+            .catch((err) => {
+                setStatus("failed");
+                setError(
+                    err instanceof Error
+                        ? err.message
+                        : "Failed to verify email",
+                );
+            });
+    }, [token]);
+
+    return (
+        <div className="flex items-center justify-center min-h-screen bg-gray-50">
+            <Card className="w-full max-w-md p-8">
+                <h1 className="text-2xl font-bold mb-6 text-center">
+                    Verify Email
+                </h1>
+
+                {status === "verifying" && (
+                    <p className="text-center text-gray-600">
+                        Verifying your email...
+                    </p>
+                )}
+
+                {status === "verified" && (
+                    <div className="mb-4 p-4 bg-green-50 border border-green-200 rounded-md text-green-700 text-sm">
+                        Your email has been verified.
+                    </div>
+                )}
+
+                {status === "failed" && (
+                    <div className="mb-4 p-4 bg-red-50 border border-red-200 rounded-md text-red-700 text-sm">
+                        {error} Sign in and ask for a new verification link.
+                    </div>
+                )}
+
+                <p className="text-center text-sm text-gray-600">
+                    <Link
+                        to="/"
+                        className="text-blue-600 hover:text-blue-700 font-medium"
+                    >
+                        Back to home
+                    </Link>
+                </p>
+            </Card>
+        </div>
+    );
+}
@@ -3,8 +3,12 @@ export * from "./HomePage";
 export * from "./LoginPage";
 export * from "./RegisterPage";
 export * from "./NotFoundPage";
+export * from "./ForgotPasswordPage";
+export * from "./ResetPasswordPage";
+export * from "./VerifyEmailPage";
 export { default as ItemsPage } from "./ItemsPage";
 export { default as TagsPage } from "./TagsPage";
+export { default as CustomersPage } from "./CustomersPage";
 export { default as InvoicesPage } from "./InvoicesPage";
 export { default as InvoiceFormPage } from "./InvoiceFormPage";
 export { default as InvoiceDetailPage } from "./InvoiceDetailPage";
@@ -7,9 +7,13 @@ import {
     NotFoundPage,
     ItemsPage,
     TagsPage,
+    CustomersPage,
     InvoicesPage,
     InvoiceFormPage,
     InvoiceDetailPage,
+    ForgotPasswordPage,
+    ResetPasswordPage,
+    VerifyEmailPage,
 } from "@/pages";
 import { RootLayout } from "@/layouts/RootLayout";
 import { ProtectedRoute } from "@/components/ProtectedRoute";
@@ -46,6 +50,14 @@ const router = createBrowserRouter([
                     </ProtectedRoute>
                 ),
             },
+            {
+                path: "/customers",
+                element: (
+                    <ProtectedRoute>
+                        <CustomersPage />
+                    </ProtectedRoute>
+                ),
+            },
             {
                 path: "/invoices",
                 element: (
@@ -86,6 +98,18 @@ const router = createBrowserRouter([
                 path: "/register",
                 element: <RegisterPage />,
             },
+            {
+                path: "/forgot-password",
+                element: <ForgotPasswordPage />,
+            },
+            {
+                path: "/reset-password",
+                element: <ResetPasswordPage />,
+            },
+            {
+                path: "/verify-email",
+                element: <VerifyEmailPage />,
+            },
             {
                 path: "*",
                 element: <NotFoundPage />,
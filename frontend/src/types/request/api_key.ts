@@ -0,0 +1,10 @@
+import { z } from "zod";
+
+export const CreateAPIKeyRequestSchema = z.object({
+    name: z.string().min(1, "Name is required").max(255),
+    // Permissions the key is limited to, e.g. "invoice:read"
+    scopes: z.array(z.string()).min(1, "At least one scope is required"),
+    expires_at: z.string().datetime().optional(),
+});
+
+export type CreateAPIKeyRequest = z.infer<typeof CreateAPIKeyRequestSchema>;
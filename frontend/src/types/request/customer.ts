@@ -0,0 +1,13 @@
+export interface CreateCustomerRequest {
+  name: string;
+  email: string;
+  billing_address: string;
+  tax_id: string;
+}
+
+export interface UpdateCustomerRequest {
+  name: string;
+  email: string;
+  billing_address: string;
+  tax_id: string;
+}
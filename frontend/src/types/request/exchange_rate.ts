@@ -0,0 +1,9 @@
+// One unit of base_currency is worth rate units of quote_currency, e.g.
+// EUR→USD at "1.0845". Rates are decimal strings so they are kept exactly.
+export interface CreateExchangeRateRequest {
+  base_currency: string;
+  quote_currency: string;
+  rate: string;
+  // YYYY-MM-DD; defaults to today
+  effective_date?: string;
+}
@@ -1,17 +1,59 @@
+import { DiscountType, PaymentKind, PaymentMethod } from "@/types/response/invoice";
+
 export interface InvoiceItemInput {
   item_id: number;
   quantity: number;
+  // Minor units (e.g. cents)
   unit_price: number;
+  discount_type?: DiscountType;
+  // Basis points for "percent", minor units for "fixed"
+  discount_value?: number;
+  // Taxes this line at its own rate instead of the invoice's
+  tax_rate_id?: number;
 }
 
 export interface CreateInvoiceRequest {
-  grand_price: number;
+  customer_id: number;
+  // ISO 4217 code; defaults to the server's base currency
+  currency?: string;
+  // Optional; computed by the server and rejected if it does not match the lines
+  grand_price?: number;
+  // Taxes every line without a rate of its own
+  tax_rate_id?: number;
   items: InvoiceItemInput[];
   tags: number[];
 }
 
 export interface UpdateInvoiceRequest {
-  grand_price: number;
+  customer_id: number;
+  // ISO 4217 code; keeps the current currency when omitted
+  currency?: string;
+  // Optional; computed by the server and rejected if it does not match the lines
+  grand_price?: number;
+  // Taxes every line without a rate of its own
+  tax_rate_id?: number;
   items: InvoiceItemInput[];
   tags: number[];
 }
+
+export interface CreatePaymentRequest {
+  // Defaults to "payment"
+  kind?: PaymentKind;
+  // Minor units (e.g. cents)
+  amount: number;
+  // Defaults to "other"
+  method?: PaymentMethod;
+  // ISO date; defaults to now
+  paid_at?: string;
+  reference?: string;
+}
+
+export interface CreditNoteLineInput {
+  invoice_item_id: number;
+  quantity: number;
+}
+
+export interface CreateCreditNoteRequest {
+  reason: string;
+  lines: CreditNoteLineInput[];
+}
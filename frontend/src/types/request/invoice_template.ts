@@ -0,0 +1,35 @@
+import { InvoiceItemInput } from "./invoice";
+import { TemplateCadence } from "@/types/response/invoice_template";
+
+export interface CreateInvoiceTemplateRequest {
+  name: string;
+  customer_id: number;
+  // ISO 4217 code; defaults to the server's base currency
+  currency?: string;
+  // Taxes every line without a rate of its own
+  tax_rate_id?: number;
+  items: InvoiceItemInput[];
+  tags: number[];
+  cadence: TemplateCadence;
+  // YYYY-MM-DD of the first invoice; defaults to today
+  next_run_date?: string;
+  // Issue each invoice as soon as it is created instead of leaving a draft
+  auto_issue: boolean;
+  active?: boolean;
+}
+
+export interface UpdateInvoiceTemplateRequest {
+  name: string;
+  customer_id: number;
+  // ISO 4217 code; keeps the current currency when omitted
+  currency?: string;
+  tax_rate_id?: number;
+  items: InvoiceItemInput[];
+  tags: number[];
+  cadence: TemplateCadence;
+  // Keeps the current schedule when omitted
+  next_run_date?: string;
+  auto_issue: boolean;
+  // Pauses or resumes the template; unchanged when omitted
+  active?: boolean;
+}
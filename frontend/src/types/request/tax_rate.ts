@@ -0,0 +1,10 @@
+// Rates are in basis points (1/100 of a percent), e.g. 1250 = 12.5%
+export interface CreateTaxRateRequest {
+  name: string;
+  rate: number;
+}
+
+export interface UpdateTaxRateRequest {
+  name: string;
+  rate: number;
+}
@@ -11,5 +11,26 @@ export const LoginRequestSchema = z.object({
     password: z.string().min(1, "Password is required"),
 });
 
+export const UpdateUserRolesRequestSchema = z.object({
+    roles: z.array(z.enum(["admin", "accountant", "viewer"])).min(1, "At least one role is required"),
+});
+
+export const ForgotPasswordRequestSchema = z.object({
+    email: z.string().email("Invalid email address"),
+});
+
+export const ResetPasswordRequestSchema = z.object({
+    token: z.string().min(1, "Token is required"),
+    password: z.string().min(8, "Password must be at least 8 characters"),
+});
+
+export const VerifyEmailRequestSchema = z.object({
+    token: z.string().min(1, "Token is required"),
+});
+
 export type RegisterUserRequest = z.infer<typeof RegisterUserRequestSchema>;
 export type LoginRequest = z.infer<typeof LoginRequestSchema>;
+export type UpdateUserRolesRequest = z.infer<typeof UpdateUserRolesRequestSchema>;
+export type ForgotPasswordRequest = z.infer<typeof ForgotPasswordRequestSchema>;
+export type ResetPasswordRequest = z.infer<typeof ResetPasswordRequestSchema>;
+export type VerifyEmailRequest = z.infer<typeof VerifyEmailRequestSchema>;
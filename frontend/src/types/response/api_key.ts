@@ -0,0 +1,28 @@
+import { z } from "zod";
+
+export const APIKeyResponseSchema = z.object({
+    id: z.string().uuid(),
+    name: z.string(),
+    // The first characters of the key, to tell keys apart
+    prefix: z.string(),
+    scopes: z.array(z.string()),
+    expires_at: z.string().nullable(),
+    last_used_at: z.string().nullable(),
+    revoked_at: z.string().nullable(),
+    created_at: z.string(),
+});
+
+export type APIKeyResponse = z.infer<typeof APIKeyResponseSchema>;
+
+// The key itself is only returned once, when it is created
+export const CreateAPIKeyResponseSchema = APIKeyResponseSchema.extend({
+    key: z.string(),
+});
+
+export type CreateAPIKeyResponse = z.infer<typeof CreateAPIKeyResponseSchema>;
+
+export const APIKeyListResponseSchema = z.object({
+    data: z.array(APIKeyResponseSchema),
+});
+
+export type APIKeyListResponse = z.infer<typeof APIKeyListResponseSchema>;
@@ -5,3 +5,24 @@ export const CommonIDResponseSchema = z.object({
 });
 
 export type CommonIDResponse = z.infer<typeof CommonIDResponseSchema>;
+
+export const FieldErrorResponseSchema = z.object({
+    field: z.string(),
+    rule: z.string(),
+    param: z.string().optional(),
+});
+
+export type FieldErrorResponse = z.infer<typeof FieldErrorResponseSchema>;
+
+// Every API error is an RFC 7807 problem with a stable code
+export const ProblemResponseSchema = z.object({
+    type: z.string(),
+    title: z.string(),
+    status: z.number(),
+    detail: z.string().optional(),
+    instance: z.string().optional(),
+    code: z.string(),
+    fields: z.array(FieldErrorResponseSchema).optional(),
+});
+
+export type ProblemResponse = z.infer<typeof ProblemResponseSchema>;
@@ -0,0 +1,21 @@
+export interface CustomerResponse {
+  id: number;
+  name: string;
+  email: string;
+  billing_address: string;
+  tax_id: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface CustomerPaginationMeta {
+  totalData: number;
+  page: number;
+  limit: number;
+  totalPage: number;
+}
+
+export interface CustomerPaginationResponse {
+  data: CustomerResponse[];
+  meta: CustomerPaginationMeta;
+}
@@ -0,0 +1,26 @@
+export interface ExchangeRateResponse {
+  id: number;
+  base_currency: string;
+  quote_currency: string;
+  rate: string;
+  // YYYY-MM-DD
+  effective_date: string;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface ExchangeRateImportResponse {
+  imported: number;
+}
+
+export interface ExchangeRatePaginationMeta {
+  totalData: number;
+  page: number;
+  limit: number;
+  totalPage: number;
+}
+
+export interface ExchangeRatePaginationResponse {
+  data: ExchangeRateResponse[];
+  meta: ExchangeRatePaginationMeta;
+}
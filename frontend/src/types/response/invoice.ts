@@ -1,38 +1,174 @@
 import { ItemResponse } from "./item";
 import { TagResponse } from "./tag";
 
+export type InvoiceStatus =
+  | "draft"
+  | "issued"
+  | "partially_paid"
+  | "paid"
+  | "void";
+
+export type DiscountType = "percent" | "fixed";
+
+export type PaymentKind = "payment" | "refund";
+
+export type PaymentMethod = "cash" | "bank_transfer" | "card" | "cheque" | "other";
+
+// All monetary amounts are integer minor units of the invoice currency
+// (e.g. cents, or whole yen)
 export interface InvoiceItemResponse {
   id: number;
   item_id: number;
   item: ItemResponse;
   quantity: number;
   unit_price: number;
+  subtotal: number;
+  discount_type: DiscountType | "";
+  discount_value: number;
+  discount_amount: number;
+  tax_rate_id: number | null;
+  // Basis points, as priced
+  tax_rate: number;
+  tax_amount: number;
+  // subtotal - discount_amount + tax_amount
   total_price: number;
 }
 
+export interface PaymentResponse {
+  id: number;
+  kind: PaymentKind;
+  amount: number;
+  method: PaymentMethod;
+  paid_at: string;
+  reference: string;
+  created_at: string;
+}
+
+export interface CreditNoteLineResponse {
+  id: number;
+  invoice_item_id: number;
+  quantity: number;
+  // Credited share of the line's discounted subtotal
+  amount: number;
+  tax_amount: number;
+  total: number;
+}
+
+export interface CreditNoteResponse {
+  id: number;
+  number: string;
+  reason: string;
+  subtotal: number;
+  tax_total: number;
+  total: number;
+  issued_at: string;
+  lines: CreditNoteLineResponse[];
+  created_at: string;
+}
+
+// Billing details of an invoice's customer; snapshotted when it is issued so
+// later edits to the customer do not change it
+export interface InvoiceCustomerResponse {
+  name: string;
+  email: string;
+  billing_address: string;
+  tax_id: string;
+}
+
 export interface InvoiceDetailResponse {
   id: number;
+  // Assigned when the invoice is issued
+  number: string | null;
+  status: InvoiceStatus;
+  customer_id: number | null;
+  customer: InvoiceCustomerResponse | null;
+  // ISO 4217 code
+  currency: string;
+  subtotal: number;
+  discount_total: number;
+  tax_rate_id: number | null;
+  // Basis points, as priced
+  tax_rate: number;
+  tax_total: number;
+  // subtotal - discount_total + tax_total
   grand_price: number;
+  amount_paid: number;
+  // Sum of the credit notes' totals
+  credited_total: number;
+  // grand_price - credited_total - amount_paid
+  outstanding_balance: number;
+  // Overpaid amount not yet refunded
+  credit_balance: number;
+  // Snapshotted on issue: the rate to the base currency and the converted total
+  base_currency: string | null;
+  exchange_rate: string | null;
+  base_grand_price: number | null;
+  issued_at: string | null;
+  paid_at: string | null;
+  voided_at: string | null;
   items: InvoiceItemResponse[];
   tags: TagResponse[];
+  payments: PaymentResponse[];
+  credit_notes: CreditNoteResponse[];
   created_at: string;
   updated_at: string;
 }
 
 export interface InvoiceListItem {
   id: number;
+  // Assigned when the invoice is issued
+  number: string | null;
+  status: InvoiceStatus;
+  customer_id: number | null;
+  customer: InvoiceCustomerResponse | null;
+  currency: string;
   grand_price: number;
+  amount_paid: number;
+  outstanding_balance: number;
+  // Present when listing with a base_currency
+  converted?: ConvertedAmounts;
   tags: TagResponse[];
   totalItem: number;
   created_at: string;
   updated_at: string;
 }
 
+// Totals converted into another currency, in its minor units. exchange_rate is
+// omitted when more than one rate was used.
+export interface ConvertedAmounts {
+  currency: string;
+  exchange_rate?: string;
+  grand_price: number;
+  amount_paid: number;
+  outstanding_balance: number;
+}
+
+export interface InvoiceCurrencyTotals {
+  currency: string;
+  count: number;
+  grand_price: number;
+  amount_paid: number;
+  outstanding_balance: number;
+  converted: ConvertedAmounts;
+}
+
+export interface InvoiceSummaryResponse {
+  currency: string;
+  count: number;
+  grand_price: number;
+  amount_paid: number;
+  outstanding_balance: number;
+  by_currency: InvoiceCurrencyTotals[];
+}
+
 export interface InvoicePaginationMeta {
   totalData: number;
   page: number;
   limit: number;
   totalPage: number;
+  // Set instead of the counts when paging with ?cursor=
+  nextCursor?: string;
+  prevCursor?: string;
 }
 
 export interface InvoicePaginationResponse {
@@ -0,0 +1,51 @@
+import { DiscountType, InvoiceCustomerResponse } from "./invoice";
+import { ItemResponse } from "./item";
+import { TagResponse } from "./tag";
+
+export type TemplateCadence = "weekly" | "monthly" | "quarterly" | "yearly";
+
+export interface InvoiceTemplateItemResponse {
+  id: number;
+  item_id: number;
+  item: ItemResponse;
+  quantity: number;
+  // Minor units of the template currency
+  unit_price: number;
+  discount_type: DiscountType | "";
+  discount_value: number;
+  tax_rate_id: number | null;
+}
+
+export interface InvoiceTemplateResponse {
+  id: number;
+  name: string;
+  // Null for templates created before customers were required; their runs fail
+  customer_id: number | null;
+  customer: InvoiceCustomerResponse | null;
+  currency: string;
+  tax_rate_id: number | null;
+  cadence: TemplateCadence;
+  // YYYY-MM-DD of the next invoice
+  next_run_date: string;
+  auto_issue: boolean;
+  active: boolean;
+  last_run_at: string | null;
+  // Why the latest scheduled run failed; cleared by the next success
+  last_error: string | null;
+  items: InvoiceTemplateItemResponse[];
+  tags: TagResponse[];
+  created_at: string;
+  updated_at: string;
+}
+
+export interface InvoiceTemplatePaginationMeta {
+  totalData: number;
+  page: number;
+  limit: number;
+  totalPage: number;
+}
+
+export interface InvoiceTemplatePaginationResponse {
+  data: InvoiceTemplateResponse[];
+  meta: InvoiceTemplatePaginationMeta;
+}
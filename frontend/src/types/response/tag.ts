@@ -11,6 +11,9 @@ export interface TagPaginationMeta {
   page: number;
   limit: number;
   totalPage: number;
+  // Set instead of the counts when paging with ?cursor=
+  nextCursor?: string;
+  prevCursor?: string;
 }
 
 export interface TagPaginationResponse {
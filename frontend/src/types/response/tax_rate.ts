@@ -0,0 +1,20 @@
+export interface TaxRateResponse {
+  id: number;
+  name: string;
+  // Basis points (1/100 of a percent)
+  rate: number;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface TaxRatePaginationMeta {
+  totalData: number;
+  page: number;
+  limit: number;
+  totalPage: number;
+}
+
+export interface TaxRatePaginationResponse {
+  data: TaxRateResponse[];
+  meta: TaxRatePaginationMeta;
+}
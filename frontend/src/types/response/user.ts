@@ -1,13 +1,45 @@
 import { z } from "zod";
 
+export const RoleSchema = z.enum(["admin", "accountant", "viewer"]);
+
+export type Role = z.infer<typeof RoleSchema>;
+
 export const GetUserSchema = z.object({
     id: z.string().uuid(),
     email: z.string().email(),
     name: z.string(),
+    roles: z.array(RoleSchema),
+    // e.g. "invoice:write"; hide actions the user is not permitted
+    permissions: z.array(z.string()),
+    // When the user verified their email, null until then
+    verified_at: z.string().nullable(),
 });
 
 export type GetUser = z.infer<typeof GetUserSchema>;
 
+export const UserResponseSchema = z.object({
+    id: z.string().uuid(),
+    email: z.string().email(),
+    name: z.string(),
+    roles: z.array(RoleSchema),
+    verified_at: z.string().nullable(),
+    created_at: z.string(),
+});
+
+export type UserResponse = z.infer<typeof UserResponseSchema>;
+
+export const UserPaginationResponseSchema = z.object({
+    data: z.array(UserResponseSchema),
+    meta: z.object({
+        totalData: z.number(),
+        page: z.number(),
+        limit: z.number(),
+        totalPage: z.number(),
+    }),
+});
+
+export type UserPaginationResponse = z.infer<typeof UserPaginationResponseSchema>;
+
 export const LoginResponseSchema = z.object({
     token: z.string(),
     user: GetUserSchema,
@@ -33,3 +65,9 @@ export const CSRFTokenResponseSchema = z.object({
 });
 
 export type CSRFTokenResponse = z.infer<typeof CSRFTokenResponseSchema>;
+
+export const AccountMessageResponseSchema = z.object({
+    message: z.string(),
+});
+
+export type AccountMessageResponse = z.infer<typeof AccountMessageResponseSchema>;